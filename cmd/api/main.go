@@ -32,6 +32,12 @@ func main() {
 		Int("port", cfg.Server.Port).
 		Msg("Configuration loaded")
 
+	// Setup tracing
+	shutdownTracing, err := api.SetupTracing(cfg)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to setup tracing")
+	}
+
 	e := echo.New()
 	e.HideBanner = true
 	e.HidePort = true
@@ -56,11 +62,11 @@ func main() {
 	}()
 
 	// Wait for shutdown signal
-	gracefulShutdown(e)
+	gracefulShutdown(e, shutdownTracing)
 }
 
-// gracefulShutdown handles graceful shutdown of the server
-func gracefulShutdown(e *echo.Echo) {
+// gracefulShutdown handles graceful shutdown of the server, then flushes pending spans
+func gracefulShutdown(e *echo.Echo, shutdownTracing func(context.Context) error) {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 
@@ -73,5 +79,8 @@ func gracefulShutdown(e *echo.Echo) {
 	if err := e.Shutdown(ctx); err != nil {
 		log.Error().Err(err).Msg("Error during server shutdown")
 	}
+	if err := shutdownTracing(ctx); err != nil {
+		log.Error().Err(err).Msg("Error flushing traces")
+	}
 	log.Info().Msg("Server stopped")
 }
@@ -28,9 +28,350 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/api/v1/admin/providers": {
+            "get": {
+                "description": "List registered flight providers with their enabled state and ranking weight",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List providers",
+                "responses": {
+                    "200": {
+                        "description": "Registered providers",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handler_admin.ProviderListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid API key",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_herdiagusthio_flight-search-system_internal_handler_httputil.ErrorDetail"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/admin/providers/audit": {
+            "get": {
+                "description": "List the most recent runtime provider changes, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Provider audit log",
+                "responses": {
+                    "200": {
+                        "description": "Recent provider changes",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handler_admin.AuditLogResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid API key",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_herdiagusthio_flight-search-system_internal_handler_httputil.ErrorDetail"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/admin/providers/{name}/disable": {
+            "post": {
+                "description": "Disable a flight provider at runtime; the change is recorded in the audit log",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Disable provider",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "airasia",
+                        "description": "Provider name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated provider",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handler_admin.ProviderDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid API key",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_herdiagusthio_flight-search-system_internal_handler_httputil.ErrorDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Unknown provider",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_herdiagusthio_flight-search-system_internal_handler_httputil.ErrorDetail"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/admin/providers/{name}/enable": {
+            "post": {
+                "description": "Enable a flight provider at runtime; the change is recorded in the audit log",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Enable provider",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "airasia",
+                        "description": "Provider name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated provider",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handler_admin.ProviderDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid API key",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_herdiagusthio_flight-search-system_internal_handler_httputil.ErrorDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Unknown provider",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_herdiagusthio_flight-search-system_internal_handler_httputil.ErrorDetail"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/admin/providers/{name}/weight": {
+            "put": {
+                "description": "Set the best-value ranking weight of a flight provider at runtime, between 0 and 2 (default 1)\n(Weight − 1) × 0.1 is subtracted from the provider's flight scores; the change is recorded in the audit log",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Reweight provider",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "garuda_indonesia",
+                        "description": "Provider name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New weight",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_handler_admin.WeightRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated provider",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handler_admin.ProviderDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or weight",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_herdiagusthio_flight-search-system_internal_handler_httputil.ErrorDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid API key",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_herdiagusthio_flight-search-system_internal_handler_httputil.ErrorDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Unknown provider",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_herdiagusthio_flight-search-system_internal_handler_httputil.ErrorDetail"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/airlines": {
+            "get": {
+                "description": "List all known airlines with canonical name, logo, low-cost flag, parent group, alliance and on-time rate",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reference"
+                ],
+                "summary": "Airline directory",
+                "responses": {
+                    "200": {
+                        "description": "Airline directory",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handler_reference.AirlineListResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/airports": {
+            "get": {
+                "description": "Search airports by IATA code, city name or airport name using prefix and fuzzy matching\nResults are ranked by match strength with major airports first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reference"
+                ],
+                "summary": "Airport autocomplete",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "jak",
+                        "description": "Search text (IATA code, city or airport name)",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of results (default 10, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Matching airports",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handler_reference.AirportSearchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing query or invalid limit",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_herdiagusthio_flight-search-system_internal_handler_httputil.ErrorDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/flights/explore": {
+            "post": {
+                "description": "Find the cheapest fare to every destination served from an origin over a range of departure dates\nEach provider is queried once per date; results are served from the result cache when available",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "flights"
+                ],
+                "summary": "Explore destinations",
+                "parameters": [
+                    {
+                        "description": "Explore parameters",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_handler_flight.ExploreRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Cheapest fare per destination",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handler_flight.ExploreResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_herdiagusthio_flight-search-system_internal_handler_httputil.ErrorDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_herdiagusthio_flight-search-system_internal_handler_httputil.ErrorDetail"
+                        }
+                    },
+                    "503": {
+                        "description": "Service unavailable - all providers failed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_herdiagusthio_flight-search-system_internal_handler_httputil.ErrorDetail"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway timeout - explore took too long",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_herdiagusthio_flight-search-system_internal_handler_httputil.ErrorDetail"
+                        }
+                    }
+                }
+            }
+        },
         "/api/v1/flights/search": {
             "post": {
-                "description": "Search for available flights from multiple airline providers based on search criteria\nThis endpoint aggregates flight data from Garuda Indonesia, Lion Air, Batik Air, and AirAsia",
+                "description": "Search for available flights from multiple airline providers based on search criteria\nThis endpoint aggregates flight data from Garuda Indonesia, Lion Air, Batik Air, AirAsia, Citilink, and Super Air Jet",
                 "consumes": [
                     "application/json"
                 ],
@@ -50,6 +391,18 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/internal_handler_flight.SearchRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated flight fields to return (e.g. flight_number,price,departure.datetime)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include each flight's ranking score breakdown",
+                        "name": "explain",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -62,25 +415,25 @@ const docTemplate = `{
                     "400": {
                         "description": "Invalid request body or validation error",
                         "schema": {
-                            "$ref": "#/definitions/github_com_herdiagusthio_flight-search-system_internal_handler_response.ErrorDetail"
+                            "$ref": "#/definitions/github_com_herdiagusthio_flight-search-system_internal_handler_httputil.ErrorDetail"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/github_com_herdiagusthio_flight-search-system_internal_handler_response.ErrorDetail"
+                            "$ref": "#/definitions/github_com_herdiagusthio_flight-search-system_internal_handler_httputil.ErrorDetail"
                         }
                     },
                     "503": {
                         "description": "Service unavailable - all providers failed",
                         "schema": {
-                            "$ref": "#/definitions/github_com_herdiagusthio_flight-search-system_internal_handler_response.ErrorDetail"
+                            "$ref": "#/definitions/github_com_herdiagusthio_flight-search-system_internal_handler_httputil.ErrorDetail"
                         }
                     },
                     "504": {
                         "description": "Gateway timeout - search took too long",
                         "schema": {
-                            "$ref": "#/definitions/github_com_herdiagusthio_flight-search-system_internal_handler_response.ErrorDetail"
+                            "$ref": "#/definitions/github_com_herdiagusthio_flight-search-system_internal_handler_httputil.ErrorDetail"
                         }
                     }
                 }
@@ -103,7 +456,7 @@ const docTemplate = `{
                     "200": {
                         "description": "Service is healthy",
                         "schema": {
-                            "$ref": "#/definitions/internal_handler_response.HealthResponse"
+                            "$ref": "#/definitions/internal_handler_httputil.HealthResponse"
                         }
                     }
                 }
@@ -111,97 +464,516 @@ const docTemplate = `{
         }
     },
     "definitions": {
-        "github_com_herdiagusthio_flight-search-system_internal_handler_response.ErrorDetail": {
+        "github_com_herdiagusthio_flight-search-system_internal_handler_httputil.ErrorDetail": {
             "type": "object",
             "properties": {
                 "code": {
                     "description": "Error code identifier",
                     "type": "string",
-                    "example": "validation_error"
+                    "example": "validation_error"
+                },
+                "details": {
+                    "description": "Additional error details (optional)",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "field": "origin",
+                        "reason": "required"
+                    }
+                },
+                "message": {
+                    "description": "Human-readable error message",
+                    "type": "string",
+                    "example": "Request validation failed"
+                }
+            }
+        },
+        "internal_handler_admin.AuditEntryDTO": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "Change made",
+                    "type": "string",
+                    "enum": [
+                        "enable",
+                        "disable",
+                        "reweight"
+                    ],
+                    "example": "disable"
+                },
+                "after": {
+                    "description": "Provider status after the change",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_handler_admin.ProviderDTO"
+                        }
+                    ]
+                },
+                "before": {
+                    "description": "Provider status before the change",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_handler_admin.ProviderDTO"
+                        }
+                    ]
+                },
+                "provider": {
+                    "description": "Provider changed",
+                    "type": "string",
+                    "example": "airasia"
+                },
+                "remote_addr": {
+                    "description": "Client address of the request",
+                    "type": "string",
+                    "example": "10.0.0.1"
+                },
+                "request_id": {
+                    "description": "Request ID of the change",
+                    "type": "string"
+                },
+                "time": {
+                    "description": "When the change was made (RFC 3339)",
+                    "type": "string",
+                    "example": "2025-12-01T08:00:00Z"
+                }
+            }
+        },
+        "internal_handler_admin.AuditLogResponse": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "description": "Most recent changes, newest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handler_admin.AuditEntryDTO"
+                    }
+                }
+            }
+        },
+        "internal_handler_admin.ProviderDTO": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "description": "Whether the provider is queried by searches",
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "description": "Provider identifier",
+                    "type": "string",
+                    "example": "garuda_indonesia"
+                },
+                "weight": {
+                    "description": "Ranking weight in [0, 2]; (weight − 1) × 0.1 is subtracted from best-value scores",
+                    "type": "number",
+                    "example": 1
+                }
+            }
+        },
+        "internal_handler_admin.ProviderListResponse": {
+            "type": "object",
+            "properties": {
+                "providers": {
+                    "description": "Registered providers in registration order",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handler_admin.ProviderDTO"
+                    }
+                }
+            }
+        },
+        "internal_handler_admin.WeightRequest": {
+            "type": "object",
+            "properties": {
+                "weight": {
+                    "description": "New ranking weight in [0, 2]",
+                    "type": "number",
+                    "example": 1.5
+                }
+            }
+        },
+        "internal_handler_flight.AirlineDTO": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "IATA airline code",
+                    "type": "string",
+                    "example": "GA"
+                },
+                "group": {
+                    "description": "Parent airline group",
+                    "type": "string",
+                    "example": "Garuda Indonesia Group"
+                },
+                "logo": {
+                    "description": "Airline logo URL",
+                    "type": "string",
+                    "example": "https://pics.avs.io/200/200/GA.png"
+                },
+                "low_cost": {
+                    "description": "Whether the airline is a low-cost carrier",
+                    "type": "boolean",
+                    "example": false
+                },
+                "name": {
+                    "description": "Airline full name",
+                    "type": "string",
+                    "example": "Garuda Indonesia"
+                }
+            }
+        },
+        "internal_handler_flight.BaggageDTO": {
+            "type": "object",
+            "properties": {
+                "carry_on": {
+                    "description": "Cabin baggage allowance",
+                    "type": "string",
+                    "example": "7kg cabin"
+                },
+                "checked": {
+                    "description": "Checked baggage allowance",
+                    "type": "string",
+                    "example": "20kg checked"
+                }
+            }
+        },
+        "internal_handler_flight.DestinationFareDTO": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "description": "Destination city (airport code if unknown)",
+                    "type": "string",
+                    "example": "Denpasar"
+                },
+                "country": {
+                    "description": "ISO 3166-1 alpha-2 country code (empty if unknown)",
+                    "type": "string",
+                    "example": "ID"
+                },
+                "departure_date": {
+                    "description": "Local departure date of the cheapest flight",
+                    "type": "string",
+                    "example": "2025-12-15"
+                },
+                "destination": {
+                    "description": "Destination airport IATA code",
+                    "type": "string",
+                    "example": "DPS"
+                },
+                "flight": {
+                    "description": "The cheapest flight",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_handler_flight.FlightDTO"
+                        }
+                    ]
+                },
+                "price": {
+                    "description": "Cheapest fare",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_handler_flight.PriceDTO"
+                        }
+                    ]
+                }
+            }
+        },
+        "internal_handler_flight.DurationDTO": {
+            "type": "object",
+            "properties": {
+                "formatted": {
+                    "description": "Human-readable formatted duration",
+                    "type": "string",
+                    "example": "1h 30m"
+                },
+                "total_minutes": {
+                    "description": "Total duration in minutes",
+                    "type": "integer",
+                    "example": 90
+                }
+            }
+        },
+        "internal_handler_flight.DurationFacetDTO": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "Number of flights matching the other filters",
+                    "type": "integer",
+                    "example": 12
+                },
+                "max_minutes": {
+                    "description": "Longest duration before filtering",
+                    "type": "integer",
+                    "example": 320
+                },
+                "min_minutes": {
+                    "description": "Shortest duration before filtering",
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "internal_handler_flight.DurationRangeDTO": {
+            "type": "object",
+            "properties": {
+                "maxMinutes": {
+                    "description": "Maximum flight duration in minutes (optional)",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 300
+                },
+                "minMinutes": {
+                    "description": "Minimum flight duration in minutes (optional)",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 60
+                }
+            }
+        },
+        "internal_handler_flight.ExploreCriteria": {
+            "type": "object",
+            "properties": {
+                "cabin_class": {
+                    "description": "Cabin class",
+                    "type": "string",
+                    "example": "economy"
+                },
+                "departure_date_from": {
+                    "description": "First departure date",
+                    "type": "string",
+                    "example": "2025-12-15"
+                },
+                "departure_date_to": {
+                    "description": "Last departure date",
+                    "type": "string",
+                    "example": "2025-12-21"
+                },
+                "origin": {
+                    "description": "Origin airport IATA code",
+                    "type": "string",
+                    "example": "CGK"
+                },
+                "passengers": {
+                    "description": "Number of passengers",
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "internal_handler_flight.ExploreRequest": {
+            "type": "object",
+            "required": [
+                "departureDateFrom",
+                "departureDateTo",
+                "origin"
+            ],
+            "properties": {
+                "class": {
+                    "description": "Cabin class preference (optional, default economy)",
+                    "type": "string",
+                    "enum": [
+                        "economy",
+                        "business",
+                        "first"
+                    ],
+                    "example": "economy"
+                },
+                "departureDateFrom": {
+                    "description": "First departure date in YYYY-MM-DD format",
+                    "type": "string",
+                    "format": "date",
+                    "example": "2025-12-15"
                 },
-                "details": {
-                    "description": "Additional error details (optional)",
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    },
-                    "example": {
-                        "field": "origin",
-                        "reason": "required"
-                    }
+                "departureDateTo": {
+                    "description": "Last departure date in YYYY-MM-DD format (inclusive)",
+                    "type": "string",
+                    "format": "date",
+                    "example": "2025-12-21"
                 },
-                "message": {
-                    "description": "Human-readable error message",
+                "origin": {
+                    "description": "Origin airport IATA code (3 letters)",
                     "type": "string",
-                    "example": "Request validation failed"
+                    "format": "IATA code",
+                    "example": "CGK"
+                },
+                "passengers": {
+                    "description": "Number of passengers (1-9, optional, default 1)",
+                    "type": "integer",
+                    "maximum": 9,
+                    "minimum": 1,
+                    "example": 1
                 }
             }
         },
-        "internal_handler_flight.AirlineDTO": {
+        "internal_handler_flight.ExploreResponse": {
             "type": "object",
             "properties": {
-                "code": {
-                    "description": "IATA airline code",
-                    "type": "string",
-                    "example": "GA"
+                "destinations": {
+                    "description": "Cheapest fare per destination, cheapest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handler_flight.DestinationFareDTO"
+                    }
                 },
-                "name": {
-                    "description": "Airline full name",
-                    "type": "string",
-                    "example": "Garuda Indonesia"
+                "explore_criteria": {
+                    "description": "Echo of the explore criteria submitted",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_handler_flight.ExploreCriteria"
+                        }
+                    ]
+                },
+                "metadata": {
+                    "description": "Explore execution metadata; total_results counts destinations",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_handler_flight.Metadata"
+                        }
+                    ]
                 }
             }
         },
-        "internal_handler_flight.BaggageDTO": {
+        "internal_handler_flight.FacetCountDTO": {
             "type": "object",
             "properties": {
-                "carry_on": {
-                    "description": "Cabin baggage allowance",
+                "count": {
+                    "description": "Number of matching flights",
+                    "type": "integer",
+                    "example": 4
+                },
+                "label": {
+                    "description": "Human-readable label",
                     "type": "string",
-                    "example": "7kg cabin"
+                    "example": "Garuda Indonesia"
                 },
-                "checked": {
-                    "description": "Checked baggage allowance",
+                "value": {
+                    "description": "Filter value",
                     "type": "string",
-                    "example": "20kg checked"
+                    "example": "GA"
                 }
             }
         },
-        "internal_handler_flight.DurationDTO": {
+        "internal_handler_flight.FacetsDTO": {
             "type": "object",
             "properties": {
-                "formatted": {
-                    "description": "Human-readable formatted duration",
-                    "type": "string",
-                    "example": "1h 30m"
+                "airlines": {
+                    "description": "Airlines with matching flight counts",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handler_flight.FacetCountDTO"
+                    }
                 },
-                "total_minutes": {
-                    "description": "Total duration in minutes",
-                    "type": "integer",
-                    "example": 90
+                "amenities": {
+                    "description": "Amenities with matching flight counts",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handler_flight.FacetCountDTO"
+                    }
+                },
+                "departure_times": {
+                    "description": "Departure time-of-day buckets with matching flight counts",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handler_flight.FacetCountDTO"
+                    }
+                },
+                "duration": {
+                    "description": "Duration bounds",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_handler_flight.DurationFacetDTO"
+                        }
+                    ]
+                },
+                "price": {
+                    "description": "Price bounds and histogram",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_handler_flight.PriceFacetDTO"
+                        }
+                    ]
+                },
+                "stops": {
+                    "description": "Stop counts with matching flight counts",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handler_flight.FacetCountDTO"
+                    }
                 }
             }
         },
-        "internal_handler_flight.DurationRangeDTO": {
+        "internal_handler_flight.FareBreakdownDTO": {
             "type": "object",
             "properties": {
-                "maxMinutes": {
-                    "description": "Maximum flight duration in minutes (optional)",
-                    "type": "integer",
-                    "minimum": 0,
-                    "example": 300
+                "base_fare": {
+                    "description": "Fare before taxes, fees and surcharges (nullable)",
+                    "type": "number",
+                    "example": 1300000
                 },
-                "minMinutes": {
-                    "description": "Minimum flight duration in minutes (optional)",
+                "fees": {
+                    "description": "Airport and service fees (nullable)",
+                    "type": "number",
+                    "example": 0
+                },
+                "passengers": {
+                    "description": "Number of passengers the total covers",
                     "type": "integer",
-                    "minimum": 0,
-                    "example": 60
+                    "example": 2
+                },
+                "per_passenger": {
+                    "description": "Price for a single passenger",
+                    "type": "number",
+                    "example": 1500000
+                },
+                "surcharges": {
+                    "description": "Fuel and other carrier surcharges (nullable)",
+                    "type": "number",
+                    "example": 0
+                },
+                "taxes": {
+                    "description": "Government taxes (nullable)",
+                    "type": "number",
+                    "example": 200000
+                },
+                "total": {
+                    "description": "Price for all passengers",
+                    "type": "number",
+                    "example": 3000000
+                },
+                "total_formatted": {
+                    "description": "Total formatted for the currency's locale",
+                    "type": "string",
+                    "example": "Rp 3.000.000"
+                },
+                "unknown_components": {
+                    "description": "Components the provider does not report",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "fees",
+                        "surcharges"
+                    ]
                 }
             }
         },
         "internal_handler_flight.FilterDTO": {
             "type": "object",
             "properties": {
+                "aircraft": {
+                    "description": "Aircraft types or families, any may match (optional)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "737",
+                        "A320"
+                    ]
+                },
                 "airlines": {
                     "description": "Filter by airline codes (optional)",
                     "type": "array",
@@ -213,6 +985,27 @@ const docTemplate = `{
                         "JT"
                     ]
                 },
+                "amenities": {
+                    "description": "Required amenities; flights must offer all of them (optional)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "wifi",
+                        "meal"
+                    ]
+                },
+                "arrivalTerminals": {
+                    "description": "Arrival terminals (optional)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "I"
+                    ]
+                },
                 "arrivalTimeRange": {
                     "description": "Filter by arrival time range (optional)",
                     "allOf": [
@@ -221,6 +1014,16 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "departureTerminals": {
+                    "description": "Departure terminals (optional)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "3"
+                    ]
+                },
                 "departureTimeRange": {
                     "description": "Filter by departure time range (optional)",
                     "allOf": [
@@ -237,8 +1040,36 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "excludedAirlines": {
+                    "description": "Exclude airline codes (optional)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "QZ"
+                    ]
+                },
+                "excludedConnectionAirports": {
+                    "description": "Exclude flights connecting at these airports (optional)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "SUB"
+                    ]
+                },
+                "layoverDurationRange": {
+                    "description": "Every layover must fall within this duration range (optional)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_handler_flight.DurationRangeDTO"
+                        }
+                    ]
+                },
                 "maxPrice": {
-                    "description": "Maximum price in IDR (optional)",
+                    "description": "Maximum price in the base currency, IDR by default (optional)",
                     "type": "number",
                     "minimum": 0,
                     "example": 5000000
@@ -248,6 +1079,23 @@ const docTemplate = `{
                     "type": "integer",
                     "minimum": 0,
                     "example": 1
+                },
+                "minCheckedBaggageKg": {
+                    "description": "Minimum included checked baggage in kg (optional)",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 20
+                },
+                "minPrice": {
+                    "description": "Minimum price in the base currency, IDR by default (optional)",
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 500000
+                },
+                "noOvernightLayovers": {
+                    "description": "Exclude flights with a layover between 00:00 and 05:00 local time (optional)",
+                    "type": "boolean",
+                    "example": false
                 }
             }
         },
@@ -330,6 +1178,40 @@ const docTemplate = `{
                     "type": "string",
                     "example": "GA-12345"
                 },
+                "international": {
+                    "description": "Whether departure and arrival are in different countries",
+                    "type": "boolean",
+                    "example": false
+                },
+                "layovers": {
+                    "description": "Layover details (empty for direct flights)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handler_flight.LayoverDTO"
+                    }
+                },
+                "missing_documents": {
+                    "description": "Required travel documents the passenger did not declare",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "passport",
+                        "visa"
+                    ]
+                },
+                "pareto_tags": {
+                    "description": "Trade-off tags: cheapest, fastest, best balance (only with sortBy pareto)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "cheapest",
+                        "best balance"
+                    ]
+                },
                 "price": {
                     "description": "Price information",
                     "allOf": [
@@ -343,6 +1225,22 @@ const docTemplate = `{
                     "type": "string",
                     "example": "Garuda Indonesia"
                 },
+                "ranking_explanation": {
+                    "description": "Best-value score breakdown (only when explain is requested)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_handler_flight.RankingExplanationDTO"
+                        }
+                    ]
+                },
+                "self_transfer": {
+                    "description": "Separately ticketed connection details (only for self-transfer itineraries)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_handler_flight.SelfTransferDTO"
+                        }
+                    ]
+                },
                 "stops": {
                     "description": "Number of stops (0 for direct)",
                     "type": "integer",
@@ -350,6 +1248,26 @@ const docTemplate = `{
                 }
             }
         },
+        "internal_handler_flight.LayoverDTO": {
+            "type": "object",
+            "properties": {
+                "airport": {
+                    "description": "Connection airport IATA code",
+                    "type": "string",
+                    "example": "SUB"
+                },
+                "duration_minutes": {
+                    "description": "Layover duration in minutes",
+                    "type": "integer",
+                    "example": 75
+                },
+                "overnight": {
+                    "description": "Whether the layover falls between 00:00 and 05:00 local time",
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
         "internal_handler_flight.LocationDTO": {
             "type": "object",
             "properties": {
@@ -358,11 +1276,21 @@ const docTemplate = `{
                     "type": "string",
                     "example": "CGK"
                 },
+                "airport_name": {
+                    "description": "Airport name (empty if unknown)",
+                    "type": "string",
+                    "example": "Soekarno-Hatta International Airport"
+                },
                 "city": {
                     "description": "City name",
                     "type": "string",
                     "example": "Jakarta"
                 },
+                "country": {
+                    "description": "ISO 3166-1 alpha-2 country code (empty if unknown)",
+                    "type": "string",
+                    "example": "ID"
+                },
                 "datetime": {
                     "description": "ISO 8601 datetime",
                     "type": "string",
@@ -383,26 +1311,60 @@ const docTemplate = `{
                     "type": "boolean",
                     "example": false
                 },
+                "failed_providers": {
+                    "description": "Names of the providers that failed",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "providers_failed": {
                     "description": "Number of providers that failed",
                     "type": "integer",
                     "example": 0
                 },
-                "providers_queried": {
-                    "description": "Number of providers queried",
+                "providers_queried": {
+                    "description": "Number of providers queried",
+                    "type": "integer",
+                    "example": 3
+                },
+                "providers_rate_limited": {
+                    "description": "Number of providers over their rate limit, not queried",
+                    "type": "integer",
+                    "example": 0
+                },
+                "providers_skipped": {
+                    "description": "Number of providers not serving the route, not queried",
                     "type": "integer",
-                    "example": 4
+                    "example": 1
                 },
                 "providers_succeeded": {
                     "description": "Number of providers that responded successfully",
                     "type": "integer",
-                    "example": 4
+                    "example": 3
+                },
+                "rate_limited_providers": {
+                    "description": "Names of the providers over their rate limit",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
                 "search_time_ms": {
                     "description": "Total search execution time in milliseconds",
                     "type": "integer",
                     "example": 1234
                 },
+                "skipped_providers": {
+                    "description": "Names of the providers not serving the route",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "batik_air"
+                    ]
+                },
                 "total_results": {
                     "description": "Total number of flights found",
                     "type": "integer",
@@ -410,6 +1372,26 @@ const docTemplate = `{
                 }
             }
         },
+        "internal_handler_flight.PriceBucketDTO": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "Number of matching flights",
+                    "type": "integer",
+                    "example": 3
+                },
+                "max": {
+                    "description": "Bucket upper bound (exclusive, inclusive for the last bucket)",
+                    "type": "number",
+                    "example": 810000
+                },
+                "min": {
+                    "description": "Bucket lower bound (inclusive)",
+                    "type": "number",
+                    "example": 650000
+                }
+            }
+        },
         "internal_handler_flight.PriceDTO": {
             "type": "object",
             "properties": {
@@ -418,10 +1400,98 @@ const docTemplate = `{
                     "type": "number",
                     "example": 1500000
                 },
+                "breakdown": {
+                    "description": "Itemized fare",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_handler_flight.FareBreakdownDTO"
+                        }
+                    ]
+                },
                 "currency": {
                     "description": "Currency code (ISO 4217)",
                     "type": "string",
                     "example": "IDR"
+                },
+                "formatted": {
+                    "description": "Amount formatted for the currency's locale",
+                    "type": "string",
+                    "example": "Rp 1.500.000"
+                }
+            }
+        },
+        "internal_handler_flight.PriceFacetDTO": {
+            "type": "object",
+            "properties": {
+                "histogram": {
+                    "description": "Equal-width price buckets",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handler_flight.PriceBucketDTO"
+                    }
+                },
+                "max": {
+                    "description": "Highest price before filtering",
+                    "type": "number",
+                    "example": 1450000
+                },
+                "min": {
+                    "description": "Lowest price before filtering",
+                    "type": "number",
+                    "example": 650000
+                }
+            }
+        },
+        "internal_handler_flight.RankingBoundDTO": {
+            "type": "object",
+            "properties": {
+                "max": {
+                    "description": "Highest value in the result set",
+                    "type": "number",
+                    "example": 1450000
+                },
+                "min": {
+                    "description": "Lowest value in the result set",
+                    "type": "number",
+                    "example": 650000
+                }
+            }
+        },
+        "internal_handler_flight.RankingExplanationDTO": {
+            "type": "object",
+            "properties": {
+                "bounds": {
+                    "description": "Result set min/max for factors normalized against it",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/internal_handler_flight.RankingBoundDTO"
+                    }
+                },
+                "components": {
+                    "description": "Normalized factor scores in [0,1], 0 being the best, keyed by factor",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number",
+                        "format": "float64"
+                    }
+                },
+                "provider_weight": {
+                    "description": "Provider weight if not 1; (provider_weight − 1) × 0.1 is subtracted from the weighted sum",
+                    "type": "number",
+                    "example": 1.5
+                },
+                "score": {
+                    "description": "Best-value score (lower is better)",
+                    "type": "number",
+                    "example": 0.35
+                },
+                "weights": {
+                    "description": "Weights applied to each factor",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number",
+                        "format": "float64"
+                    }
                 }
             }
         },
@@ -474,6 +1544,11 @@ const docTemplate = `{
                     ],
                     "example": "economy"
                 },
+                "currency": {
+                    "description": "ISO 4217 currency to display prices in (optional, default IDR)",
+                    "type": "string",
+                    "example": "USD"
+                },
                 "departureDate": {
                     "description": "Departure date in YYYY-MM-DD format",
                     "type": "string",
@@ -486,6 +1561,16 @@ const docTemplate = `{
                     "format": "IATA code",
                     "example": "DPS"
                 },
+                "explain": {
+                    "description": "Include each flight's ranking score breakdown (optional)",
+                    "type": "boolean",
+                    "example": false
+                },
+                "fields": {
+                    "description": "Comma-separated flight fields to return (optional, sparse fieldset)",
+                    "type": "string",
+                    "example": "flight_number,price,departure.datetime"
+                },
                 "filters": {
                     "description": "Optional filters for search results",
                     "allOf": [
@@ -507,22 +1592,51 @@ const docTemplate = `{
                     "minimum": 1,
                     "example": 2
                 },
-                "sortBy": {
-                    "description": "Sort order for results (optional)",
+                "preferredDepartureWindow": {
+                    "description": "Preferred local departure window, scored by the departureTime ranking factor (optional, default 06:00-22:00)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_handler_flight.TimeRangeDTO"
+                        }
+                    ]
+                },
+                "rankingProfile": {
+                    "description": "Ranking weights used for best-value sorting (optional, default balanced)",
                     "type": "string",
                     "enum": [
-                        "best",
-                        "price",
-                        "duration",
-                        "departure"
+                        "balanced",
+                        "business",
+                        "cheapest-ish",
+                        "fastest-ish"
                     ],
-                    "example": "price"
+                    "example": "business"
+                },
+                "sortBy": {
+                    "description": "Comma-separated sort keys with optional :asc/:desc (optional; keys: best, price, duration, departure, arrival, stops, seats, airline, pareto)",
+                    "type": "string",
+                    "example": "stops,price:asc,departure:desc"
+                },
+                "travelDocuments": {
+                    "description": "Passenger nationality and travel documents (optional)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_handler_flight.TravelDocumentsDTO"
+                        }
+                    ]
                 }
             }
         },
         "internal_handler_flight.SearchResponse": {
             "type": "object",
             "properties": {
+                "facets": {
+                    "description": "Filter facets computed over all gathered flights",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_handler_flight.FacetsDTO"
+                        }
+                    ]
+                },
                 "flights": {
                     "description": "List of available flights matching criteria",
                     "type": "array",
@@ -548,6 +1662,93 @@ const docTemplate = `{
                 }
             }
         },
+        "internal_handler_flight.SelfTransferDTO": {
+            "type": "object",
+            "properties": {
+                "connection_airport": {
+                    "description": "Airport where the passenger changes flights",
+                    "type": "string",
+                    "example": "SUB"
+                },
+                "connection_minutes": {
+                    "description": "Time between the first leg's arrival and the second leg's departure",
+                    "type": "integer",
+                    "example": 140
+                },
+                "legs": {
+                    "description": "The separately ticketed flights, in travel order",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handler_flight.SelfTransferLegDTO"
+                    }
+                },
+                "status": {
+                    "description": "protected if both legs are of the same airline group, otherwise unprotected",
+                    "type": "string",
+                    "example": "unprotected"
+                }
+            }
+        },
+        "internal_handler_flight.SelfTransferLegDTO": {
+            "type": "object",
+            "properties": {
+                "airline": {
+                    "description": "Airline information",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_handler_flight.AirlineDTO"
+                        }
+                    ]
+                },
+                "arrival": {
+                    "description": "Arrival information",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_handler_flight.LocationDTO"
+                        }
+                    ]
+                },
+                "departure": {
+                    "description": "Departure information",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_handler_flight.LocationDTO"
+                        }
+                    ]
+                },
+                "duration": {
+                    "description": "Flight duration",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_handler_flight.DurationDTO"
+                        }
+                    ]
+                },
+                "flight_number": {
+                    "description": "Flight number",
+                    "type": "string",
+                    "example": "QZ7510"
+                },
+                "id": {
+                    "description": "Unique flight identifier",
+                    "type": "string",
+                    "example": "QZ7510_AirAsia"
+                },
+                "price": {
+                    "description": "Price information",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_handler_flight.PriceDTO"
+                        }
+                    ]
+                },
+                "provider": {
+                    "description": "Provider the leg is booked with",
+                    "type": "string",
+                    "example": "AirAsia"
+                }
+            }
+        },
         "internal_handler_flight.TimeRangeDTO": {
             "type": "object",
             "required": [
@@ -567,7 +1768,32 @@ const docTemplate = `{
                 }
             }
         },
-        "internal_handler_response.HealthResponse": {
+        "internal_handler_flight.TravelDocumentsDTO": {
+            "type": "object",
+            "properties": {
+                "hasPassport": {
+                    "description": "Whether the passenger holds a valid passport",
+                    "type": "boolean",
+                    "example": true
+                },
+                "nationality": {
+                    "description": "Passenger nationality, ISO 3166-1 alpha-2 (optional)",
+                    "type": "string",
+                    "example": "ID"
+                },
+                "visas": {
+                    "description": "Countries (ISO 3166-1 alpha-2) the passenger holds a visa for (optional)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "JP"
+                    ]
+                }
+            }
+        },
+        "internal_handler_httputil.HealthResponse": {
             "type": "object",
             "properties": {
                 "status": {
@@ -576,11 +1802,110 @@ const docTemplate = `{
                     "example": "healthy"
                 }
             }
+        },
+        "internal_handler_reference.AirlineDTO": {
+            "type": "object",
+            "properties": {
+                "alliance": {
+                    "description": "Airline alliance, omitted if unaligned",
+                    "type": "string",
+                    "example": "SkyTeam"
+                },
+                "code": {
+                    "description": "IATA airline code",
+                    "type": "string",
+                    "example": "GA"
+                },
+                "group": {
+                    "description": "Parent airline group",
+                    "type": "string",
+                    "example": "Garuda Indonesia Group"
+                },
+                "logo": {
+                    "description": "Airline logo URL",
+                    "type": "string",
+                    "example": "https://pics.avs.io/200/200/GA.png"
+                },
+                "low_cost": {
+                    "description": "Whether the airline is a low-cost carrier",
+                    "type": "boolean",
+                    "example": false
+                },
+                "name": {
+                    "description": "Canonical airline name",
+                    "type": "string",
+                    "example": "Garuda Indonesia"
+                },
+                "on_time_rate": {
+                    "description": "Indicative share of flights arriving on time (0-1), omitted if unknown",
+                    "type": "number",
+                    "example": 0.88
+                }
+            }
+        },
+        "internal_handler_reference.AirlineListResponse": {
+            "type": "object",
+            "properties": {
+                "airlines": {
+                    "description": "All known airlines, sorted by code",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handler_reference.AirlineDTO"
+                    }
+                }
+            }
+        },
+        "internal_handler_reference.AirportDTO": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "description": "City served",
+                    "type": "string",
+                    "example": "Jakarta"
+                },
+                "code": {
+                    "description": "IATA airport code",
+                    "type": "string",
+                    "example": "CGK"
+                },
+                "country": {
+                    "description": "ISO 3166-1 alpha-2 country code",
+                    "type": "string",
+                    "example": "ID"
+                },
+                "name": {
+                    "description": "Airport name",
+                    "type": "string",
+                    "example": "Soekarno-Hatta International Airport"
+                },
+                "timezone": {
+                    "description": "IANA timezone",
+                    "type": "string",
+                    "example": "Asia/Jakarta"
+                }
+            }
+        },
+        "internal_handler_reference.AirportSearchResponse": {
+            "type": "object",
+            "properties": {
+                "airports": {
+                    "description": "Matching airports, best match first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handler_reference.AirportDTO"
+                    }
+                },
+                "query": {
+                    "description": "The search query",
+                    "type": "string",
+                    "example": "jak"
+                }
+            }
         }
     },
     "securityDefinitions": {
         "ApiKeyAuth": {
-            "description": "API Key for authentication (optional)",
+            "description": "API key for the admin endpoints, which are disabled unless ADMIN_API_KEY is set",
             "type": "apiKey",
             "name": "X-API-Key",
             "in": "header"
@@ -591,9 +1916,17 @@ const docTemplate = `{
             "description": "Flight search and information operations",
             "name": "flights"
         },
+        {
+            "description": "Reference data lookups such as airports and airlines",
+            "name": "reference"
+        },
         {
             "description": "Service health check operations",
             "name": "health"
+        },
+        {
+            "description": "Runtime provider administration",
+            "name": "admin"
         }
     ]
 }`
@@ -605,7 +1938,7 @@ var SwaggerInfo = &swag.Spec{
 	BasePath:         "",
 	Schemes:          []string{"http", "https"},
 	Title:            "Flight Search API",
-	Description:      "RESTful API for searching and aggregating flight information from multiple airline providers\nThis API aggregates flight data from Indonesian airlines including Garuda Indonesia, Lion Air, Batik Air, and AirAsia",
+	Description:      "RESTful API for searching and aggregating flight information from multiple airline providers\nThis API aggregates flight data from Indonesian airlines including Garuda Indonesia, Lion Air, Batik Air, AirAsia, Citilink, and Super Air Jet",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
 	LeftDelim:        "{{",
@@ -0,0 +1,75 @@
+package domain
+
+import "slices"
+
+// Route is a pair of airports served in both directions.
+type Route struct {
+	Origin      string
+	Destination string
+}
+
+// RouteCoverage declares the routes, airports and cabin classes a provider serves.
+// Empty fields are unrestricted, so the zero value covers every search.
+type RouteCoverage struct {
+	// Airports are the IATA codes of the airports served. A search is covered only if
+	// its origin and destination are among them.
+	Airports []string
+	// Routes are the airport pairs served, in either direction. A search is covered
+	// only if it is one of them.
+	Routes []Route
+	// Classes are the cabin classes offered.
+	Classes []string
+}
+
+// Covers reports whether a search may return flights from the provider.
+// A search without destination is covered if the origin is served.
+func (c RouteCoverage) Covers(criteria SearchCriteria) bool {
+	if len(c.Airports) > 0 {
+		if !slices.Contains(c.Airports, criteria.Origin) {
+			return false
+		}
+		if criteria.Destination != "" && !slices.Contains(c.Airports, criteria.Destination) {
+			return false
+		}
+	}
+
+	if len(c.Routes) > 0 && !slices.ContainsFunc(c.Routes, func(r Route) bool {
+		return r.serves(criteria.Origin, criteria.Destination)
+	}) {
+		return false
+	}
+
+	if len(c.Classes) > 0 && criteria.Class != "" && !slices.Contains(c.Classes, criteria.Class) {
+		return false
+	}
+
+	return true
+}
+
+// serves reports whether the route connects origin with destination, in either
+// direction. An empty destination matches any route served from the origin.
+func (r Route) serves(origin, destination string) bool {
+	if destination == "" {
+		return r.Origin == origin || r.Destination == origin
+	}
+	return (r.Origin == origin && r.Destination == destination) ||
+		(r.Origin == destination && r.Destination == origin)
+}
+
+// CoverageProvider is implemented by flight providers declaring their route coverage.
+// Searches the coverage does not include are not sent to the provider. Providers not
+// implementing it are queried for every search.
+type CoverageProvider interface {
+	// Coverage returns the routes, airports and cabin classes the provider serves.
+	Coverage() RouteCoverage
+}
+
+// ProviderCovers reports whether the provider serves the search criteria, according
+// to its declared coverage if it implements CoverageProvider.
+func ProviderCovers(provider FlightProvider, criteria SearchCriteria) bool {
+	declarer, ok := provider.(CoverageProvider)
+	if !ok {
+		return true
+	}
+	return declarer.Coverage().Covers(criteria)
+}
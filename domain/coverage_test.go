@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouteCoverage_Covers(t *testing.T) {
+	airports := RouteCoverage{
+		Airports: []string{"CGK", "DPS", "SUB"},
+		Classes:  []string{"economy"},
+	}
+	routes := RouteCoverage{
+		Routes: []Route{{Origin: "CGK", Destination: "DPS"}},
+	}
+
+	tests := []struct {
+		name     string
+		coverage RouteCoverage
+		criteria SearchCriteria
+		want     bool
+	}{
+		{"zero value covers everything", RouteCoverage{}, SearchCriteria{Origin: "CGK", Destination: "LOP", Class: "first"}, true},
+		{"airports served", airports, SearchCriteria{Origin: "CGK", Destination: "SUB", Class: "economy"}, true},
+		{"origin not served", airports, SearchCriteria{Origin: "LOP", Destination: "SUB", Class: "economy"}, false},
+		{"destination not served", airports, SearchCriteria{Origin: "CGK", Destination: "LOP", Class: "economy"}, false},
+		{"class not offered", airports, SearchCriteria{Origin: "CGK", Destination: "SUB", Class: "business"}, false},
+		{"no class", airports, SearchCriteria{Origin: "CGK", Destination: "SUB"}, true},
+		{"no destination", airports, SearchCriteria{Origin: "DPS", Class: "economy"}, true},
+		{"route served", routes, SearchCriteria{Origin: "CGK", Destination: "DPS"}, true},
+		{"route served in reverse", routes, SearchCriteria{Origin: "DPS", Destination: "CGK"}, true},
+		{"route not served", routes, SearchCriteria{Origin: "CGK", Destination: "SUB"}, false},
+		{"route from origin", routes, SearchCriteria{Origin: "DPS"}, true},
+		{"no route from origin", routes, SearchCriteria{Origin: "SUB"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.coverage.Covers(tt.criteria))
+		})
+	}
+}
+
+// coveringProviderMock is a FlightProvider declaring its coverage.
+type coveringProviderMock struct {
+	SimpleFlightProviderMock
+	coverage RouteCoverage
+}
+
+func (m *coveringProviderMock) Coverage() RouteCoverage {
+	return m.coverage
+}
+
+func TestProviderCovers(t *testing.T) {
+	criteria := SearchCriteria{Origin: "CGK", Destination: "DPS", Class: "economy"}
+
+	undeclared := NewSimpleFlightProviderMock("garuda", nil, nil)
+	assert.True(t, ProviderCovers(undeclared, criteria), "providers without coverage serve every route")
+
+	declared := &coveringProviderMock{
+		SimpleFlightProviderMock: *NewSimpleFlightProviderMock("airasia", nil, nil),
+		coverage:                 RouteCoverage{Airports: []string{"CGK", "SUB"}},
+	}
+	assert.False(t, ProviderCovers(declared, criteria))
+
+	criteria.Destination = "SUB"
+	assert.True(t, ProviderCovers(declared, criteria))
+}
@@ -0,0 +1,12 @@
+package domain
+
+import "context"
+
+// ExchangeRateProvider supplies currency exchange rates.
+// Implementations may read rates from a static file or a remote service.
+type ExchangeRateProvider interface {
+	// Rate returns how many units of currency to one unit of currency from is worth.
+	// Currency codes are ISO 4217 (e.g. "IDR", "USD").
+	// Returns an error wrapping ErrUnsupportedCurrency if either currency has no rate.
+	Rate(ctx context.Context, from, to string) (float64, error)
+}
@@ -23,6 +23,13 @@ var (
 	// ErrProviderUnavailable indicates a provider is not reachable.
 	ErrProviderUnavailable = errors.New("provider unavailable")
 
+	// ErrProviderRateLimited indicates a provider is over its rate or concurrency limit.
+	// The provider is skipped rather than queried.
+	ErrProviderRateLimited = errors.New("provider rate limited")
+
+	// ErrProviderNotFound indicates no provider is registered under a name (HTTP 404).
+	ErrProviderNotFound = errors.New("provider not found")
+
 	// ErrNoFlightsFound indicates no flights matched the search criteria.
 	// This is not necessarily an error but useful for explicit handling.
 	ErrNoFlightsFound = errors.New("no flights found")
@@ -34,6 +41,9 @@ var (
 	// ErrMissingRequiredField indicates a required field is missing from flight data.
 	// This represents incomplete data from a provider.
 	ErrMissingRequiredField = errors.New("missing required field")
+
+	// ErrUnsupportedCurrency indicates no exchange rate is available for a currency.
+	ErrUnsupportedCurrency = errors.New("unsupported currency")
 )
 
 // ProviderError wraps an error with provider context.
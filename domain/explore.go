@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// ExploreCriteria defines the parameters for an explore search: the cheapest fares from an
+// origin to every destination the providers serve, over a range of departure dates.
+type ExploreCriteria struct {
+	Origin            string `json:"origin"`
+	DepartureDateFrom string `json:"departureDateFrom"`
+	DepartureDateTo   string `json:"departureDateTo"`
+	Passengers        int    `json:"passengers"`
+	Class             string `json:"class,omitempty"`
+}
+
+// Validate checks if the explore criteria is valid.
+func (e *ExploreCriteria) Validate() error {
+	if e.Origin == "" {
+		return fmt.Errorf("%w: origin is required", ErrInvalidRequest)
+	}
+	if !airportCodeRegex.MatchString(e.Origin) {
+		return fmt.Errorf("%w: origin must be a valid 3-letter IATA code, got %q", ErrInvalidRequest, e.Origin)
+	}
+	from, err := parseExploreDate("departureDateFrom", e.DepartureDateFrom)
+	if err != nil {
+		return err
+	}
+	to, err := parseExploreDate("departureDateTo", e.DepartureDateTo)
+	if err != nil {
+		return err
+	}
+	if to.Before(from) {
+		return fmt.Errorf("%w: departureDateTo must not be before departureDateFrom", ErrInvalidRequest)
+	}
+	if e.Passengers < 1 {
+		return fmt.Errorf("%w: passengers must be at least 1", ErrInvalidRequest)
+	}
+	if e.Class != "" && !validClasses[e.Class] {
+		return fmt.Errorf("%w: class must be one of: economy, business, first; got %q", ErrInvalidRequest, e.Class)
+	}
+	return nil
+}
+
+// parseExploreDate parses a required YYYY-MM-DD date field.
+func parseExploreDate(field, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, fmt.Errorf("%w: %s is required", ErrInvalidRequest, field)
+	}
+	if !dateRegex.MatchString(value) {
+		return time.Time{}, fmt.Errorf("%w: %s must be in YYYY-MM-DD format, got %q", ErrInvalidRequest, field, value)
+	}
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %s is not a valid date: %s", ErrInvalidRequest, field, value)
+	}
+	return date, nil
+}
+
+// SetDefaults applies default values to empty optional fields.
+func (e *ExploreCriteria) SetDefaults() {
+	if e.Passengers == 0 {
+		e.Passengers = 1
+	}
+	if e.Class == "" {
+		e.Class = "economy"
+	}
+}
+
+// Days returns the number of departure dates in the range, without building it.
+// Returns 0 if the range is invalid.
+func (e *ExploreCriteria) Days() int {
+	from, to, ok := e.dateRange()
+	if !ok || to.Before(from) {
+		return 0
+	}
+	return int(to.Sub(from).Hours()/24) + 1
+}
+
+// Dates returns every departure date of the range, in order, in YYYY-MM-DD format.
+// Returns nil if the range is invalid. Check Days first to bound long ranges.
+func (e *ExploreCriteria) Dates() []string {
+	from, to, ok := e.dateRange()
+	if !ok {
+		return nil
+	}
+
+	var dates []string
+	for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
+		dates = append(dates, d.Format("2006-01-02"))
+	}
+	return dates
+}
+
+// dateRange parses the first and last departure dates, reporting false if either is invalid.
+func (e *ExploreCriteria) dateRange() (from, to time.Time, ok bool) {
+	from, err := time.Parse("2006-01-02", e.DepartureDateFrom)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	to, err = time.Parse("2006-01-02", e.DepartureDateTo)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	return from, to, true
+}
+
+// SearchCriteria returns the criteria for searching every destination on one departure date.
+func (e *ExploreCriteria) SearchCriteria(date string) SearchCriteria {
+	return SearchCriteria{
+		Origin:        e.Origin,
+		DepartureDate: date,
+		Passengers:    e.Passengers,
+		Class:         e.Class,
+	}
+}
+
+// DestinationFare is the cheapest fare found to a destination.
+type DestinationFare struct {
+	Destination string `json:"destination"` // Destination airport IATA code
+	City        string `json:"city"`        // Destination city (empty if unknown)
+	Country     string `json:"country"`     // Destination ISO 3166-1 alpha-2 country code (empty if unknown)
+	Flight      Flight `json:"flight"`      // The cheapest flight to the destination over the date range
+}
+
+// ExploreResponse represents the cheapest fare per destination from an origin.
+type ExploreResponse struct {
+	Destinations []DestinationFare `json:"destinations"`
+	Metadata     SearchMetadata    `json:"metadata"`
+}
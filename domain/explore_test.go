@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExploreCriteriaValidate(t *testing.T) {
+	validCriteria := ExploreCriteria{
+		Origin:            "CGK",
+		DepartureDateFrom: "2025-12-15",
+		DepartureDateTo:   "2025-12-20",
+		Passengers:        1,
+		Class:             "economy",
+	}
+
+	tests := []struct {
+		name          string
+		modify        func(*ExploreCriteria)
+		errorContains string
+	}{
+		{name: "valid criteria"},
+		{name: "single day", modify: func(c *ExploreCriteria) { c.DepartureDateTo = c.DepartureDateFrom }},
+		{name: "empty origin", modify: func(c *ExploreCriteria) { c.Origin = "" }, errorContains: "origin is required"},
+		{name: "invalid origin", modify: func(c *ExploreCriteria) { c.Origin = "cgk" }, errorContains: "3-letter IATA code"},
+		{name: "missing start date", modify: func(c *ExploreCriteria) { c.DepartureDateFrom = "" }, errorContains: "departureDateFrom is required"},
+		{name: "invalid end date format", modify: func(c *ExploreCriteria) { c.DepartureDateTo = "20-12-2025" }, errorContains: "departureDateTo must be in YYYY-MM-DD format"},
+		{name: "invalid end date", modify: func(c *ExploreCriteria) { c.DepartureDateTo = "2025-02-30" }, errorContains: "departureDateTo is not a valid date"},
+		{name: "end before start", modify: func(c *ExploreCriteria) { c.DepartureDateTo = "2025-12-14" }, errorContains: "must not be before departureDateFrom"},
+		{name: "zero passengers", modify: func(c *ExploreCriteria) { c.Passengers = 0 }, errorContains: "passengers must be at least 1"},
+		{name: "invalid class", modify: func(c *ExploreCriteria) { c.Class = "premium" }, errorContains: "class must be one of"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			criteria := validCriteria
+			if tt.modify != nil {
+				tt.modify(&criteria)
+			}
+
+			err := criteria.Validate()
+
+			if tt.errorContains == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.ErrorIs(t, err, ErrInvalidRequest)
+			assert.Contains(t, err.Error(), tt.errorContains)
+		})
+	}
+}
+
+func TestExploreCriteriaDates(t *testing.T) {
+	criteria := ExploreCriteria{DepartureDateFrom: "2025-12-30", DepartureDateTo: "2026-01-02"}
+	assert.Equal(t, []string{"2025-12-30", "2025-12-31", "2026-01-01", "2026-01-02"}, criteria.Dates())
+
+	invalid := ExploreCriteria{DepartureDateFrom: "2025-12-30"}
+	assert.Nil(t, invalid.Dates())
+}
+
+func TestExploreCriteriaDays(t *testing.T) {
+	tests := []struct {
+		name     string
+		criteria ExploreCriteria
+		expected int
+	}{
+		{"single day", ExploreCriteria{DepartureDateFrom: "2025-12-15", DepartureDateTo: "2025-12-15"}, 1},
+		{"across year end", ExploreCriteria{DepartureDateFrom: "2025-12-30", DepartureDateTo: "2026-01-02"}, 4},
+		{"several years", ExploreCriteria{DepartureDateFrom: "2025-01-01", DepartureDateTo: "2034-12-31"}, 3652},
+		{"reversed range", ExploreCriteria{DepartureDateFrom: "2025-12-16", DepartureDateTo: "2025-12-15"}, 0},
+		{"invalid date", ExploreCriteria{DepartureDateFrom: "2025-12-30"}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.criteria.Days())
+		})
+	}
+}
+
+func TestExploreCriteriaSearchCriteria(t *testing.T) {
+	criteria := ExploreCriteria{Origin: "CGK", DepartureDateFrom: "2025-12-15", DepartureDateTo: "2025-12-16"}
+	criteria.SetDefaults()
+
+	assert.Equal(t, SearchCriteria{
+		Origin:        "CGK",
+		DepartureDate: "2025-12-16",
+		Passengers:    1,
+		Class:         "economy",
+	}, criteria.SearchCriteria("2025-12-16"))
+}
@@ -0,0 +1,42 @@
+package domain
+
+// SearchFacets summarizes the gathered flights for building a filter sidebar.
+// Value lists and ranges are computed over all gathered flights (before filtering),
+// while counts reflect every active filter except the one on the facet's own dimension.
+type SearchFacets struct {
+	Airlines       []FacetCount  `json:"airlines"`
+	Stops          []FacetCount  `json:"stops"`
+	DepartureTimes []FacetCount  `json:"departureTimes"`
+	Price          PriceFacet    `json:"price"`
+	Duration       DurationFacet `json:"duration"`
+	Amenities      []FacetCount  `json:"amenities"`
+}
+
+// FacetCount is a single facet value with the number of matching flights.
+type FacetCount struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+	Count int    `json:"count"`
+}
+
+// PriceFacet contains the price bounds and a histogram of flight prices.
+type PriceFacet struct {
+	Min       float64       `json:"min"`
+	Max       float64       `json:"max"`
+	Histogram []PriceBucket `json:"histogram"`
+}
+
+// PriceBucket is a single price histogram bucket covering [Min, Max).
+// The last bucket also includes its upper bound.
+type PriceBucket struct {
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+	Count int     `json:"count"`
+}
+
+// DurationFacet contains the duration bounds and the number of matching flights.
+type DurationFacet struct {
+	MinMinutes int `json:"minMinutes"`
+	MaxMinutes int `json:"maxMinutes"`
+	Count      int `json:"count"`
+}
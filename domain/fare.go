@@ -0,0 +1,94 @@
+package domain
+
+// Fare components itemized in a FareBreakdown.
+const (
+	FareComponentBase       = "base"
+	FareComponentTaxes      = "taxes"
+	FareComponentFees       = "fees"
+	FareComponentSurcharges = "surcharges"
+)
+
+// FareBreakdown itemizes a fare. The components are per passenger; a nil component
+// means the provider does not report it. PerPassenger, Passengers and Total are set
+// from the price by PriceInfo.ApplyPassengers.
+type FareBreakdown struct {
+	BaseFare   *float64 `json:"baseFare,omitempty"`   // Fare before taxes, fees and surcharges
+	Taxes      *float64 `json:"taxes,omitempty"`      // Government taxes
+	Fees       *float64 `json:"fees,omitempty"`       // Airport and service fees
+	Surcharges *float64 `json:"surcharges,omitempty"` // Fuel and other carrier surcharges
+
+	PerPassenger float64 `json:"perPassenger"` // Price for a single passenger
+	Passengers   int     `json:"passengers"`   // Number of passengers Total covers
+	Total        float64 `json:"total"`        // Price for all passengers
+}
+
+// UnknownComponents returns the names of the components the provider does not report,
+// in breakdown order. Returns nil if every component is known.
+func (b FareBreakdown) UnknownComponents() []string {
+	var unknown []string
+	for _, c := range []struct {
+		name   string
+		amount *float64
+	}{
+		{FareComponentBase, b.BaseFare},
+		{FareComponentTaxes, b.Taxes},
+		{FareComponentFees, b.Fees},
+		{FareComponentSurcharges, b.Surcharges},
+	} {
+		if c.amount == nil {
+			unknown = append(unknown, c.name)
+		}
+	}
+	return unknown
+}
+
+// Scaled returns a copy of the breakdown with every amount multiplied by rate,
+// used when converting the fare to another currency.
+func (b FareBreakdown) Scaled(rate float64) FareBreakdown {
+	scale := func(v *float64) *float64 {
+		if v == nil {
+			return nil
+		}
+		scaled := *v * rate
+		return &scaled
+	}
+
+	b.BaseFare = scale(b.BaseFare)
+	b.Taxes = scale(b.Taxes)
+	b.Fees = scale(b.Fees)
+	b.Surcharges = scale(b.Surcharges)
+	b.PerPassenger *= rate
+	b.Total *= rate
+	return b
+}
+
+// Plus returns the breakdown of a fare combining both fares, such as the legs of a
+// self-transfer. A component is known only if it is known in both breakdowns.
+// PerPassenger, Passengers and Total are left unset.
+func (b FareBreakdown) Plus(o FareBreakdown) FareBreakdown {
+	add := func(x, y *float64) *float64 {
+		if x == nil || y == nil {
+			return nil
+		}
+		sum := *x + *y
+		return &sum
+	}
+
+	return FareBreakdown{
+		BaseFare:   add(b.BaseFare, o.BaseFare),
+		Taxes:      add(b.Taxes, o.Taxes),
+		Fees:       add(b.Fees, o.Fees),
+		Surcharges: add(b.Surcharges, o.Surcharges),
+	}
+}
+
+// ApplyPassengers sets the breakdown's per-passenger price from Amount and its total
+// for the given number of passengers. A count below 1 is treated as 1.
+func (p *PriceInfo) ApplyPassengers(passengers int) {
+	if passengers < 1 {
+		passengers = 1
+	}
+	p.Breakdown.PerPassenger = p.Amount
+	p.Breakdown.Passengers = passengers
+	p.Breakdown.Total = p.Amount * float64(passengers)
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFareBreakdownUnknownComponents(t *testing.T) {
+	amount := func(v float64) *float64 { return &v }
+
+	tests := []struct {
+		name      string
+		breakdown FareBreakdown
+		expected  []string
+	}{
+		{"nothing reported", FareBreakdown{}, []string{FareComponentBase, FareComponentTaxes, FareComponentFees, FareComponentSurcharges}},
+		{"base and taxes reported", FareBreakdown{BaseFare: amount(900), Taxes: amount(100)}, []string{FareComponentFees, FareComponentSurcharges}},
+		{"fully itemized", FareBreakdown{BaseFare: amount(900), Taxes: amount(100), Fees: amount(0), Surcharges: amount(0)}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.breakdown.UnknownComponents())
+		})
+	}
+}
+
+func TestFareBreakdownScaled(t *testing.T) {
+	base, taxes := 800000.0, 160000.0
+	original := FareBreakdown{BaseFare: &base, Taxes: &taxes, PerPassenger: 960000, Passengers: 2, Total: 1920000}
+
+	scaled := original.Scaled(0.5)
+
+	require.NotNil(t, scaled.BaseFare)
+	require.NotNil(t, scaled.Taxes)
+	assert.Equal(t, 400000.0, *scaled.BaseFare)
+	assert.Equal(t, 80000.0, *scaled.Taxes)
+	assert.Nil(t, scaled.Fees)
+	assert.Nil(t, scaled.Surcharges)
+	assert.Equal(t, 480000.0, scaled.PerPassenger)
+	assert.Equal(t, 2, scaled.Passengers)
+	assert.Equal(t, 960000.0, scaled.Total)
+
+	// The original breakdown is not modified
+	assert.Equal(t, 800000.0, base)
+	assert.Equal(t, 1920000.0, original.Total)
+}
+
+func TestPriceInfoApplyPassengers(t *testing.T) {
+	tests := []struct {
+		name             string
+		passengers       int
+		expectPassengers int
+		expectTotal      float64
+	}{
+		{"single passenger", 1, 1, 500000},
+		{"multiple passengers", 3, 3, 1500000},
+		{"zero treated as one", 0, 1, 500000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := PriceInfo{Amount: 500000, Currency: "IDR"}
+			p.ApplyPassengers(tt.passengers)
+
+			assert.Equal(t, 500000.0, p.Breakdown.PerPassenger)
+			assert.Equal(t, tt.expectPassengers, p.Breakdown.Passengers)
+			assert.Equal(t, tt.expectTotal, p.Breakdown.Total)
+		})
+	}
+}
+
+func TestFareBreakdownPlus(t *testing.T) {
+	amount := func(v float64) *float64 { return &v }
+
+	first := FareBreakdown{BaseFare: amount(400000), Taxes: amount(40000), Fees: amount(10000)}
+	second := FareBreakdown{BaseFare: amount(500000), Taxes: amount(50000)}
+
+	sum := first.Plus(second)
+
+	require.NotNil(t, sum.BaseFare)
+	require.NotNil(t, sum.Taxes)
+	assert.Equal(t, 900000.0, *sum.BaseFare)
+	assert.Equal(t, 90000.0, *sum.Taxes)
+	assert.Nil(t, sum.Fees, "fees are unknown for the second fare")
+	assert.Nil(t, sum.Surcharges)
+
+	// The operands are not modified
+	assert.Equal(t, 400000.0, *first.BaseFare)
+	assert.Equal(t, 500000.0, *second.BaseFare)
+}
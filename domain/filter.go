@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"fmt"
 	"strings"
 	"time"
 )
@@ -13,26 +14,106 @@ const (
 	SortByPrice     SortOption = "price"
 	SortByDuration  SortOption = "duration"
 	SortByDeparture SortOption = "departure"
+	SortByArrival   SortOption = "arrival"
+	SortByStops     SortOption = "stops"
+	SortBySeats     SortOption = "seats"   // Available seats
+	SortByAirline   SortOption = "airline" // Airline name
+	SortByPareto    SortOption = "pareto"  // Pareto frontier over price, duration and stops, cheapest first
 )
 
+// sortOptions lists every SortOption, in the order used in error messages.
+var sortOptions = []SortOption{
+	SortByBestValue, SortByPrice, SortByDuration, SortByDeparture,
+	SortByArrival, SortByStops, SortBySeats, SortByAirline, SortByPareto,
+}
+
 // IsValid checks if the sort option is a valid value.
 func (s SortOption) IsValid() bool {
-	switch s {
-	case SortByBestValue, SortByPrice, SortByDuration, SortByDeparture:
-		return true
-	default:
-		return false
+	for _, option := range sortOptions {
+		if s == option {
+			return true
+		}
+	}
+	return false
+}
+
+// Sort directions of a sort key.
+const (
+	SortAscending  = "asc"
+	SortDescending = "desc"
+)
+
+// SortKey is a sort option with a direction. Flights equal on a key are ordered by
+// the next key.
+type SortKey struct {
+	Option     SortOption
+	Descending bool
+}
+
+// ParseSortKeys parses a comma-separated list of sort keys in priority order, each an
+// option optionally followed by ":asc" or ":desc" (e.g. "stops,price:asc,departure:desc").
+// Keys are case-insensitive and ascending by default. SortByPareto cannot be combined
+// with other keys or a direction.
+func ParseSortKeys(s string) ([]SortKey, error) {
+	parts := strings.Split(s, ",")
+	keys := make([]SortKey, 0, len(parts))
+	seen := make(map[SortOption]struct{}, len(parts))
+
+	for _, part := range parts {
+		name, direction, hasDirection := strings.Cut(strings.TrimSpace(part), ":")
+		option := SortOption(strings.ToLower(strings.TrimSpace(name)))
+		if !option.IsValid() {
+			names := make([]string, len(sortOptions))
+			for i, o := range sortOptions {
+				names[i] = string(o)
+			}
+			return nil, fmt.Errorf("sort key must be one of: %s; got %q", strings.Join(names, ", "), name)
+		}
+		if _, dup := seen[option]; dup {
+			return nil, fmt.Errorf("duplicate sort key %q", option)
+		}
+		seen[option] = struct{}{}
+
+		key := SortKey{Option: option}
+		if hasDirection {
+			switch strings.ToLower(strings.TrimSpace(direction)) {
+			case SortAscending:
+			case SortDescending:
+				key.Descending = true
+			default:
+				return nil, fmt.Errorf("sort direction of %q must be %s or %s; got %q", option, SortAscending, SortDescending, direction)
+			}
+		}
+		if option == SortByPareto && (len(parts) > 1 || hasDirection) {
+			return nil, fmt.Errorf("sort key %q cannot be combined with other keys or a direction", option)
+		}
+
+		keys = append(keys, key)
 	}
+
+	return keys, nil
 }
 
 // FilterOptions defines optional filters to apply to flight results.
 type FilterOptions struct {
-	MaxPrice           *float64       `json:"maxPrice,omitempty"`
-	MaxStops           *int           `json:"maxStops,omitempty"`
-	Airlines           []string       `json:"airlines,omitempty"`
-	DepartureTimeRange *TimeRange     `json:"departureTimeRange,omitempty"`
-	ArrivalTimeRange   *TimeRange     `json:"arrivalTimeRange,omitempty"`
-	DurationRange      *DurationRange `json:"durationRange,omitempty"`
+	MinPrice            *float64       `json:"minPrice,omitempty"`
+	MaxPrice            *float64       `json:"maxPrice,omitempty"`
+	MaxStops            *int           `json:"maxStops,omitempty"`
+	Airlines            []string       `json:"airlines,omitempty"`
+	ExcludedAirlines    []string       `json:"excludedAirlines,omitempty"`
+	DepartureTimeRange  *TimeRange     `json:"departureTimeRange,omitempty"`
+	ArrivalTimeRange    *TimeRange     `json:"arrivalTimeRange,omitempty"`
+	DurationRange       *DurationRange `json:"durationRange,omitempty"`
+	Amenities           []string       `json:"amenities,omitempty"`           // Required amenities; a flight must offer all of them
+	MinCheckedBaggageKg *int           `json:"minCheckedBaggageKg,omitempty"` // Minimum included checked baggage in kg
+	Aircraft            []string       `json:"aircraft,omitempty"`            // Aircraft types or families (e.g. "737", "A320"); any may match
+	DepartureTerminals  []string       `json:"departureTerminals,omitempty"`
+	ArrivalTerminals    []string       `json:"arrivalTerminals,omitempty"`
+
+	// Layover constraints; flights without layover details are not excluded by these
+	LayoverDurationRange       *DurationRange `json:"layoverDurationRange,omitempty"`       // Every layover must fall within this range
+	ExcludedConnectionAirports []string       `json:"excludedConnectionAirports,omitempty"` // Connection airports to avoid
+	NoOvernightLayovers        bool           `json:"noOvernightLayovers,omitempty"`
 }
 
 // TimeRange represents a time window for filtering.
@@ -78,7 +159,10 @@ func (dr *DurationRange) Contains(durationMinutes int) bool {
 	return true
 }
 
-// Contains checks if a given time falls within the time range.
+// Contains checks if a given time of day falls within the time range (inclusive).
+// The time of day is read in t's own location, so callers should pass a time
+// already converted to the relevant local timezone (see FlightPoint.LocalTime).
+// A range whose end is before its start crosses midnight, e.g. 22:00-02:00.
 func (tr *TimeRange) Contains(t time.Time) bool {
 	if tr == nil {
 		return true
@@ -86,43 +170,132 @@ func (tr *TimeRange) Contains(t time.Time) bool {
 	tMinutes := t.Hour()*60 + t.Minute()
 	startMinutes := tr.Start.Hour()*60 + tr.Start.Minute()
 	endMinutes := tr.End.Hour()*60 + tr.End.Minute()
+	if startMinutes > endMinutes {
+		return tMinutes >= startMinutes || tMinutes <= endMinutes
+	}
 	return tMinutes >= startMinutes && tMinutes <= endMinutes
 }
 
 // MatchesFlight checks if a flight matches all the filter criteria.
 func (f *FilterOptions) MatchesFlight(flight Flight) bool {
+	return f.Matcher()(flight)
+}
+
+// Matcher returns a function checking if a flight matches all the filter criteria.
+// The code lists are turned into lookup sets once, so the returned function suits
+// filtering many flights.
+func (f *FilterOptions) Matcher() func(Flight) bool {
 	if f == nil {
-		return true
+		return func(Flight) bool { return true }
 	}
-	if f.MaxPrice != nil && flight.Price.Amount > *f.MaxPrice {
-		return false
-	}
-	if f.MaxStops != nil && flight.Stops > *f.MaxStops {
-		return false
-	}
-	if len(f.Airlines) > 0 {
-		found := false
-		flightCode := strings.ToUpper(flight.Airline.Code)
-		for _, code := range f.Airlines {
-			if strings.ToUpper(code) == flightCode {
-				found = true
-				break
-			}
+
+	// Pre-build lookup sets for O(1) membership checks
+	airlines := buildCodeSet(f.Airlines)
+	excludedAirlines := buildCodeSet(f.ExcludedAirlines)
+	departureTerminals := buildCodeSet(f.DepartureTerminals)
+	arrivalTerminals := buildCodeSet(f.ArrivalTerminals)
+
+	return func(flight Flight) bool {
+		// Price filters: include flights where minPrice <= price <= maxPrice
+		if f.MinPrice != nil && flight.Price.Amount < *f.MinPrice {
+			return false
 		}
-		if !found {
+		if f.MaxPrice != nil && flight.Price.Amount > *f.MaxPrice {
 			return false
 		}
+
+		// Stops filter: include flights where stops <= maxStops
+		if f.MaxStops != nil && flight.Stops > *f.MaxStops {
+			return false
+		}
+
+		// Airline filters: include whitelisted and drop blacklisted airline codes
+		if len(f.Airlines) > 0 && !inSet(flight.Airline.Code, airlines) {
+			return false
+		}
+		if len(f.ExcludedAirlines) > 0 && inSet(flight.Airline.Code, excludedAirlines) {
+			return false
+		}
+
+		// Time range filters, evaluated in each airport's local time
+		if f.DepartureTimeRange != nil && !f.DepartureTimeRange.Contains(flight.Departure.LocalTime()) {
+			return false
+		}
+		if f.ArrivalTimeRange != nil && !f.ArrivalTimeRange.Contains(flight.Arrival.LocalTime()) {
+			return false
+		}
+
+		// Duration range filter: include flights with duration within the range
+		if f.DurationRange != nil && !f.DurationRange.Contains(flight.Duration.TotalMinutes) {
+			return false
+		}
+
+		// Amenities filter: include flights offering every required amenity
+		if len(f.Amenities) > 0 && !flight.HasAmenities(f.Amenities) {
+			return false
+		}
+
+		// Checked baggage filter: include flights with at least the minimum included allowance
+		if f.MinCheckedBaggageKg != nil && flight.Baggage.CheckedKg < *f.MinCheckedBaggageKg {
+			return false
+		}
+
+		// Aircraft filter: include flights whose aircraft matches any requested type or family
+		if len(f.Aircraft) > 0 && !flight.MatchesAircraft(f.Aircraft) {
+			return false
+		}
+
+		// Terminal filters: include flights departing from / arriving at a requested terminal
+		if len(f.DepartureTerminals) > 0 && !inSet(flight.Departure.Terminal, departureTerminals) {
+			return false
+		}
+		if len(f.ArrivalTerminals) > 0 && !inSet(flight.Arrival.Terminal, arrivalTerminals) {
+			return false
+		}
+
+		// Layover filters: every layover must satisfy the duration, airport and overnight constraints
+		if f.hasLayoverFilter() && !flight.MatchesLayovers(f.LayoverDurationRange, f.ExcludedConnectionAirports, f.NoOvernightLayovers) {
+			return false
+		}
+
+		return true
 	}
-	if f.DepartureTimeRange != nil && !f.DepartureTimeRange.Contains(flight.Departure.DateTime) {
-		return false
-	}
-	if f.ArrivalTimeRange != nil && !f.ArrivalTimeRange.Contains(flight.Arrival.DateTime) {
-		return false
+}
+
+// hasLayoverFilter reports whether any layover constraint is set.
+func (f *FilterOptions) hasLayoverFilter() bool {
+	return f.LayoverDurationRange != nil || len(f.ExcludedConnectionAirports) > 0 || f.NoOvernightLayovers
+}
+
+// buildCodeSet creates a case-insensitive lookup set from codes such as airline
+// codes or terminals.
+func buildCodeSet(codes []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(codes))
+	for _, code := range codes {
+		set[normalizeCode(code)] = struct{}{}
 	}
-	if f.DurationRange != nil && !f.DurationRange.Contains(flight.Duration.TotalMinutes) {
-		return false
+	return set
+}
+
+// inSet checks if a code is in a set built by buildCodeSet (case-insensitive).
+func inSet(code string, set map[string]struct{}) bool {
+	_, exists := set[normalizeCode(code)]
+	return exists
+}
+
+// normalizeCode trims and uppercases a code for case-insensitive matching.
+func normalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// containsFold reports whether values contains target, ignoring case.
+func containsFold(values []string, target string) bool {
+	for _, v := range values {
+		if strings.EqualFold(strings.TrimSpace(v), target) {
+			return true
+		}
 	}
-	return true
+	return false
 }
 
 // ParseSortOption converts a string to a SortOption.
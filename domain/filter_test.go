@@ -16,6 +16,11 @@ func TestSortOptionIsValid(t *testing.T) {
 		{SortByPrice, true},
 		{SortByDuration, true},
 		{SortByDeparture, true},
+		{SortByArrival, true},
+		{SortByStops, true},
+		{SortBySeats, true},
+		{SortByAirline, true},
+		{SortByPareto, true},
 		{SortOption("invalid"), false},
 		{SortOption(""), false},
 		{SortOption("BEST"), false},
@@ -49,6 +54,83 @@ func TestParseSortOption(t *testing.T) {
 	}
 }
 
+func TestParseSortKeys(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []SortKey
+		wantErr  string
+	}{
+		{
+			name:     "single key",
+			input:    "price",
+			expected: []SortKey{{Option: SortByPrice}},
+		},
+		{
+			name:  "keys with directions",
+			input: "stops,price:asc,departure:desc",
+			expected: []SortKey{
+				{Option: SortByStops},
+				{Option: SortByPrice},
+				{Option: SortByDeparture, Descending: true},
+			},
+		},
+		{
+			name:     "case-insensitive with spaces",
+			input:    " Seats:DESC , Airline ",
+			expected: []SortKey{{Option: SortBySeats, Descending: true}, {Option: SortByAirline}},
+		},
+		{
+			name:     "pareto alone",
+			input:    "pareto",
+			expected: []SortKey{{Option: SortByPareto}},
+		},
+		{
+			name:    "unknown key",
+			input:   "price,cheapest",
+			wantErr: `sort key must be one of: best, price, duration, departure, arrival, stops, seats, airline, pareto; got "cheapest"`,
+		},
+		{
+			name:    "empty key",
+			input:   "price,",
+			wantErr: "sort key must be one of",
+		},
+		{
+			name:    "invalid direction",
+			input:   "price:up",
+			wantErr: `sort direction of "price" must be asc or desc; got "up"`,
+		},
+		{
+			name:    "duplicate key",
+			input:   "price,price:desc",
+			wantErr: `duplicate sort key "price"`,
+		},
+		{
+			name:    "pareto combined with other keys",
+			input:   "pareto,price",
+			wantErr: `sort key "pareto" cannot be combined with other keys or a direction`,
+		},
+		{
+			name:    "pareto with direction",
+			input:   "pareto:desc",
+			wantErr: `sort key "pareto" cannot be combined with other keys or a direction`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, err := ParseSortKeys(tt.input)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				assert.Nil(t, keys)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, keys)
+		})
+	}
+}
+
 func TestDurationRangeIsValid(t *testing.T) {
 	intPtr := func(v int) *int { return &v }
 
@@ -212,6 +294,30 @@ func TestTimeRangeContains(t *testing.T) {
 			t:        makeTime(13, 0),
 			expected: false,
 		},
+		{
+			name:     "crossing midnight before midnight",
+			tr:       &TimeRange{Start: makeTime(22, 0), End: makeTime(2, 0)},
+			t:        makeTime(23, 30),
+			expected: true,
+		},
+		{
+			name:     "crossing midnight after midnight",
+			tr:       &TimeRange{Start: makeTime(22, 0), End: makeTime(2, 0)},
+			t:        makeTime(1, 15),
+			expected: true,
+		},
+		{
+			name:     "crossing midnight at end boundary",
+			tr:       &TimeRange{Start: makeTime(22, 0), End: makeTime(2, 0)},
+			t:        makeTime(2, 0),
+			expected: true,
+		},
+		{
+			name:     "crossing midnight outside range",
+			tr:       &TimeRange{Start: makeTime(22, 0), End: makeTime(2, 0)},
+			t:        makeTime(12, 0),
+			expected: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -378,6 +484,126 @@ func TestFilterOptionsMatchesFlight(t *testing.T) {
 			flight:   baseFlight,
 			expected: false,
 		},
+		{
+			name:     "price above min",
+			filter:   &FilterOptions{MinPrice: floatPtr(1000000)},
+			flight:   baseFlight,
+			expected: true,
+		},
+		{
+			name:     "price below min",
+			filter:   &FilterOptions{MinPrice: floatPtr(2000000)},
+			flight:   baseFlight,
+			expected: false,
+		},
+		{
+			name:     "airline excluded",
+			filter:   &FilterOptions{ExcludedAirlines: []string{"ga"}},
+			flight:   baseFlight,
+			expected: false,
+		},
+		{
+			name:     "airline not excluded",
+			filter:   &FilterOptions{ExcludedAirlines: []string{"JT", "QZ"}},
+			flight:   baseFlight,
+			expected: true,
+		},
+		{
+			name:     "required amenities present",
+			filter:   &FilterOptions{Amenities: []string{"WiFi", "meals"}},
+			flight:   Flight{Airline: AirlineInfo{Code: "GA"}, Amenities: []string{"wifi", "meal", "entertainment"}},
+			expected: true,
+		},
+		{
+			name:     "required amenity missing",
+			filter:   &FilterOptions{Amenities: []string{"wifi", "power_outlet"}},
+			flight:   Flight{Airline: AirlineInfo{Code: "GA"}, Amenities: []string{"wifi"}},
+			expected: false,
+		},
+		{
+			name:     "checked baggage meets minimum",
+			filter:   &FilterOptions{MinCheckedBaggageKg: intPtr(20)},
+			flight:   Flight{Baggage: BaggageInfo{CheckedKg: 20}},
+			expected: true,
+		},
+		{
+			name:     "checked baggage below minimum",
+			filter:   &FilterOptions{MinCheckedBaggageKg: intPtr(20)},
+			flight:   Flight{Baggage: BaggageInfo{CheckedKg: 15}},
+			expected: false,
+		},
+		{
+			name:     "aircraft family matches",
+			filter:   &FilterOptions{Aircraft: []string{"A320", "737"}},
+			flight:   Flight{Aircraft: "Boeing 737-800"},
+			expected: true,
+		},
+		{
+			name:     "aircraft family does not match",
+			filter:   &FilterOptions{Aircraft: []string{"A330"}},
+			flight:   Flight{Aircraft: "Boeing 737-800"},
+			expected: false,
+		},
+		{
+			name:     "departure terminal matches",
+			filter:   &FilterOptions{DepartureTerminals: []string{"3"}},
+			flight:   Flight{Departure: FlightPoint{Terminal: "3"}},
+			expected: true,
+		},
+		{
+			name:     "departure terminal matches padded code",
+			filter:   &FilterOptions{DepartureTerminals: []string{"2"}},
+			flight:   Flight{Departure: FlightPoint{Terminal: " 2 "}},
+			expected: true,
+		},
+		{
+			name:     "arrival terminal unknown",
+			filter:   &FilterOptions{ArrivalTerminals: []string{"I"}},
+			flight:   Flight{},
+			expected: false,
+		},
+		{
+			name: "departure time evaluated in airport timezone",
+			filter: &FilterOptions{
+				DepartureTimeRange: &TimeRange{
+					Start: time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC),
+					End:   time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC),
+				},
+			},
+			// 23:30 UTC is 07:30 in Makassar (WITA)
+			flight:   Flight{Departure: FlightPoint{DateTime: time.Date(2025, 1, 1, 23, 30, 0, 0, time.UTC), Timezone: "Asia/Makassar"}},
+			expected: true,
+		},
+		{
+			name: "arrival time range crossing midnight",
+			filter: &FilterOptions{
+				ArrivalTimeRange: &TimeRange{
+					Start: time.Date(2025, 1, 1, 22, 0, 0, 0, time.UTC),
+					End:   time.Date(2025, 1, 1, 2, 0, 0, 0, time.UTC),
+				},
+			},
+			// 17:00 UTC is 00:00 in Jakarta (WIB)
+			flight:   Flight{Arrival: FlightPoint{DateTime: time.Date(2025, 1, 1, 17, 0, 0, 0, time.UTC), Timezone: "Asia/Jakarta"}},
+			expected: true,
+		},
+		{
+			name:     "layover connection airport excluded",
+			filter:   &FilterOptions{ExcludedConnectionAirports: []string{"sub"}},
+			flight:   Flight{Layovers: []Layover{{AirportCode: "SUB", DurationMinutes: 75}}},
+			expected: false,
+		},
+		{
+			name:     "overnight layover excluded",
+			filter:   &FilterOptions{NoOvernightLayovers: true},
+			flight:   Flight{Layovers: []Layover{{AirportCode: "SUB", DurationMinutes: 420, Overnight: true}}},
+			expected: false,
+		},
+		{
+			name:     "layover filters ignore direct flights",
+			filter:   &FilterOptions{LayoverDurationRange: &DurationRange{MinMinutes: intPtr(60)}, NoOvernightLayovers: true},
+			flight:   baseFlight,
+			expected: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -386,3 +612,54 @@ func TestFilterOptionsMatchesFlight(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildCodeSet(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		checkKey string
+		exists   bool
+	}{
+		{"uppercase stored as uppercase", []string{"GA"}, "GA", true},
+		{"lowercase converted to uppercase", []string{"ga"}, "GA", true},
+		{"mixed case converted", []string{"Ga"}, "GA", true},
+		{"multiple airlines", []string{"GA", "jt", "Qz"}, "JT", true},
+		{"not in set", []string{"GA"}, "JT", false},
+		{"whitespace trimmed", []string{" 2 "}, "2", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set := buildCodeSet(tt.input)
+			_, exists := set[tt.checkKey]
+			assert.Equal(t, tt.exists, exists)
+		})
+	}
+}
+
+func TestInSet(t *testing.T) {
+	set := map[string]struct{}{
+		"GA": {},
+		"JT": {},
+		"QZ": {},
+	}
+
+	tests := []struct {
+		name   string
+		code   string
+		exists bool
+	}{
+		{"exact match uppercase", "GA", true},
+		{"lowercase match", "ga", true},
+		{"mixed case match", "Ga", true},
+		{"not in set", "ID", false},
+		{"whitespace trimmed", " ga ", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := inSet(tt.code, set)
+			assert.Equal(t, tt.exists, result)
+		})
+	}
+}
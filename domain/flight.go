@@ -3,7 +3,12 @@ package domain
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
+
+	"github.com/herdiagusthio/flight-search-system/pkg/airline"
+	"github.com/herdiagusthio/flight-search-system/pkg/airport"
+	"github.com/herdiagusthio/flight-search-system/pkg/util"
 )
 
 // Flight represents a single flight offering from a provider.
@@ -23,24 +28,83 @@ type Flight struct {
 	AvailableSeats int          `json:"availableSeats"`
 	Aircraft       string       `json:"aircraft,omitempty"`
 	Amenities      []string     `json:"amenities,omitempty"`
+	Layovers       []Layover    `json:"layovers,omitempty"`
+
+	International    bool     `json:"international"`              // Departure and arrival are in different countries
+	MissingDocuments []string `json:"missingDocuments,omitempty"` // Required travel documents the passenger has not declared
+
+	RankingExplanation *RankingExplanation `json:"rankingExplanation,omitempty"` // Breakdown of RankingScore, set only when requested
+	ParetoTags         []string            `json:"paretoTags,omitempty"`         // Trade-off tags, set only when sorting by SortByPareto
+
+	SelfTransfer *SelfTransfer `json:"selfTransfer,omitempty"` // Set for virtual connections of separately ticketed flights
 }
 
 // AirlineInfo contains information about an airline.
 type AirlineInfo struct {
-	Code string `json:"code"`
-	Name string `json:"name"`
-	Logo string `json:"logo,omitempty"`
+	Code     string `json:"code"`
+	Name     string `json:"name"`
+	Logo     string `json:"logo,omitempty"`
+	LowCost  bool   `json:"lowCost,omitempty"`
+	Group    string `json:"group,omitempty"`
+	Alliance string `json:"alliance,omitempty"`
+
+	OnTimeRate float64 `json:"onTimeRate,omitempty"` // Indicative share of flights arriving on time, 0 if unknown
+}
+
+// ApplyAirlineReference fills the canonical Name, Logo, LowCost, Group, Alliance and
+// OnTimeRate from the airline reference dataset.
+// Provider-supplied values are kept for airlines missing from the dataset.
+func (a *AirlineInfo) ApplyAirlineReference() {
+	ref, ok := airline.Lookup(a.Code)
+	if !ok {
+		return
+	}
+	a.Code = ref.Code
+	a.Name = ref.Name
+	a.Logo = ref.Logo
+	a.LowCost = ref.LowCost
+	a.Group = ref.Group
+	a.Alliance = ref.Alliance
+	a.OnTimeRate = ref.OnTimeRate
 }
 
 // FlightPoint represents a departure or arrival point.
 type FlightPoint struct {
 	AirportCode string    `json:"airportCode"`
 	AirportName string    `json:"airportName,omitempty"`
+	City        string    `json:"city,omitempty"`
+	Country     string    `json:"country,omitempty"` // ISO 3166-1 alpha-2 country code
 	Terminal    string    `json:"terminal,omitempty"`
 	DateTime    time.Time `json:"dateTime"`
 	Timezone    string    `json:"timezone,omitempty"`
 }
 
+// LocalTime returns DateTime in the airport's local timezone.
+// Falls back to DateTime as parsed if Timezone is empty or cannot be loaded.
+func (p FlightPoint) LocalTime() time.Time {
+	if p.Timezone == "" {
+		return p.DateTime
+	}
+	loc, err := util.GetLocation(p.Timezone)
+	if err != nil {
+		return p.DateTime
+	}
+	return p.DateTime.In(loc)
+}
+
+// ApplyAirportReference fills AirportName, City, Country and Timezone from the airport reference dataset.
+// Provider-supplied values are kept for airports missing from the dataset.
+func (p *FlightPoint) ApplyAirportReference() {
+	a, ok := airport.Lookup(p.AirportCode)
+	if !ok {
+		return
+	}
+	p.AirportName = a.Name
+	p.City = a.City
+	p.Country = a.Country
+	p.Timezone = a.Timezone
+}
+
 // DurationInfo contains flight duration information.
 type DurationInfo struct {
 	TotalMinutes int    `json:"totalMinutes"`
@@ -52,6 +116,13 @@ type PriceInfo struct {
 	Amount    float64 `json:"amount"`
 	Currency  string  `json:"currency"`
 	Formatted string  `json:"formatted,omitempty"`
+
+	// OriginalAmount and OriginalCurrency hold the provider's price when Amount was converted.
+	OriginalAmount   float64 `json:"originalAmount,omitempty"`
+	OriginalCurrency string  `json:"originalCurrency,omitempty"`
+
+	// Breakdown itemizes the fare into base fare, taxes, fees and surcharges.
+	Breakdown FareBreakdown `json:"breakdown"`
 }
 
 // BaggageInfo contains baggage allowance information.
@@ -97,7 +168,57 @@ func formatMinutesOnly(mins int) string {
 	return strconv.Itoa(mins) + "m"
 }
 
+// amenityAliases maps provider-specific amenity spellings to a canonical name.
+var amenityAliases = map[string]string{
+	"meals":         "meal",
+	"wi_fi":         "wifi",
+	"power":         "power_outlet",
+	"power_outlets": "power_outlet",
+}
+
+// NormalizeAmenity converts an amenity name to its canonical form.
+// Names are lowercased, spaces and hyphens become underscores, and known aliases are resolved.
+// Examples: "Meals" → "meal", "Wi-Fi" → "wifi", "Power Outlet" → "power_outlet"
+func NormalizeAmenity(name string) string {
+	normalized := strings.ToLower(strings.TrimSpace(name))
+	normalized = strings.NewReplacer(" ", "_", "-", "_").Replace(normalized)
+	if canonical, ok := amenityAliases[normalized]; ok {
+		return canonical
+	}
+	return normalized
+}
+
+// HasAmenities reports whether the flight offers every required amenity.
+// Amenity names are compared after normalization.
+func (f *Flight) HasAmenities(required []string) bool {
+	offered := make(map[string]struct{}, len(f.Amenities))
+	for _, a := range f.Amenities {
+		offered[NormalizeAmenity(a)] = struct{}{}
+	}
+	for _, r := range required {
+		if _, ok := offered[NormalizeAmenity(r)]; !ok {
+			return false
+		}
+	}
+	return true
+}
 
+// MatchesAircraft reports whether the flight's aircraft matches any of the given types or families.
+// Matching is a case-insensitive substring match, so "737" matches "Boeing 737-800".
+// Flights without aircraft information never match.
+func (f *Flight) MatchesAircraft(families []string) bool {
+	if f.Aircraft == "" {
+		return false
+	}
+	aircraft := strings.ToLower(f.Aircraft)
+	for _, family := range families {
+		family = strings.ToLower(strings.TrimSpace(family))
+		if family != "" && strings.Contains(aircraft, family) {
+			return true
+		}
+	}
+	return false
+}
 
 // Validate checks if the flight data is valid and consistent.
 // It returns an error if:
@@ -186,3 +186,102 @@ func TestFormatMinutesOnly(t *testing.T) {
 	assert.Equal(t, "30m", formatMinutesOnly(30))
 	assert.Equal(t, "5m", formatMinutesOnly(5))
 }
+
+func TestNormalizeAmenity(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"wifi", "wifi"},
+		{"WiFi", "wifi"},
+		{"Wi-Fi", "wifi"},
+		{"Meal", "meal"},
+		{"meals", "meal"},
+		{"Power Outlet", "power_outlet"},
+		{"power", "power_outlet"},
+		{" Entertainment ", "entertainment"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NormalizeAmenity(tt.input))
+		})
+	}
+}
+
+func TestFlightHasAmenities(t *testing.T) {
+	flight := Flight{Amenities: []string{"WiFi", "Meal", "entertainment"}}
+
+	assert.True(t, flight.HasAmenities(nil))
+	assert.True(t, flight.HasAmenities([]string{"wifi"}))
+	assert.True(t, flight.HasAmenities([]string{"meals", "Wi-Fi"}))
+	assert.False(t, flight.HasAmenities([]string{"wifi", "power_outlet"}))
+	assert.False(t, (&Flight{}).HasAmenities([]string{"meal"}))
+}
+
+func TestFlightMatchesAircraft(t *testing.T) {
+	flight := Flight{Aircraft: "Boeing 737-800"}
+
+	assert.True(t, flight.MatchesAircraft([]string{"737"}))
+	assert.True(t, flight.MatchesAircraft([]string{"A320", "boeing 737"}))
+	assert.False(t, flight.MatchesAircraft([]string{"A330"}))
+	assert.False(t, flight.MatchesAircraft([]string{" "}))
+	assert.False(t, (&Flight{}).MatchesAircraft([]string{"737"}))
+}
+
+func TestFlightPointLocalTime(t *testing.T) {
+	utc := time.Date(2025, 12, 15, 23, 0, 0, 0, time.UTC)
+
+	t.Run("converts to airport timezone", func(t *testing.T) {
+		local := FlightPoint{DateTime: utc, Timezone: "Asia/Jayapura"}.LocalTime()
+		assert.Equal(t, 8, local.Hour())
+		assert.True(t, local.Equal(utc))
+	})
+
+	t.Run("empty timezone keeps parsed location", func(t *testing.T) {
+		assert.Equal(t, utc, FlightPoint{DateTime: utc}.LocalTime())
+	})
+
+	t.Run("unknown timezone keeps parsed location", func(t *testing.T) {
+		assert.Equal(t, utc, FlightPoint{DateTime: utc, Timezone: "Mars/Olympus"}.LocalTime())
+	})
+}
+
+func TestFlightPointApplyAirportReference(t *testing.T) {
+	t.Run("known airport", func(t *testing.T) {
+		p := FlightPoint{AirportCode: "DPS", AirportName: "Bali", City: "Bali", Timezone: "Asia/Jakarta"}
+		p.ApplyAirportReference()
+
+		assert.Equal(t, "I Gusti Ngurah Rai International Airport", p.AirportName)
+		assert.Equal(t, "Denpasar", p.City)
+		assert.Equal(t, "ID", p.Country)
+		assert.Equal(t, "Asia/Makassar", p.Timezone)
+	})
+
+	t.Run("unknown airport keeps provider values", func(t *testing.T) {
+		p := FlightPoint{AirportCode: "XYZ", AirportName: "Somewhere", City: "Nowhere"}
+		p.ApplyAirportReference()
+
+		assert.Equal(t, FlightPoint{AirportCode: "XYZ", AirportName: "Somewhere", City: "Nowhere"}, p)
+	})
+}
+
+func TestAirlineInfoApplyAirlineReference(t *testing.T) {
+	t.Run("known airline", func(t *testing.T) {
+		a := AirlineInfo{Code: "qz", Name: "AirAsia"}
+		a.ApplyAirlineReference()
+
+		assert.Equal(t, "QZ", a.Code)
+		assert.Equal(t, "Indonesia AirAsia", a.Name)
+		assert.NotEmpty(t, a.Logo)
+		assert.True(t, a.LowCost)
+		assert.Equal(t, "Capital A", a.Group)
+	})
+
+	t.Run("unknown airline keeps provider values", func(t *testing.T) {
+		a := AirlineInfo{Code: "ZZ", Name: "Zed Air"}
+		a.ApplyAirlineReference()
+
+		assert.Equal(t, AirlineInfo{Code: "ZZ", Name: "Zed Air"}, a)
+	})
+}
@@ -0,0 +1,80 @@
+package domain
+
+import "time"
+
+// Night window used to decide whether a layover is overnight, in the connection airport's local time.
+const (
+	nightStartHour = 0 // inclusive
+	nightEndHour   = 5 // exclusive
+)
+
+// Layover describes a connection stop on a multi-leg flight.
+type Layover struct {
+	AirportCode     string `json:"airportCode"`
+	DurationMinutes int    `json:"durationMinutes"`
+	Overnight       bool   `json:"overnight"`
+}
+
+// NewLayover creates a Layover from the inbound arrival and onward departure times.
+// The layover is overnight if any part of it falls between 00:00 and 05:00
+// in the connection airport's local time (loc).
+func NewLayover(airportCode string, arrival, departure time.Time, loc *time.Location) Layover {
+	return Layover{
+		AirportCode:     airportCode,
+		DurationMinutes: int(departure.Sub(arrival).Minutes()),
+		Overnight:       overlapsNight(arrival, departure, loc),
+	}
+}
+
+// NewLayoverFromDuration creates a Layover when the provider only reports its duration.
+// The exact layover window is unknown, so the itinerary's departure and arrival bound it:
+// the layover is conservatively marked overnight if that itinerary window touches the night
+// window in the connection airport's local time (loc).
+func NewLayoverFromDuration(airportCode string, durationMinutes int, itineraryDeparture, itineraryArrival time.Time, loc *time.Location) Layover {
+	return Layover{
+		AirportCode:     airportCode,
+		DurationMinutes: durationMinutes,
+		Overnight:       overlapsNight(itineraryDeparture, itineraryArrival, loc),
+	}
+}
+
+// overlapsNight reports whether the window [start, end) overlaps the night window on any local day.
+func overlapsNight(start, end time.Time, loc *time.Location) bool {
+	if loc == nil {
+		loc = time.UTC
+	}
+	if !end.After(start) {
+		return false
+	}
+
+	localStart := start.In(loc)
+	day := time.Date(localStart.Year(), localStart.Month(), localStart.Day(), 0, 0, 0, 0, loc)
+	for !day.After(end) {
+		nightStart := day.Add(nightStartHour * time.Hour)
+		nightEnd := day.Add(nightEndHour * time.Hour)
+		if start.Before(nightEnd) && end.After(nightStart) {
+			return true
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return false
+}
+
+// MatchesLayovers reports whether every layover of the flight satisfies the given constraints:
+// its duration falls within durationRange, its airport is not in excludedAirports,
+// and, if noOvernight is set, it is not overnight.
+// Flights without layover details always match.
+func (f *Flight) MatchesLayovers(durationRange *DurationRange, excludedAirports []string, noOvernight bool) bool {
+	for _, l := range f.Layovers {
+		if !durationRange.Contains(l.DurationMinutes) {
+			return false
+		}
+		if len(excludedAirports) > 0 && containsFold(excludedAirports, l.AirportCode) {
+			return false
+		}
+		if noOvernight && l.Overnight {
+			return false
+		}
+	}
+	return true
+}
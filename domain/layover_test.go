@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLayover(t *testing.T) {
+	wib := time.FixedZone("WIB", 7*60*60)
+
+	tests := []struct {
+		name              string
+		arrival           time.Time
+		departure         time.Time
+		expectedMinutes   int
+		expectedOvernight bool
+	}{
+		{
+			name:              "daytime layover",
+			arrival:           time.Date(2025, 12, 15, 10, 0, 0, 0, wib),
+			departure:         time.Date(2025, 12, 15, 11, 15, 0, 0, wib),
+			expectedMinutes:   75,
+			expectedOvernight: false,
+		},
+		{
+			name:              "layover crossing midnight",
+			arrival:           time.Date(2025, 12, 15, 23, 30, 0, 0, wib),
+			departure:         time.Date(2025, 12, 16, 0, 30, 0, 0, wib),
+			expectedMinutes:   60,
+			expectedOvernight: true,
+		},
+		{
+			name:              "layover in the early morning",
+			arrival:           time.Date(2025, 12, 16, 2, 0, 0, 0, wib),
+			departure:         time.Date(2025, 12, 16, 3, 0, 0, 0, wib),
+			expectedMinutes:   60,
+			expectedOvernight: true,
+		},
+		{
+			name:              "layover ending at night window end",
+			arrival:           time.Date(2025, 12, 16, 5, 0, 0, 0, wib),
+			departure:         time.Date(2025, 12, 16, 6, 0, 0, 0, wib),
+			expectedMinutes:   60,
+			expectedOvernight: false,
+		},
+		{
+			name:              "evaluated in the connection airport timezone",
+			arrival:           time.Date(2025, 12, 15, 16, 0, 0, 0, time.UTC), // 23:00 WIB
+			departure:         time.Date(2025, 12, 15, 18, 0, 0, 0, time.UTC), // 01:00 WIB
+			expectedMinutes:   120,
+			expectedOvernight: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			layover := NewLayover("SUB", tt.arrival, tt.departure, wib)
+			assert.Equal(t, "SUB", layover.AirportCode)
+			assert.Equal(t, tt.expectedMinutes, layover.DurationMinutes)
+			assert.Equal(t, tt.expectedOvernight, layover.Overnight)
+		})
+	}
+}
+
+func TestNewLayoverFromDuration(t *testing.T) {
+	wita := time.FixedZone("WITA", 8*60*60)
+
+	daytime := NewLayoverFromDuration("UPG", 55,
+		time.Date(2025, 12, 15, 8, 0, 0, 0, wita),
+		time.Date(2025, 12, 15, 13, 0, 0, 0, wita), wita)
+	assert.Equal(t, Layover{AirportCode: "UPG", DurationMinutes: 55, Overnight: false}, daytime)
+
+	redEye := NewLayoverFromDuration("UPG", 55,
+		time.Date(2025, 12, 15, 22, 0, 0, 0, wita),
+		time.Date(2025, 12, 16, 4, 0, 0, 0, wita), wita)
+	assert.True(t, redEye.Overnight)
+}
+
+func TestFlightMatchesLayovers(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+	flight := Flight{
+		Layovers: []Layover{
+			{AirportCode: "SUB", DurationMinutes: 40},
+			{AirportCode: "UPG", DurationMinutes: 120, Overnight: true},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		durationRange    *DurationRange
+		excludedAirports []string
+		noOvernight      bool
+		expected         bool
+	}{
+		{"no constraints", nil, nil, false, true},
+		{"min layover too short", &DurationRange{MinMinutes: intPtr(45)}, nil, false, false},
+		{"max layover too long", &DurationRange{MaxMinutes: intPtr(90)}, nil, false, false},
+		{"all layovers within range", &DurationRange{MinMinutes: intPtr(30), MaxMinutes: intPtr(120)}, nil, false, true},
+		{"excluded airport", nil, []string{"upg"}, false, false},
+		{"other airport excluded", nil, []string{"KUL"}, false, true},
+		{"overnight excluded", nil, nil, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, flight.MatchesLayovers(tt.durationRange, tt.excludedAirports, tt.noOvernight))
+		})
+	}
+
+	direct := Flight{}
+	assert.True(t, direct.MatchesLayovers(&DurationRange{MinMinutes: intPtr(60)}, []string{"SUB"}, true))
+}
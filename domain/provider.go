@@ -1,6 +1,11 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+)
 
 //go:generate mockgen -destination=provider_mock.go -package=domain github.com/herdiagusthio/flight-search-system/domain FlightProvider,ProviderRegistry
 
@@ -24,7 +29,7 @@ type FlightProvider interface {
 	// This identifier is used for logging, metrics, and result attribution.
 	//
 	// The name should be a lowercase, underscore-separated string.
-	// Examples: "garuda_indonesia", "lion_air", "batik_air", "airasia"
+	// Examples: "garuda_indonesia", "lion_air", "batik_air", "airasia", "citilink", "super_air_jet"
 	Name() string
 
 	// Search queries the provider for available flights matching the criteria.
@@ -48,61 +53,217 @@ type FlightProvider interface {
 	Search(ctx context.Context, criteria SearchCriteria) ([]Flight, error)
 }
 
+// Provider weight bounds. A provider's weight adjusts the preference for its flights in
+// best-value ranking: (weight − 1) × ProviderWeightInfluence is subtracted from their
+// score. Since scores lie in [0, 1] with lower being better, the weight shifts a score by
+// at most ProviderWeightInfluence, nudging the ranking without overriding the other
+// factors.
+const (
+	DefaultProviderWeight   = 1.0
+	MaxProviderWeight       = 2.0
+	ProviderWeightInfluence = 0.1
+)
+
+// ProviderStatus is the runtime state of a registered provider.
+type ProviderStatus struct {
+	Name    string
+	Enabled bool
+	Weight  float64
+}
+
 // ProviderRegistry manages the collection of available flight providers.
 // This is used by the use case layer to discover and query all registered providers.
+// Providers can be enabled, disabled and reweighted at runtime; implementations must be
+// safe for concurrent use.
 type ProviderRegistry interface {
-	// Register adds a new provider to the registry.
-	// If a provider with the same name already exists, it will be replaced.
+	// Register adds a new provider to the registry, enabled with the default weight.
+	// If a provider with the same name already exists, it will be replaced, keeping its status.
 	Register(provider FlightProvider)
 
-	// GetAll returns all registered providers.
+	// GetAll returns all registered providers in registration order.
 	GetAll() []FlightProvider
 
 	// Get returns a specific provider by name, or nil if not found.
 	Get(name string) FlightProvider
 
-	// Names returns the names of all registered providers.
+	// Names returns the names of all registered providers in registration order.
 	Names() []string
+
+	// Enabled returns the enabled providers in registration order.
+	Enabled() []FlightProvider
+
+	// Status returns the status of a provider, or false if not found.
+	Status(name string) (ProviderStatus, bool)
+
+	// Statuses returns the status of all registered providers in registration order.
+	Statuses() []ProviderStatus
+
+	// SetEnabled enables or disables a provider and returns its previous status.
+	// Returns ErrProviderNotFound if the provider is not registered.
+	SetEnabled(name string, enabled bool) (ProviderStatus, error)
+
+	// SetWeight sets the ranking weight of a provider and returns its previous status.
+	// The weight shifts the best-value score of the provider's flights by
+	// (weight − 1) × ProviderWeightInfluence; self-transfers take the mean weight of
+	// their legs' providers. Returns ErrProviderNotFound if the provider is not registered, and an
+	// ErrInvalidRequest error if the weight is not in [0, MaxProviderWeight].
+	SetWeight(name string, weight float64) (ProviderStatus, error)
+}
+
+// registeredProvider is a provider with its runtime status.
+type registeredProvider struct {
+	provider FlightProvider
+	status   ProviderStatus
 }
 
 // providerRegistry is the default implementation of ProviderRegistry.
 type providerRegistry struct {
-	providers map[string]FlightProvider
+	mu        sync.RWMutex
+	providers []*registeredProvider
+	byName    map[string]*registeredProvider
 }
 
 // NewProviderRegistry creates a new provider registry.
 func NewProviderRegistry() ProviderRegistry {
 	return &providerRegistry{
-		providers: make(map[string]FlightProvider),
+		byName: make(map[string]*registeredProvider),
+	}
+}
+
+// NewProviderRegistryWith creates a new provider registry with the given providers registered.
+func NewProviderRegistryWith(providers ...FlightProvider) ProviderRegistry {
+	registry := NewProviderRegistry()
+	for _, p := range providers {
+		registry.Register(p)
 	}
+	return registry
 }
 
 // Register adds a new provider to the registry.
 func (r *providerRegistry) Register(provider FlightProvider) {
-	if provider != nil {
-		r.providers[provider.Name()] = provider
+	if provider == nil {
+		return
 	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	name := provider.Name()
+	if existing, ok := r.byName[name]; ok {
+		existing.provider = provider
+		return
+	}
+
+	registered := &registeredProvider{
+		provider: provider,
+		status:   ProviderStatus{Name: name, Enabled: true, Weight: DefaultProviderWeight},
+	}
+	r.providers = append(r.providers, registered)
+	r.byName[name] = registered
 }
 
 // GetAll returns all registered providers.
 func (r *providerRegistry) GetAll() []FlightProvider {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	result := make([]FlightProvider, 0, len(r.providers))
 	for _, p := range r.providers {
-		result = append(result, p)
+		result = append(result, p.provider)
 	}
 	return result
 }
 
 // Get returns a specific provider by name, or nil if not found.
 func (r *providerRegistry) Get(name string) FlightProvider {
-	return r.providers[name]
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if p, ok := r.byName[name]; ok {
+		return p.provider
+	}
+	return nil
 }
 
 // Names returns the names of all registered providers.
 func (r *providerRegistry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	names := make([]string, 0, len(r.providers))
-	for name := range r.providers {
-		names = append(names, name)
+	for _, p := range r.providers {
+		names = append(names, p.status.Name)
 	}
 	return names
-}
\ No newline at end of file
+}
+
+// Enabled returns the enabled providers.
+func (r *providerRegistry) Enabled() []FlightProvider {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]FlightProvider, 0, len(r.providers))
+	for _, p := range r.providers {
+		if p.status.Enabled {
+			result = append(result, p.provider)
+		}
+	}
+	return result
+}
+
+// Status returns the status of a provider.
+func (r *providerRegistry) Status(name string) (ProviderStatus, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	p, ok := r.byName[name]
+	if !ok {
+		return ProviderStatus{}, false
+	}
+	return p.status, true
+}
+
+// Statuses returns the status of all registered providers.
+func (r *providerRegistry) Statuses() []ProviderStatus {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	statuses := make([]ProviderStatus, 0, len(r.providers))
+	for _, p := range r.providers {
+		statuses = append(statuses, p.status)
+	}
+	return statuses
+}
+
+// SetEnabled enables or disables a provider.
+func (r *providerRegistry) SetEnabled(name string, enabled bool) (ProviderStatus, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p, ok := r.byName[name]
+	if !ok {
+		return ProviderStatus{}, fmt.Errorf("%w: %s", ErrProviderNotFound, name)
+	}
+	previous := p.status
+	p.status.Enabled = enabled
+	return previous, nil
+}
+
+// SetWeight sets the ranking weight of a provider, which shifts the best-value score of
+// its flights by at most ProviderWeightInfluence.
+func (r *providerRegistry) SetWeight(name string, weight float64) (ProviderStatus, error) {
+	if math.IsNaN(weight) || weight < 0 || weight > MaxProviderWeight {
+		return ProviderStatus{}, WrapInvalidRequest("provider weight must be between 0 and %g, got %g", MaxProviderWeight, weight)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p, ok := r.byName[name]
+	if !ok {
+		return ProviderStatus{}, fmt.Errorf("%w: %s", ErrProviderNotFound, name)
+	}
+	previous := p.status
+	p.status.Weight = weight
+	return previous, nil
+}
@@ -93,6 +93,20 @@ func (m *MockProviderRegistry) EXPECT() *MockProviderRegistryMockRecorder {
 	return m.recorder
 }
 
+// Enabled mocks base method.
+func (m *MockProviderRegistry) Enabled() []FlightProvider {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enabled")
+	ret0, _ := ret[0].([]FlightProvider)
+	return ret0
+}
+
+// Enabled indicates an expected call of Enabled.
+func (mr *MockProviderRegistryMockRecorder) Enabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enabled", reflect.TypeOf((*MockProviderRegistry)(nil).Enabled))
+}
+
 // Get mocks base method.
 func (m *MockProviderRegistry) Get(name string) FlightProvider {
 	m.ctrl.T.Helper()
@@ -146,3 +160,62 @@ func (mr *MockProviderRegistryMockRecorder) Register(provider any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockProviderRegistry)(nil).Register), provider)
 }
+
+// SetEnabled mocks base method.
+func (m *MockProviderRegistry) SetEnabled(name string, enabled bool) (ProviderStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEnabled", name, enabled)
+	ret0, _ := ret[0].(ProviderStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetEnabled indicates an expected call of SetEnabled.
+func (mr *MockProviderRegistryMockRecorder) SetEnabled(name, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEnabled", reflect.TypeOf((*MockProviderRegistry)(nil).SetEnabled), name, enabled)
+}
+
+// SetWeight mocks base method.
+func (m *MockProviderRegistry) SetWeight(name string, weight float64) (ProviderStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWeight", name, weight)
+	ret0, _ := ret[0].(ProviderStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetWeight indicates an expected call of SetWeight.
+func (mr *MockProviderRegistryMockRecorder) SetWeight(name, weight any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWeight", reflect.TypeOf((*MockProviderRegistry)(nil).SetWeight), name, weight)
+}
+
+// Status mocks base method.
+func (m *MockProviderRegistry) Status(name string) (ProviderStatus, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status", name)
+	ret0, _ := ret[0].(ProviderStatus)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockProviderRegistryMockRecorder) Status(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockProviderRegistry)(nil).Status), name)
+}
+
+// Statuses mocks base method.
+func (m *MockProviderRegistry) Statuses() []ProviderStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Statuses")
+	ret0, _ := ret[0].([]ProviderStatus)
+	return ret0
+}
+
+// Statuses indicates an expected call of Statuses.
+func (mr *MockProviderRegistryMockRecorder) Statuses() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Statuses", reflect.TypeOf((*MockProviderRegistry)(nil).Statuses))
+}
@@ -2,9 +2,12 @@ package domain
 
 import (
 	"context"
+	"math"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // SimpleFlightProviderMock is a simple test implementation of FlightProvider
@@ -175,6 +178,107 @@ func TestProviderRegistryNames(t *testing.T) {
 	assert.Contains(t, names, "lion_air")
 }
 
+func TestProviderRegistryOrder(t *testing.T) {
+	registry := NewProviderRegistryWith(
+		NewSimpleFlightProviderMock("garuda", nil, nil),
+		NewSimpleFlightProviderMock("airasia", nil, nil),
+		NewSimpleFlightProviderMock("lion_air", nil, nil),
+	)
+
+	assert.Equal(t, []string{"garuda", "airasia", "lion_air"}, registry.Names())
+}
+
+func TestProviderRegistrySetEnabled(t *testing.T) {
+	registry := NewProviderRegistryWith(
+		NewSimpleFlightProviderMock("garuda", nil, nil),
+		NewSimpleFlightProviderMock("airasia", nil, nil),
+	)
+	assert.Len(t, registry.Enabled(), 2)
+
+	previous, err := registry.SetEnabled("airasia", false)
+	require.NoError(t, err)
+	assert.Equal(t, ProviderStatus{Name: "airasia", Enabled: true, Weight: DefaultProviderWeight}, previous)
+
+	enabled := registry.Enabled()
+	require.Len(t, enabled, 1)
+	assert.Equal(t, "garuda", enabled[0].Name())
+	assert.Len(t, registry.GetAll(), 2, "disabled providers stay registered")
+
+	status, ok := registry.Status("airasia")
+	require.True(t, ok)
+	assert.False(t, status.Enabled)
+
+	// Re-registering keeps the status
+	registry.Register(NewSimpleFlightProviderMock("airasia", nil, nil))
+	status, _ = registry.Status("airasia")
+	assert.False(t, status.Enabled)
+
+	_, err = registry.SetEnabled("airasia", true)
+	require.NoError(t, err)
+	assert.Len(t, registry.Enabled(), 2)
+
+	_, err = registry.SetEnabled("citilink", false)
+	assert.ErrorIs(t, err, ErrProviderNotFound)
+}
+
+func TestProviderRegistrySetWeight(t *testing.T) {
+	registry := NewProviderRegistryWith(NewSimpleFlightProviderMock("garuda", nil, nil))
+
+	tests := []struct {
+		name    string
+		weight  float64
+		wantErr error
+	}{
+		{"valid weight", 1.5, nil},
+		{"maximum weight", MaxProviderWeight, nil},
+		{"zero weight", 0, nil},
+		{"negative weight", -1, ErrInvalidRequest},
+		{"weight too high", MaxProviderWeight + 1, ErrInvalidRequest},
+		{"NaN weight", math.NaN(), ErrInvalidRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := registry.SetWeight("garuda", tt.weight)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			status, _ := registry.Status("garuda")
+			assert.Equal(t, tt.weight, status.Weight)
+		})
+	}
+
+	_, err := registry.SetWeight("citilink", 1)
+	assert.ErrorIs(t, err, ErrProviderNotFound)
+}
+
+func TestProviderRegistryConcurrentAccess(t *testing.T) {
+	registry := NewProviderRegistryWith(NewSimpleFlightProviderMock("garuda", nil, nil))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(3)
+		go func(i int) {
+			defer wg.Done()
+			_, _ = registry.SetEnabled("garuda", i%2 == 0)
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+			_, _ = registry.SetWeight("garuda", float64(i%5+1))
+		}(i)
+		go func() {
+			defer wg.Done()
+			_ = registry.Enabled()
+			_ = registry.Statuses()
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, registry.Statuses(), 1)
+}
+
 func TestSimpleFlightProviderMockSearch(t *testing.T) {
 	flights := []Flight{
 		{ID: "1", FlightNumber: "GA-123"},
@@ -0,0 +1,25 @@
+package domain
+
+// RankingExplanation breaks a flight's best-value ranking score into its parts,
+// so that the score can be reproduced as the weighted sum of the components,
+// minus the provider weight adjustment if set.
+// All maps are keyed by scoring factor name (e.g. "price", "duration", "stops").
+type RankingExplanation struct {
+	Components     map[string]float64      `json:"components"`               // Normalized factor scores in [0,1], 0 being the best
+	Weights        map[string]float64      `json:"weights"`                  // Weights applied to each component
+	Bounds         map[string]RankingBound `json:"bounds"`                   // Normalization bounds of the result set, for factors normalized against it
+	ProviderWeight *float64                `json:"providerWeight,omitempty"` // Weight of the flight's provider if not the default 1; (weight − 1) × ProviderWeightInfluence is subtracted from the weighted sum
+}
+
+// RankingBound holds the min/max values used to normalize a ranking component.
+type RankingBound struct {
+	Min float64 `json:"min"`
+	Max float64 `json:"max"`
+}
+
+// Trade-off tags given to flights on the Pareto frontier.
+const (
+	ParetoTagCheapest    = "cheapest"     // Lowest price on the frontier
+	ParetoTagFastest     = "fastest"      // Shortest duration on the frontier
+	ParetoTagBestBalance = "best balance" // Lowest best-value score on the frontier
+)
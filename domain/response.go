@@ -5,6 +5,7 @@ type SearchResponse struct {
 	SearchCriteria SearchCriteriaResponse `json:"search_criteria"`
 	Metadata       SearchMetadata         `json:"metadata"`
 	Flights        []Flight               `json:"flights"`
+	Facets         *SearchFacets          `json:"facets,omitempty"`
 }
 
 // SearchCriteriaResponse represents the search criteria in the response.
@@ -17,13 +18,21 @@ type SearchCriteriaResponse struct {
 }
 
 // SearchMetadata contains metadata about the search execution.
+// Skipped providers do not serve the searched route and are not queried.
+// Rate limited providers serve the route but are over their rate or concurrency limit
+// and are not queried either.
 type SearchMetadata struct {
-	TotalResults       int   `json:"total_results"`
-	ProvidersQueried   int   `json:"providers_queried"`
-	ProvidersSucceeded int   `json:"providers_succeeded"`
-	ProvidersFailed    int   `json:"providers_failed"`
-	SearchTimeMs       int64 `json:"search_time_ms"`
-	CacheHit           bool  `json:"cache_hit"`
+	TotalResults         int      `json:"total_results"`
+	ProvidersQueried     int      `json:"providers_queried"`
+	ProvidersSucceeded   int      `json:"providers_succeeded"`
+	ProvidersFailed      int      `json:"providers_failed"`
+	ProvidersSkipped     int      `json:"providers_skipped"`
+	ProvidersRateLimited int      `json:"providers_rate_limited"`
+	FailedProviders      []string `json:"failed_providers,omitempty"`
+	SkippedProviders     []string `json:"skipped_providers,omitempty"`
+	RateLimitedProviders []string `json:"rate_limited_providers,omitempty"`
+	SearchTimeMs         int64    `json:"search_time_ms"`
+	CacheHit             bool     `json:"cache_hit"`
 }
 
 // NewSearchResponse creates a new SearchResponse.
@@ -13,10 +13,13 @@ type SearchCriteria struct {
 	DepartureDate string `json:"departureDate"`
 	Passengers    int    `json:"passengers"`
 	Class         string `json:"class,omitempty"`
+
+	TravelDocuments *TravelDocuments `json:"travelDocuments,omitempty"` // Documents declared by the passenger (optional)
 }
 
 var airportCodeRegex = regexp.MustCompile(`^[A-Z]{3}$`)
 var dateRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+var countryCodeRegex = regexp.MustCompile(`^[A-Z]{2}$`)
 var validClasses = map[string]bool{
 	"economy":  true,
 	"business": true,
@@ -55,6 +58,24 @@ func (s *SearchCriteria) Validate() error {
 	if s.Class != "" && !validClasses[s.Class] {
 		return fmt.Errorf("%w: class must be one of: economy, business, first; got %q", ErrInvalidRequest, s.Class)
 	}
+	if s.TravelDocuments != nil {
+		if err := s.TravelDocuments.Validate(); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidRequest, err)
+		}
+	}
+	return nil
+}
+
+// Validate checks that the declared country codes are ISO 3166-1 alpha-2 codes.
+func (d *TravelDocuments) Validate() error {
+	if d.Nationality != "" && !countryCodeRegex.MatchString(d.Nationality) {
+		return fmt.Errorf("nationality must be a 2-letter country code, got %q", d.Nationality)
+	}
+	for _, v := range d.Visas {
+		if !countryCodeRegex.MatchString(v) {
+			return fmt.Errorf("visas must contain 2-letter country codes, got %q", v)
+		}
+	}
 	return nil
 }
 
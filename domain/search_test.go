@@ -160,6 +160,29 @@ func TestSearchCriteriaValidate(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "travel documents are valid",
+			modifyCriteria: func(c *SearchCriteria) {
+				c.TravelDocuments = &TravelDocuments{Nationality: "ID", HasPassport: true, Visas: []string{"JP"}}
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid nationality",
+			modifyCriteria: func(c *SearchCriteria) {
+				c.TravelDocuments = &TravelDocuments{Nationality: "IDN"}
+			},
+			expectError:   true,
+			errorContains: "nationality must be a 2-letter country code",
+		},
+		{
+			name: "invalid visa country",
+			modifyCriteria: func(c *SearchCriteria) {
+				c.TravelDocuments = &TravelDocuments{Visas: []string{"jp"}}
+			},
+			expectError:   true,
+			errorContains: "visas must contain 2-letter country codes",
+		},
 	}
 
 	for _, tt := range tests {
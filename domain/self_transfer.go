@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"strings"
+
+	"github.com/herdiagusthio/flight-search-system/pkg/util"
+)
+
+// Self-transfer protection statuses.
+const (
+	// SelfTransferProtected means both legs are operated by airlines of the same group,
+	// which rebook passengers who miss the connection because of a delay.
+	SelfTransferProtected = "protected"
+	// SelfTransferUnprotected means the legs are ticketed independently; a missed
+	// connection is the passenger's risk.
+	SelfTransferUnprotected = "unprotected"
+)
+
+// SelfTransfer describes a virtual connection combining separately ticketed flights.
+// Passengers collect their baggage and check in again at the connection airport.
+type SelfTransfer struct {
+	Status            string   `json:"status"`            // SelfTransferProtected or SelfTransferUnprotected
+	ConnectionAirport string   `json:"connectionAirport"` // Airport where the passenger changes flights
+	ConnectionMinutes int      `json:"connectionMinutes"` // Time between the first leg's arrival and the second leg's departure
+	Legs              []Flight `json:"legs"`              // The separately ticketed flights, in travel order
+}
+
+// NewSelfTransfer combines two flights, the second departing from the airport the first
+// arrives at, into a single self-transfer itinerary. Both prices must be in the same
+// currency. The itinerary takes the first leg's airline and cabin class, the lower seat
+// availability and baggage allowance of the two legs, and the amenities both legs offer.
+func NewSelfTransfer(first, second Flight) Flight {
+	hub := first.Arrival.AirportCode
+	layovers := make([]Layover, 0, len(first.Layovers)+len(second.Layovers)+1)
+	layovers = append(layovers, first.Layovers...)
+	layovers = append(layovers, NewLayover(hub, first.Arrival.DateTime, second.Departure.DateTime, first.Arrival.LocalTime().Location()))
+	layovers = append(layovers, second.Layovers...)
+
+	totalMinutes := int(second.Arrival.DateTime.Sub(first.Departure.DateTime).Minutes())
+	amount := first.Price.Amount + second.Price.Amount
+
+	status := SelfTransferUnprotected
+	if first.Airline.Group != "" && strings.EqualFold(first.Airline.Group, second.Airline.Group) {
+		status = SelfTransferProtected
+	}
+
+	f := Flight{
+		ID:           first.ID + "+" + second.ID,
+		FlightNumber: first.FlightNumber + "+" + second.FlightNumber,
+		Airline:      first.Airline,
+		Departure:    first.Departure,
+		Arrival:      second.Arrival,
+		Duration: DurationInfo{
+			TotalMinutes: totalMinutes,
+			Formatted:    util.FormatDuration(totalMinutes),
+		},
+		Price: PriceInfo{
+			Amount:    amount,
+			Currency:  first.Price.Currency,
+			Formatted: util.FormatCurrency(amount, first.Price.Currency),
+			Breakdown: first.Price.Breakdown.Plus(second.Price.Breakdown),
+		},
+		Baggage: BaggageInfo{
+			CabinKg:   min(first.Baggage.CabinKg, second.Baggage.CabinKg),
+			CheckedKg: min(first.Baggage.CheckedKg, second.Baggage.CheckedKg),
+		},
+		Class:          first.Class,
+		Stops:          first.Stops + second.Stops + 1,
+		Provider:       first.Provider + "+" + second.Provider,
+		AvailableSeats: min(first.AvailableSeats, second.AvailableSeats),
+		Amenities:      commonAmenities(first.Amenities, second.Amenities),
+		Layovers:       layovers,
+		SelfTransfer: &SelfTransfer{
+			Status:            status,
+			ConnectionAirport: hub,
+			ConnectionMinutes: int(second.Departure.DateTime.Sub(first.Arrival.DateTime).Minutes()),
+			Legs:              []Flight{first, second},
+		},
+	}
+	f.ClassifyRoute()
+	f.International = f.International || first.International || second.International
+	return f
+}
+
+// commonAmenities returns the amenities offered on both legs, in the first leg's order.
+func commonAmenities(first, second []string) []string {
+	var common []string
+	for _, a := range first {
+		if containsFold(second, a) {
+			common = append(common, a)
+		}
+	}
+	return common
+}
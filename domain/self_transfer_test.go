@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// selfTransferLegs returns a CGK-SUB and a SUB-LOP flight connecting at SUB after 140 minutes.
+func selfTransferLegs() (Flight, Flight) {
+	wib := time.FixedZone("WIB", 7*60*60)
+	wita := time.FixedZone("WITA", 8*60*60)
+
+	first := Flight{
+		ID:           "QZ7510_AirAsia",
+		FlightNumber: "QZ7510",
+		Airline:      AirlineInfo{Code: "QZ", Name: "AirAsia"},
+		Departure:    FlightPoint{AirportCode: "CGK", Country: "ID", DateTime: time.Date(2025, 12, 15, 7, 10, 0, 0, wib)},
+		Arrival:      FlightPoint{AirportCode: "SUB", Country: "ID", DateTime: time.Date(2025, 12, 15, 8, 40, 0, 0, wib)},
+		Price:        PriceInfo{Amount: 540000, Currency: "IDR"},
+		Baggage:      BaggageInfo{CabinKg: 7, CheckedKg: 20},
+		Class:        "economy",
+		Provider:     "AirAsia",
+		Amenities:    []string{"wifi", "meal"},
+
+		AvailableSeats: 30,
+	}
+	second := Flight{
+		ID:           "JT828_LionAir",
+		FlightNumber: "JT828",
+		Airline:      AirlineInfo{Code: "JT", Name: "Lion Air", Group: "Lion Group"},
+		Departure:    FlightPoint{AirportCode: "SUB", Country: "ID", DateTime: time.Date(2025, 12, 15, 11, 0, 0, 0, wib)},
+		Arrival:      FlightPoint{AirportCode: "LOP", Country: "ID", DateTime: time.Date(2025, 12, 15, 13, 5, 0, 0, wita)},
+		Price:        PriceInfo{Amount: 620000, Currency: "IDR"},
+		Baggage:      BaggageInfo{CabinKg: 7, CheckedKg: 0},
+		Class:        "economy",
+		Provider:     "Lion Air",
+		Amenities:    []string{"meal"},
+
+		AvailableSeats: 12,
+	}
+	return first, second
+}
+
+func TestNewSelfTransfer(t *testing.T) {
+	first, second := selfTransferLegs()
+
+	f := NewSelfTransfer(first, second)
+
+	assert.Equal(t, "QZ7510_AirAsia+JT828_LionAir", f.ID)
+	assert.Equal(t, "QZ7510+JT828", f.FlightNumber)
+	assert.Equal(t, "AirAsia+Lion Air", f.Provider)
+	assert.Equal(t, "CGK", f.Departure.AirportCode)
+	assert.Equal(t, "LOP", f.Arrival.AirportCode)
+	assert.Equal(t, 295, f.Duration.TotalMinutes)
+	assert.Equal(t, 1160000.0, f.Price.Amount)
+	assert.Equal(t, "IDR", f.Price.Currency)
+	assert.Equal(t, BaggageInfo{CabinKg: 7, CheckedKg: 0}, f.Baggage)
+	assert.Equal(t, 12, f.AvailableSeats)
+	assert.Equal(t, []string{"meal"}, f.Amenities)
+	assert.Equal(t, 1, f.Stops)
+	require.Len(t, f.Layovers, 1)
+	assert.Equal(t, "SUB", f.Layovers[0].AirportCode)
+	assert.Equal(t, 140, f.Layovers[0].DurationMinutes)
+	assert.False(t, f.International)
+
+	require.NotNil(t, f.SelfTransfer)
+	assert.Equal(t, SelfTransferUnprotected, f.SelfTransfer.Status)
+	assert.Equal(t, "SUB", f.SelfTransfer.ConnectionAirport)
+	assert.Equal(t, 140, f.SelfTransfer.ConnectionMinutes)
+	assert.Equal(t, []Flight{first, second}, f.SelfTransfer.Legs)
+}
+
+func TestNewSelfTransferStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		firstGroup  string
+		secondGroup string
+		expected    string
+	}{
+		{"same group", "Lion Group", "Lion Group", SelfTransferProtected},
+		{"same group case-insensitive", "lion group", "Lion Group", SelfTransferProtected},
+		{"different groups", "Garuda Indonesia Group", "Lion Group", SelfTransferUnprotected},
+		{"unknown groups", "", "", SelfTransferUnprotected},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, second := selfTransferLegs()
+			first.Airline.Group = tt.firstGroup
+			second.Airline.Group = tt.secondGroup
+
+			f := NewSelfTransfer(first, second)
+
+			assert.Equal(t, tt.expected, f.SelfTransfer.Status)
+		})
+	}
+}
+
+func TestSelfTransferRequiredDocuments(t *testing.T) {
+	first, second := selfTransferLegs()
+	first.Arrival.Country = "SG"
+	first.International = true
+	second.Departure.Country = "SG"
+	second.International = true
+
+	f := NewSelfTransfer(first, second)
+
+	assert.True(t, f.International, "legs cross a border")
+	assert.Equal(t, []string{DocumentPassport, DocumentVisa}, f.RequiredDocuments("US"))
+	assert.Equal(t, []string{DocumentPassport}, f.RequiredDocuments("ID"))
+}
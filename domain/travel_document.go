@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+)
+
+// Travel documents a flight may require.
+const (
+	DocumentPassport = "passport"
+	DocumentVisa     = "visa"
+)
+
+// aseanCountries are ASEAN member states, whose nationals travel visa-free within ASEAN.
+var aseanCountries = map[string]bool{
+	"BN": true, "ID": true, "KH": true, "LA": true, "MM": true,
+	"MY": true, "PH": true, "SG": true, "TH": true, "VN": true,
+}
+
+// TravelDocuments describes the travel documents a passenger has declared.
+type TravelDocuments struct {
+	Nationality string   `json:"nationality,omitempty"` // ISO 3166-1 alpha-2 country code
+	HasPassport bool     `json:"hasPassport"`           // Whether the passenger holds a valid passport
+	Visas       []string `json:"visas,omitempty"`       // Countries (ISO 3166-1 alpha-2) the passenger holds a visa for
+}
+
+// ClassifyRoute sets International from the departure and arrival countries.
+// A flight whose departure or arrival country is unknown is treated as domestic.
+func (f *Flight) ClassifyRoute() {
+	f.International = f.Departure.Country != "" && f.Arrival.Country != "" &&
+		!strings.EqualFold(f.Departure.Country, f.Arrival.Country)
+}
+
+// ApplyReferenceData enriches the airline and both airports from the reference datasets
+// and classifies the route as domestic or international.
+func (f *Flight) ApplyReferenceData() {
+	f.Airline.ApplyAirlineReference()
+	f.Departure.ApplyAirportReference()
+	f.Arrival.ApplyAirportReference()
+	f.ClassifyRoute()
+}
+
+// RequiredDocuments returns the travel documents needed to board the flight.
+// International flights require a passport. A visa is required when the passenger's
+// nationality is known, differs from the arrival country, and is not visa-exempt there
+// (only the ASEAN mutual exemption is modeled). Returns nil for domestic flights.
+// Self-transfers require the documents of every leg, since the passenger enters the
+// connection airport's country.
+func (f *Flight) RequiredDocuments(nationality string) []string {
+	if f.SelfTransfer != nil {
+		var required []string
+		for _, leg := range f.SelfTransfer.Legs {
+			for _, doc := range leg.RequiredDocuments(nationality) {
+				if !slices.Contains(required, doc) {
+					required = append(required, doc)
+				}
+			}
+		}
+		return required
+	}
+
+	if !f.International {
+		return nil
+	}
+
+	required := []string{DocumentPassport}
+	if visaRequired(nationality, f.Arrival.Country) {
+		required = append(required, DocumentVisa)
+	}
+	return required
+}
+
+// AnnotateMissingDocuments sets MissingDocuments to the documents the flight requires
+// that the passenger has not declared. A nil docs means the passenger declared nothing.
+// For self-transfers each leg is checked against its own arrival country, so a visa for
+// the connection airport's country is needed as well as one for the destination.
+func (f *Flight) AnnotateMissingDocuments(docs *TravelDocuments) {
+	var declared TravelDocuments
+	if docs != nil {
+		declared = *docs
+	}
+	f.MissingDocuments = f.missingDocuments(declared)
+}
+
+// missingDocuments returns the documents the flight requires that are not declared.
+func (f *Flight) missingDocuments(declared TravelDocuments) []string {
+	if f.SelfTransfer != nil {
+		var missing []string
+		for _, leg := range f.SelfTransfer.Legs {
+			for _, doc := range leg.missingDocuments(declared) {
+				if !slices.Contains(missing, doc) {
+					missing = append(missing, doc)
+				}
+			}
+		}
+		return missing
+	}
+
+	var missing []string
+	for _, doc := range f.RequiredDocuments(declared.Nationality) {
+		switch doc {
+		case DocumentPassport:
+			if !declared.HasPassport {
+				missing = append(missing, doc)
+			}
+		case DocumentVisa:
+			if !containsFold(declared.Visas, f.Arrival.Country) {
+				missing = append(missing, doc)
+			}
+		}
+	}
+	return missing
+}
+
+// visaRequired reports whether a national of nationality needs a visa to enter country.
+// Returns false if either country is unknown.
+func visaRequired(nationality, country string) bool {
+	nationality = strings.ToUpper(strings.TrimSpace(nationality))
+	country = strings.ToUpper(country)
+	if nationality == "" || country == "" || nationality == country {
+		return false
+	}
+	return !(aseanCountries[nationality] && aseanCountries[country])
+}
@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlightClassifyRoute(t *testing.T) {
+	tests := []struct {
+		name                string
+		departureCountry    string
+		arrivalCountry      string
+		expectInternational bool
+	}{
+		{"domestic", "ID", "ID", false},
+		{"international", "ID", "SG", true},
+		{"case-insensitive", "id", "ID", false},
+		{"unknown departure country", "", "SG", false},
+		{"unknown arrival country", "ID", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := Flight{
+				Departure: FlightPoint{Country: tt.departureCountry},
+				Arrival:   FlightPoint{Country: tt.arrivalCountry},
+			}
+			f.ClassifyRoute()
+			assert.Equal(t, tt.expectInternational, f.International)
+		})
+	}
+}
+
+func TestFlightApplyReferenceData(t *testing.T) {
+	f := Flight{
+		Airline:   AirlineInfo{Code: "GA", Name: "Garuda"},
+		Departure: FlightPoint{AirportCode: "CGK"},
+		Arrival:   FlightPoint{AirportCode: "SIN"},
+	}
+
+	f.ApplyReferenceData()
+
+	assert.Equal(t, "Garuda Indonesia", f.Airline.Name)
+	assert.Equal(t, "ID", f.Departure.Country)
+	assert.Equal(t, "SG", f.Arrival.Country)
+	assert.Equal(t, "Asia/Singapore", f.Arrival.Timezone)
+	assert.True(t, f.International)
+}
+
+func TestFlightRequiredDocuments(t *testing.T) {
+	tests := []struct {
+		name           string
+		international  bool
+		arrivalCountry string
+		nationality    string
+		expected       []string
+	}{
+		{"domestic", false, "ID", "ID", nil},
+		{"international, nationality unknown", true, "SG", "", []string{DocumentPassport}},
+		{"ASEAN visa exemption", true, "SG", "ID", []string{DocumentPassport}},
+		{"returning home", true, "ID", "ID", []string{DocumentPassport}},
+		{"visa required outside ASEAN", true, "JP", "ID", []string{DocumentPassport, DocumentVisa}},
+		{"visa required for non-ASEAN national", true, "SG", "AU", []string{DocumentPassport, DocumentVisa}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := Flight{International: tt.international, Arrival: FlightPoint{Country: tt.arrivalCountry}}
+			assert.Equal(t, tt.expected, f.RequiredDocuments(tt.nationality))
+		})
+	}
+}
+
+func TestFlightAnnotateMissingDocuments(t *testing.T) {
+	tests := []struct {
+		name     string
+		flight   Flight
+		docs     *TravelDocuments
+		expected []string
+	}{
+		{
+			name:     "domestic flight needs nothing",
+			flight:   Flight{Arrival: FlightPoint{Country: "ID"}},
+			docs:     nil,
+			expected: nil,
+		},
+		{
+			name:     "nothing declared",
+			flight:   Flight{International: true, Arrival: FlightPoint{Country: "SG"}},
+			docs:     nil,
+			expected: []string{DocumentPassport},
+		},
+		{
+			name:     "passport declared",
+			flight:   Flight{International: true, Arrival: FlightPoint{Country: "SG"}},
+			docs:     &TravelDocuments{Nationality: "ID", HasPassport: true},
+			expected: nil,
+		},
+		{
+			name:     "visa not declared",
+			flight:   Flight{International: true, Arrival: FlightPoint{Country: "JP"}},
+			docs:     &TravelDocuments{Nationality: "ID", HasPassport: true},
+			expected: []string{DocumentVisa},
+		},
+		{
+			name:     "visa declared",
+			flight:   Flight{International: true, Arrival: FlightPoint{Country: "JP"}},
+			docs:     &TravelDocuments{Nationality: "ID", HasPassport: true, Visas: []string{"JP"}},
+			expected: nil,
+		},
+		{
+			name:     "nothing but nationality declared",
+			flight:   Flight{International: true, Arrival: FlightPoint{Country: "JP"}},
+			docs:     &TravelDocuments{Nationality: "ID"},
+			expected: []string{DocumentPassport, DocumentVisa},
+		},
+		{
+			name: "self-transfer visa for connection country not declared",
+			flight: Flight{
+				International: true,
+				Arrival:       FlightPoint{Country: "MY"},
+				SelfTransfer: &SelfTransfer{Legs: []Flight{
+					{International: true, Arrival: FlightPoint{Country: "SG"}},
+					{International: true, Arrival: FlightPoint{Country: "MY"}},
+				}},
+			},
+			docs:     &TravelDocuments{Nationality: "JP", HasPassport: true, Visas: []string{"MY"}},
+			expected: []string{DocumentVisa},
+		},
+		{
+			name: "self-transfer visas for every leg declared",
+			flight: Flight{
+				International: true,
+				Arrival:       FlightPoint{Country: "MY"},
+				SelfTransfer: &SelfTransfer{Legs: []Flight{
+					{International: true, Arrival: FlightPoint{Country: "SG"}},
+					{International: true, Arrival: FlightPoint{Country: "MY"}},
+				}},
+			},
+			docs:     &TravelDocuments{Nationality: "JP", HasPassport: true, Visas: []string{"SG", "MY"}},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := tt.flight
+			f.AnnotateMissingDocuments(tt.docs)
+			assert.Equal(t, tt.expected, f.MissingDocuments)
+		})
+	}
+}
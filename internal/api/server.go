@@ -3,7 +3,7 @@
 //	@title						Flight Search API
 //	@version					1.0
 //	@description				RESTful API for searching and aggregating flight information from multiple airline providers
-//	@description				This API aggregates flight data from Indonesian airlines including Garuda Indonesia, Lion Air, Batik Air, and AirAsia
+//	@description				This API aggregates flight data from Indonesian airlines including Garuda Indonesia, Lion Air, Batik Air, AirAsia, Citilink, and Super Air Jet
 //
 //	@contact.name				Flight Search API Support
 //	@contact.email				support@flightsearch.example.com
@@ -17,8 +17,12 @@
 //
 //	@tag.name					flights
 //	@tag.description			Flight search and information operations
+//	@tag.name					reference
+//	@tag.description			Reference data lookups such as airports and airlines
 //	@tag.name					health
 //	@tag.description			Service health check operations
+//	@tag.name					admin
+//	@tag.description			Runtime provider administration
 //
 //	@accept						json
 //	@produce					json
@@ -26,30 +30,52 @@
 //	@securitydefinitions.apikey	ApiKeyAuth
 //	@in							header
 //	@name						X-API-Key
-//	@description				API Key for authentication (optional)
+//	@description				API key for the admin endpoints, which are disabled unless ADMIN_API_KEY is set
 package api
 
 import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
 	"os"
+	"strconv"
 	"time"
 
 	_ "github.com/herdiagusthio/flight-search-system/docs" // Import generated Swagger docs
 	"github.com/herdiagusthio/flight-search-system/domain"
 	"github.com/herdiagusthio/flight-search-system/internal/config"
+	"github.com/herdiagusthio/flight-search-system/internal/handler/admin"
 	"github.com/herdiagusthio/flight-search-system/internal/handler/flight"
 	"github.com/herdiagusthio/flight-search-system/internal/handler/httputil"
+	"github.com/herdiagusthio/flight-search-system/internal/handler/reference"
+	"github.com/herdiagusthio/flight-search-system/internal/metrics"
+	"github.com/herdiagusthio/flight-search-system/internal/repository/exchangerate"
 	"github.com/herdiagusthio/flight-search-system/internal/repository/provider/airasia"
 	"github.com/herdiagusthio/flight-search-system/internal/repository/provider/batikair"
+	"github.com/herdiagusthio/flight-search-system/internal/repository/provider/citilink"
 	"github.com/herdiagusthio/flight-search-system/internal/repository/provider/garuda"
+	"github.com/herdiagusthio/flight-search-system/internal/repository/provider/generic"
 	"github.com/herdiagusthio/flight-search-system/internal/repository/provider/lionair"
+	"github.com/herdiagusthio/flight-search-system/internal/repository/provider/superairjet"
+	"github.com/herdiagusthio/flight-search-system/internal/tracing"
 	"github.com/herdiagusthio/flight-search-system/internal/usecase"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	echoSwagger "github.com/swaggo/echo-swagger"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// tracer creates the server spans of HTTP requests.
+var tracer = otel.Tracer("github.com/herdiagusthio/flight-search-system/internal/api")
+
 // SetupLogger configures the global logger based on the provided configuration
 func SetupLogger(cfg *config.Config) {
 	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
@@ -71,13 +97,35 @@ func SetupLogger(cfg *config.Config) {
 	}
 }
 
+// SetupTracing configures OpenTelemetry tracing based on the provided configuration.
+// The returned function flushes pending spans and must be called on shutdown.
+func SetupTracing(cfg *config.Config) (func(context.Context) error, error) {
+	return tracing.Setup(tracing.Config{
+		Exporter:    cfg.Tracing.Exporter,
+		File:        cfg.Tracing.File,
+		ServiceName: cfg.Tracing.ServiceName,
+		SampleRatio: cfg.Tracing.SampleRatio,
+	})
+}
+
 // SetupMiddleware configures the middleware stack for the Echo instance
 func SetupMiddleware(e *echo.Echo) {
 	// Recovery middleware to handle panics
 	e.Use(middleware.Recover())
 
-	// Request ID middleware
-	e.Use(middleware.RequestID())
+	// Request ID middleware; the ID is also set on the request so handlers can read it
+	// after the timeout middleware has replaced the response writer
+	e.Use(middleware.RequestIDWithConfig(middleware.RequestIDConfig{
+		RequestIDHandler: func(c echo.Context, requestID string) {
+			c.Request().Header.Set(echo.HeaderXRequestID, requestID)
+		},
+	}))
+
+	// Server span per request, continuing the W3C trace context of the caller
+	e.Use(traceRequests())
+
+	// Prometheus request counters and latency histograms by route and status
+	e.Use(requestMetrics())
 
 	// Logger middleware with zerolog integration
 	e.Use(middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
@@ -100,29 +148,98 @@ func SetupMiddleware(e *echo.Echo) {
 }
 
 // SetupDependencies initializes all dependencies (providers, usecase, handlers)
-func SetupDependencies(cfg *config.Config) *flight.FlightHandler {
+func SetupDependencies(cfg *config.Config) (*flight.FlightHandler, *admin.AdminHandler) {
 	// Initialize provider adapters
 	garudaProvider := garuda.NewAdapter("external/response-mock/garuda_indonesia_search_response.json", false)
 	lionProvider := lionair.NewAdapter("external/response-mock/lion_air_search_response.json", false)
 	batikProvider := batikair.NewAdapter("external/response-mock/batik_air_search_response.json", false)
 	airasiaProvider := airasia.NewAdapter("external/response-mock/airasia_search_response.json", false)
+	citilinkProvider := citilink.NewAdapter("external/response-mock/citilink_search_response.json", false)
+	superAirJetProvider := superairjet.NewAdapter("external/response-mock/super_air_jet_search_response.json", false)
 
-	providers := []domain.FlightProvider{
+	// Register providers; they can be enabled, disabled and reweighted at runtime
+	registry := domain.NewProviderRegistryWith(
 		garudaProvider,
 		lionProvider,
 		batikProvider,
 		airasiaProvider,
+		citilinkProvider,
+		superAirJetProvider,
+	)
+
+	// Register providers described by mapping files. A mapping named after an existing
+	// provider replaces its hand-written adapter.
+	for _, path := range cfg.Providers.MappingFiles {
+		mapping, err := generic.LoadMapping(path)
+		if err != nil {
+			log.Error().Err(err).Str("file", path).Msg("Skipping provider with invalid mapping file")
+			continue
+		}
+		registry.Register(generic.NewAdapter(mapping, mapping.MockData, false))
+		log.Info().Str("provider", mapping.Provider).Str("file", path).Msg("Registered provider from mapping file")
+	}
+
+	// Initialize exchange rate source for multi-currency pricing
+	var exchangeRates domain.ExchangeRateProvider
+	if cfg.Currency.ExchangeRateFile != "" {
+		exchangeRates = exchangerate.NewStaticProvider(cfg.Currency.ExchangeRateFile)
+	}
+
+	// Per-provider rate limits; the overrides were validated when loading the config
+	providerLimits, _ := cfg.RateLimit.ProviderLimits()
+	rateLimits := usecase.RateLimitConfig{
+		Default: usecase.RateLimit{
+			RequestsPerSecond: cfg.RateLimit.RequestsPerSecond,
+			Burst:             cfg.RateLimit.Burst,
+			MaxInFlight:       cfg.RateLimit.MaxInFlight,
+		},
+		Providers:    make(map[string]usecase.RateLimit, len(providerLimits)),
+		QueueTimeout: cfg.RateLimit.QueueTimeout,
+	}
+	if rateLimits.QueueTimeout == 0 {
+		// A zero queue timeout in the config means not waiting, which the use case
+		// expresses as a negative timeout
+		rateLimits.QueueTimeout = -1
+	}
+	for name, limit := range providerLimits {
+		rateLimits.Providers[name] = usecase.RateLimit(limit)
 	}
 
-	// Initialize usecase with timeout configuration
+	// Initialize usecase with timeout and currency configuration
 	usecaseConfig := &usecase.Config{
 		GlobalTimeout:   cfg.Timeouts.GlobalSearch,
 		ProviderTimeout: cfg.Timeouts.Provider,
+		ExchangeRates:   exchangeRates,
+		BaseCurrency:    cfg.Currency.BaseCurrency,
+		RankingWeights: usecase.RankingWeights{
+			usecase.FactorPrice:         cfg.Ranking.WeightPrice,
+			usecase.FactorDuration:      cfg.Ranking.WeightDuration,
+			usecase.FactorStops:         cfg.Ranking.WeightStops,
+			usecase.FactorDepartureTime: cfg.Ranking.WeightDepartureTime,
+			usecase.FactorBaggage:       cfg.Ranking.WeightBaggage,
+			usecase.FactorAmenities:     cfg.Ranking.WeightAmenities,
+			usecase.FactorOnTime:        cfg.Ranking.WeightOnTime,
+			usecase.FactorSeats:         cfg.Ranking.WeightSeats,
+		},
+		SelfTransfer: usecase.SelfTransferConfig{
+			Enabled:              cfg.SelfTransfer.Enabled,
+			Hubs:                 cfg.SelfTransfer.Hubs,
+			DefaultMinConnection: cfg.SelfTransfer.MinConnection,
+			MaxConnection:        cfg.SelfTransfer.MaxConnection,
+			MinSaving:            cfg.SelfTransfer.MinSaving,
+		},
+		Explore: usecase.ExploreConfig{
+			MaxDays:             cfg.Explore.MaxDays,
+			ProviderConcurrency: cfg.Explore.ProviderConcurrency,
+		},
+		ResultCacheTTL: cfg.Cache.ResultTTL,
+		RateLimits:     rateLimits,
 	}
-	searchUseCase := usecase.NewFlightSearchUseCase(providers, usecaseConfig)
+	searchUseCase := usecase.NewFlightSearchUseCaseWithRegistry(registry, usecaseConfig)
+	providerAdminUseCase := usecase.NewProviderAdminUseCase(registry, usecase.DefaultAuditLogSize)
 
-	// Initialize and return flight handler
-	return flight.NewFlightHandler(searchUseCase, &log.Logger)
+	// Initialize and return handlers
+	return flight.NewFlightHandler(searchUseCase, &log.Logger), admin.NewAdminHandler(providerAdminUseCase, &log.Logger)
 }
 
 // SetupRouter configures all routes and route-specific middleware
@@ -135,8 +252,11 @@ func SetupRouter(e *echo.Echo, cfg *config.Config) {
 		return httputil.HealthCheck(c)
 	})
 
+	// Prometheus metrics endpoint
+	e.GET("/metrics", echo.WrapHandler(promhttp.Handler()))
+
 	// Initialize dependencies
-	flightHandler := SetupDependencies(cfg)
+	flightHandler, adminHandler := SetupDependencies(cfg)
 
 	// API v1 group with middleware
 	v1 := e.Group("/api/v1")
@@ -144,8 +264,8 @@ func SetupRouter(e *echo.Echo, cfg *config.Config) {
 	// Configure CORS middleware for API routes
 	v1.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins:     []string{"*"}, // Configure based on environment in production
-		AllowMethods:     []string{echo.GET, echo.POST, echo.OPTIONS},
-		AllowHeaders:     []string{echo.HeaderContentType, echo.HeaderAuthorization, echo.HeaderXRequestID},
+		AllowMethods:     []string{echo.GET, echo.POST, echo.PUT, echo.OPTIONS},
+		AllowHeaders:     []string{echo.HeaderContentType, echo.HeaderAuthorization, echo.HeaderXRequestID, adminAPIKeyHeader},
 		AllowCredentials: false,
 	}))
 
@@ -157,4 +277,111 @@ func SetupRouter(e *echo.Echo, cfg *config.Config) {
 	// Register flight routes
 	flights := v1.Group("/flights")
 	flights.POST("/search", flightHandler.HandleSearch)
+	flights.POST("/explore", flightHandler.HandleExplore)
+
+	// Register reference data routes
+	referenceHandler := reference.NewReferenceHandler(&log.Logger)
+	v1.GET("/airports", referenceHandler.HandleSearchAirports)
+	v1.GET("/airlines", referenceHandler.HandleListAirlines)
+
+	// Register admin routes only when an API key protects them
+	if cfg.Admin.APIKey == "" {
+		log.Warn().Msg("ADMIN_API_KEY is not set, admin endpoints are disabled")
+		return
+	}
+	providers := v1.Group("/admin/providers", adminKeyAuth(cfg.Admin.APIKey))
+	providers.GET("", adminHandler.HandleListProviders)
+	providers.GET("/audit", adminHandler.HandleProviderAuditLog)
+	providers.POST("/:name/enable", adminHandler.HandleEnableProvider)
+	providers.POST("/:name/disable", adminHandler.HandleDisableProvider)
+	providers.PUT("/:name/weight", adminHandler.HandleSetProviderWeight)
+}
+
+// adminAPIKeyHeader is the request header carrying the admin API key.
+const adminAPIKeyHeader = "X-API-Key"
+
+// adminKeyAuth returns middleware rejecting requests without the admin API key.
+func adminKeyAuth(apiKey string) echo.MiddlewareFunc {
+	return middleware.KeyAuthWithConfig(middleware.KeyAuthConfig{
+		KeyLookup: "header:" + adminAPIKeyHeader,
+		Validator: func(key string, _ echo.Context) (bool, error) {
+			return subtle.ConstantTimeCompare([]byte(key), []byte(apiKey)) == 1, nil
+		},
+		ErrorHandler: func(_ error, c echo.Context) error {
+			return httputil.Unauthorized(c)
+		},
+	})
+}
+
+// requestMetrics returns middleware recording HTTP request counts and latency by method,
+// route and status code. The route is the registered path, such as
+// /api/v1/admin/providers/:name/enable, keeping the label cardinality bounded.
+func requestMetrics() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			err := next(c)
+
+			labels := []string{c.Request().Method, routeOf(c), strconv.Itoa(responseStatus(c, err))}
+			metrics.HTTPRequests.WithLabelValues(labels...).Inc()
+			metrics.HTTPRequestDuration.WithLabelValues(labels...).Observe(time.Since(start).Seconds())
+			return err
+		}
+	}
+}
+
+// traceRequests returns middleware tracing each request in a server span. The trace
+// context of the caller is extracted from the W3C traceparent and tracestate headers.
+func traceRequests() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			ctx := otel.GetTextMapPropagator().Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+
+			route := routeOf(c)
+			ctx, span := tracer.Start(ctx, req.Method+" "+route,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					attribute.String("http.request.method", req.Method),
+					attribute.String("http.route", route),
+					attribute.String("request_id", req.Header.Get(echo.HeaderXRequestID)),
+				),
+			)
+			defer span.End()
+			c.SetRequest(req.WithContext(ctx))
+
+			err := next(c)
+
+			status := responseStatus(c, err)
+			span.SetAttributes(attribute.Int("http.response.status_code", status))
+			if status >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(status))
+			}
+			return err
+		}
+	}
+}
+
+// routeOf returns the registered path of the request's route, or "unmatched" if no
+// route matched.
+func routeOf(c echo.Context) string {
+	if route := c.Path(); route != "" {
+		return route
+	}
+	return "unmatched"
+}
+
+// responseStatus returns the status code of the response to a request handled with err.
+func responseStatus(c echo.Context, err error) int {
+	if err == nil {
+		return c.Response().Status
+	}
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Code
+	}
+	if !c.Response().Committed {
+		return http.StatusInternalServerError
+	}
+	return c.Response().Status
 }
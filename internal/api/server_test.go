@@ -3,6 +3,8 @@ package api
 import (
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -10,6 +12,13 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestSetupLogger(t *testing.T) {
@@ -377,3 +386,186 @@ func TestRequestIDMiddleware(t *testing.T) {
 		assert.NotEmpty(t, requestID)
 	})
 }
+
+func TestMetricsEndpoint(t *testing.T) {
+	e := echo.New()
+	cfg := &config.Config{
+		Server: config.ServerConfig{
+			Port:         8080,
+			ReadTimeout:  5 * time.Second,
+			WriteTimeout: 5 * time.Second,
+		},
+		Timeouts: config.TimeoutConfig{
+			GlobalSearch: 5 * time.Second,
+			Provider:     2 * time.Second,
+		},
+		Admin: config.AdminConfig{APIKey: "s3cret"},
+	}
+
+	SetupMiddleware(e)
+	SetupRouter(e, cfg)
+
+	for _, r := range []struct{ method, path string }{
+		{http.MethodGet, "/health"},
+		{http.MethodGet, "/unknown"},
+		{http.MethodPost, "/api/v1/flights/search"},
+		{http.MethodPost, "/api/v1/admin/providers/garuda_indonesia/enable"},
+	} {
+		req := httptest.NewRequest(r.method, r.path, nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(adminAPIKeyHeader, "s3cret")
+		e.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := rec.Body.String()
+	assert.Contains(t, body, `flight_search_http_requests_total{method="GET",route="/health",status="200"}`)
+	assert.Contains(t, body, `flight_search_http_requests_total{method="POST",route="/api/v1/flights/search",status="400"}`)
+	assert.Contains(t, body, `flight_search_http_request_duration_seconds_bucket{method="POST",route="/api/v1/admin/providers/:name/enable",status="200"`)
+	assert.Contains(t, body, `flight_search_http_requests_total{method="GET",route="unmatched",status="404"}`)
+	assert.NotContains(t, body, `route="/unknown"`, "unmatched paths should not be route labels")
+}
+
+func TestTraceRequests(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	e := echo.New()
+	SetupMiddleware(e)
+	e.GET("/api/v1/airports/:code", func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/airports/CGK", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "GET /api/v1/airports/:code", span.Name())
+	assert.Equal(t, trace.SpanKindServer, span.SpanKind())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", span.Parent().SpanID().String())
+	assert.True(t, span.Parent().IsRemote())
+	assert.Contains(t, span.Attributes(), attribute.String("http.route", "/api/v1/airports/:code"))
+	assert.Contains(t, span.Attributes(), attribute.Int("http.response.status_code", http.StatusOK))
+	assert.Contains(t, span.Attributes(), attribute.String("request_id", rec.Header().Get(echo.HeaderXRequestID)))
+}
+
+func TestAdminProviderRoutes(t *testing.T) {
+	tests := []struct {
+		name           string
+		apiKey         string
+		requestKey     string
+		method         string
+		path           string
+		expectedStatus int
+	}{
+		{
+			name:           "not registered without configured key",
+			method:         http.MethodPost,
+			path:           "/api/v1/admin/providers/airasia/disable",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "unknown provider",
+			apiKey:         "s3cret",
+			requestKey:     "s3cret",
+			method:         http.MethodPost,
+			path:           "/api/v1/admin/providers/pelita_air/disable",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "missing API key",
+			apiKey:         "s3cret",
+			method:         http.MethodGet,
+			path:           "/api/v1/admin/providers",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "wrong API key",
+			apiKey:         "s3cret",
+			requestKey:     "guess",
+			method:         http.MethodGet,
+			path:           "/api/v1/admin/providers/audit",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "valid API key",
+			apiKey:         "s3cret",
+			requestKey:     "s3cret",
+			method:         http.MethodPost,
+			path:           "/api/v1/admin/providers/airasia/disable",
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			cfg := &config.Config{
+				Timeouts: config.TimeoutConfig{
+					GlobalSearch: 5 * time.Second,
+					Provider:     2 * time.Second,
+				},
+				Admin: config.AdminConfig{APIKey: tt.apiKey},
+			}
+
+			SetupRouter(e, cfg)
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.requestKey != "" {
+				req.Header.Set(adminAPIKeyHeader, tt.requestKey)
+			}
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestSetupDependenciesWithMappingFiles(t *testing.T) {
+	dir := t.TempDir()
+	validMapping := filepath.Join(dir, "test_air.yaml")
+	require.NoError(t, os.WriteFile(validMapping, []byte(`
+provider: test_air
+mock_data: test_air.json
+flights: flights
+departure: {airport: from, time: depart}
+arrival: {airport: to, time: arrive}
+price: {amount: price, default_currency: IDR}
+`), 0o600))
+	invalidMapping := filepath.Join(dir, "invalid.yaml")
+	require.NoError(t, os.WriteFile(invalidMapping, []byte("provider: broken_air"), 0o600))
+
+	e := echo.New()
+	cfg := &config.Config{
+		Timeouts: config.TimeoutConfig{
+			GlobalSearch: 5 * time.Second,
+			Provider:     2 * time.Second,
+		},
+		Providers: config.ProviderConfig{MappingFiles: []string{validMapping, invalidMapping, filepath.Join(dir, "missing.yaml")}},
+		Admin:     config.AdminConfig{APIKey: "s3cret"},
+	}
+
+	SetupRouter(e, cfg)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/providers", nil)
+	req.Header.Set(adminAPIKeyHeader, "s3cret")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"test_air"`)
+	assert.NotContains(t, rec.Body.String(), `"broken_air"`)
+}
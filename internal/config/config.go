@@ -2,6 +2,9 @@ package config
 
 import (
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/caarlos0/env/v10"
@@ -10,11 +13,20 @@ import (
 )
 
 type Config struct {
-	Server   ServerConfig
-	Timeouts TimeoutConfig
-	Retry    RetryConfig
-	Logging  LoggingConfig
-	App      AppConfig
+	Server       ServerConfig
+	Timeouts     TimeoutConfig
+	Retry        RetryConfig
+	Currency     CurrencyConfig
+	Ranking      RankingConfig
+	SelfTransfer SelfTransferConfig
+	Explore      ExploreConfig
+	Cache        CacheConfig
+	Providers    ProviderConfig
+	RateLimit    RateLimitConfig
+	Admin        AdminConfig
+	Logging      LoggingConfig
+	Tracing      TracingConfig
+	App          AppConfig
 }
 
 type ServerConfig struct {
@@ -35,11 +47,110 @@ type RetryConfig struct {
 	Multiplier   float64       `env:"RETRY_MULTIPLIER" envDefault:"2.0"`
 }
 
+type CurrencyConfig struct {
+	BaseCurrency     string `env:"BASE_CURRENCY" envDefault:"IDR"`
+	ExchangeRateFile string `env:"EXCHANGE_RATE_FILE" envDefault:"external/exchange-rate/rates.json"`
+}
+
+type RankingConfig struct {
+	WeightPrice         float64 `env:"RANKING_WEIGHT_PRICE" envDefault:"0.5"`
+	WeightDuration      float64 `env:"RANKING_WEIGHT_DURATION" envDefault:"0.3"`
+	WeightStops         float64 `env:"RANKING_WEIGHT_STOPS" envDefault:"0.2"`
+	WeightDepartureTime float64 `env:"RANKING_WEIGHT_DEPARTURE_TIME" envDefault:"0"`
+	WeightBaggage       float64 `env:"RANKING_WEIGHT_BAGGAGE" envDefault:"0"`
+	WeightAmenities     float64 `env:"RANKING_WEIGHT_AMENITIES" envDefault:"0"`
+	WeightOnTime        float64 `env:"RANKING_WEIGHT_ON_TIME" envDefault:"0"`
+	WeightSeats         float64 `env:"RANKING_WEIGHT_SEATS" envDefault:"0"`
+}
+
+type SelfTransferConfig struct {
+	Enabled       bool          `env:"SELF_TRANSFER_ENABLED" envDefault:"false"`
+	Hubs          []string      `env:"SELF_TRANSFER_HUBS" envDefault:"CGK,SUB,DPS,UPG,KNO,SIN,KUL" envSeparator:","`
+	MinConnection time.Duration `env:"SELF_TRANSFER_MIN_CONNECTION" envDefault:"90m"`
+	MaxConnection time.Duration `env:"SELF_TRANSFER_MAX_CONNECTION" envDefault:"8h"`
+	MinSaving     float64       `env:"SELF_TRANSFER_MIN_SAVING" envDefault:"0.2"`
+}
+
+type ExploreConfig struct {
+	MaxDays             int `env:"EXPLORE_MAX_DAYS" envDefault:"7"`
+	ProviderConcurrency int `env:"EXPLORE_PROVIDER_CONCURRENCY" envDefault:"2"`
+}
+
+type CacheConfig struct {
+	ResultTTL time.Duration `env:"RESULT_CACHE_TTL" envDefault:"0"`
+}
+
+// ProviderConfig configures additional providers. Each mapping file describes a
+// provider served by the generic JSON adapter.
+type ProviderConfig struct {
+	MappingFiles []string `env:"PROVIDER_MAPPING_FILES" envSeparator:","`
+}
+
+// RateLimitConfig configures per-provider rate limits and in-flight caps. Zero values
+// mean no limit. Overrides maps provider names to "rps:burst:max_in_flight" limits
+// replacing the defaults, e.g. "garuda_indonesia=5:10:2,lion_air=2:2:1".
+type RateLimitConfig struct {
+	RequestsPerSecond float64           `env:"PROVIDER_RATE_LIMIT_RPS" envDefault:"0"`
+	Burst             int               `env:"PROVIDER_RATE_LIMIT_BURST" envDefault:"0"`
+	MaxInFlight       int               `env:"PROVIDER_MAX_IN_FLIGHT" envDefault:"0"`
+	QueueTimeout      time.Duration     `env:"PROVIDER_RATE_LIMIT_QUEUE_TIMEOUT" envDefault:"100ms"`
+	Overrides         map[string]string `env:"PROVIDER_RATE_LIMITS" envSeparator:"," envKeyValSeparator:"="`
+}
+
+// ProviderRateLimit is the rate limit of one provider.
+type ProviderRateLimit struct {
+	RequestsPerSecond float64
+	Burst             int
+	MaxInFlight       int
+}
+
+// ProviderLimits parses the per-provider overrides.
+func (c RateLimitConfig) ProviderLimits() (map[string]ProviderRateLimit, error) {
+	limits := make(map[string]ProviderRateLimit, len(c.Overrides))
+	for name, value := range c.Overrides {
+		parts := strings.Split(value, ":")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("rate limit of %q must be rps:burst:max_in_flight; got %q", name, value)
+		}
+		rps, err := strconv.ParseFloat(parts[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("rate limit of %q: invalid rps %q", name, parts[0])
+		}
+		burst, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("rate limit of %q: invalid burst %q", name, parts[1])
+		}
+		inFlight, err := strconv.Atoi(parts[2])
+		if err != nil {
+			return nil, fmt.Errorf("rate limit of %q: invalid max in flight %q", name, parts[2])
+		}
+		if rps < 0 || burst < 0 || inFlight < 0 {
+			return nil, fmt.Errorf("rate limit of %q must not be negative; got %q", name, value)
+		}
+		limits[strings.TrimSpace(name)] = ProviderRateLimit{RequestsPerSecond: rps, Burst: burst, MaxInFlight: inFlight}
+	}
+	return limits, nil
+}
+
+// AdminConfig configures the admin endpoints. If APIKey is empty they are not registered.
+type AdminConfig struct {
+	APIKey string `env:"ADMIN_API_KEY"`
+}
+
 type LoggingConfig struct {
 	Level  string `env:"LOG_LEVEL" envDefault:"info"`
 	Format string `env:"LOG_FORMAT" envDefault:"json"`
 }
 
+// TracingConfig configures OpenTelemetry tracing. Exporter is none (or empty), stdout
+// or otlp-file; otlp-file appends OTLP JSON lines to File.
+type TracingConfig struct {
+	Exporter    string  `env:"TRACING_EXPORTER" envDefault:"none"`
+	File        string  `env:"TRACING_FILE" envDefault:"traces.jsonl"`
+	ServiceName string  `env:"TRACING_SERVICE_NAME" envDefault:"flight-search-api"`
+	SampleRatio float64 `env:"TRACING_SAMPLE_RATIO" envDefault:"1"`
+}
+
 type AppConfig struct {
 	Env string `env:"ENV" envDefault:"development"`
 }
@@ -114,6 +225,88 @@ func validate(cfg *Config) error {
 		return fmt.Errorf("RETRY_MULTIPLIER must be at least 1.0; got %f", cfg.Retry.Multiplier)
 	}
 
+	// Validate currency configuration
+	if cfg.Currency.BaseCurrency != "" && !isCurrencyCode(cfg.Currency.BaseCurrency) {
+		return fmt.Errorf("BASE_CURRENCY must be a 3-letter uppercase ISO 4217 code; got %q", cfg.Currency.BaseCurrency)
+	}
+
+	// Validate ranking weights
+	weights := []struct {
+		env   string
+		value float64
+	}{
+		{"RANKING_WEIGHT_PRICE", cfg.Ranking.WeightPrice},
+		{"RANKING_WEIGHT_DURATION", cfg.Ranking.WeightDuration},
+		{"RANKING_WEIGHT_STOPS", cfg.Ranking.WeightStops},
+		{"RANKING_WEIGHT_DEPARTURE_TIME", cfg.Ranking.WeightDepartureTime},
+		{"RANKING_WEIGHT_BAGGAGE", cfg.Ranking.WeightBaggage},
+		{"RANKING_WEIGHT_AMENITIES", cfg.Ranking.WeightAmenities},
+		{"RANKING_WEIGHT_ON_TIME", cfg.Ranking.WeightOnTime},
+		{"RANKING_WEIGHT_SEATS", cfg.Ranking.WeightSeats},
+	}
+	weightSum := 0.0
+	for _, w := range weights {
+		if w.value < 0 {
+			return fmt.Errorf("%s must be non-negative; got %g", w.env, w.value)
+		}
+		weightSum += w.value
+	}
+	if math.Abs(weightSum-1) > 1e-6 {
+		return fmt.Errorf("RANKING_WEIGHT_* values must sum to 1; got %g", weightSum)
+	}
+
+	// Validate self-transfer configuration
+	if cfg.SelfTransfer.Enabled {
+		if len(cfg.SelfTransfer.Hubs) == 0 {
+			return fmt.Errorf("SELF_TRANSFER_HUBS must not be empty when SELF_TRANSFER_ENABLED is set")
+		}
+		for _, hub := range cfg.SelfTransfer.Hubs {
+			if !isAirportCode(hub) {
+				return fmt.Errorf("SELF_TRANSFER_HUBS must contain 3-letter uppercase IATA codes; got %q", hub)
+			}
+		}
+		if cfg.SelfTransfer.MinConnection <= 0 {
+			return fmt.Errorf("SELF_TRANSFER_MIN_CONNECTION must be positive; got %v", cfg.SelfTransfer.MinConnection)
+		}
+		if cfg.SelfTransfer.MaxConnection < cfg.SelfTransfer.MinConnection {
+			return fmt.Errorf("SELF_TRANSFER_MAX_CONNECTION (%v) should not be less than SELF_TRANSFER_MIN_CONNECTION (%v)",
+				cfg.SelfTransfer.MaxConnection, cfg.SelfTransfer.MinConnection)
+		}
+		if cfg.SelfTransfer.MinSaving < 0 || cfg.SelfTransfer.MinSaving >= 1 {
+			return fmt.Errorf("SELF_TRANSFER_MIN_SAVING must be at least 0 and less than 1; got %g", cfg.SelfTransfer.MinSaving)
+		}
+	}
+
+	// Validate explore configuration
+	if cfg.Explore.MaxDays < 1 {
+		return fmt.Errorf("EXPLORE_MAX_DAYS must be at least 1; got %d", cfg.Explore.MaxDays)
+	}
+	if cfg.Explore.ProviderConcurrency < 1 {
+		return fmt.Errorf("EXPLORE_PROVIDER_CONCURRENCY must be at least 1; got %d", cfg.Explore.ProviderConcurrency)
+	}
+
+	// Validate result cache TTL (0 disables caching)
+	if cfg.Cache.ResultTTL < 0 {
+		return fmt.Errorf("RESULT_CACHE_TTL must not be negative; got %v", cfg.Cache.ResultTTL)
+	}
+
+	// Validate rate limits (0 disables a limit)
+	if cfg.RateLimit.RequestsPerSecond < 0 {
+		return fmt.Errorf("PROVIDER_RATE_LIMIT_RPS must not be negative; got %g", cfg.RateLimit.RequestsPerSecond)
+	}
+	if cfg.RateLimit.Burst < 0 {
+		return fmt.Errorf("PROVIDER_RATE_LIMIT_BURST must not be negative; got %d", cfg.RateLimit.Burst)
+	}
+	if cfg.RateLimit.MaxInFlight < 0 {
+		return fmt.Errorf("PROVIDER_MAX_IN_FLIGHT must not be negative; got %d", cfg.RateLimit.MaxInFlight)
+	}
+	if cfg.RateLimit.QueueTimeout < 0 {
+		return fmt.Errorf("PROVIDER_RATE_LIMIT_QUEUE_TIMEOUT must not be negative; got %v", cfg.RateLimit.QueueTimeout)
+	}
+	if _, err := cfg.RateLimit.ProviderLimits(); err != nil {
+		return fmt.Errorf("PROVIDER_RATE_LIMITS: %w", err)
+	}
+
 	// Validate log level
 	validLevels := map[string]bool{"debug": true, "info": true, "warn": true, "error": true}
 	if !validLevels[cfg.Logging.Level] {
@@ -126,6 +319,18 @@ func validate(cfg *Config) error {
 		return fmt.Errorf("LOG_FORMAT must be one of: json, console; got %q", cfg.Logging.Format)
 	}
 
+	// Validate tracing configuration
+	validExporters := map[string]bool{"none": true, "stdout": true, "otlp-file": true}
+	if cfg.Tracing.Exporter != "" && !validExporters[cfg.Tracing.Exporter] {
+		return fmt.Errorf("TRACING_EXPORTER must be one of: none, stdout, otlp-file; got %q", cfg.Tracing.Exporter)
+	}
+	if cfg.Tracing.Exporter == "otlp-file" && cfg.Tracing.File == "" {
+		return fmt.Errorf("TRACING_FILE must not be empty when TRACING_EXPORTER is otlp-file")
+	}
+	if cfg.Tracing.SampleRatio < 0 || cfg.Tracing.SampleRatio > 1 {
+		return fmt.Errorf("TRACING_SAMPLE_RATIO must be between 0 and 1; got %g", cfg.Tracing.SampleRatio)
+	}
+
 	// Validate app environment
 	validEnvs := map[string]bool{"development": true, "staging": true, "production": true}
 	if !validEnvs[cfg.App.Env] {
@@ -135,3 +340,21 @@ func validate(cfg *Config) error {
 	return nil
 }
 
+// isCurrencyCode reports whether code is three uppercase ASCII letters.
+func isCurrencyCode(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// isAirportCode reports whether code is an IATA airport code, which has the same
+// three uppercase letter shape as a currency code.
+func isAirportCode(code string) bool {
+	return isCurrencyCode(code)
+}
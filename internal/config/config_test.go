@@ -19,6 +19,61 @@ func validRetryConfig() RetryConfig {
 	}
 }
 
+// validRankingConfig returns a valid ranking configuration for testing
+func validRankingConfig() RankingConfig {
+	return RankingConfig{
+		WeightPrice:    0.5,
+		WeightDuration: 0.3,
+		WeightStops:    0.2,
+	}
+}
+
+// defaultSelfTransferConfig returns the default self-transfer configuration
+func defaultSelfTransferConfig() SelfTransferConfig {
+	return SelfTransferConfig{
+		Hubs:          []string{"CGK", "SUB", "DPS", "UPG", "KNO", "SIN", "KUL"},
+		MinConnection: 90 * time.Minute,
+		MaxConnection: 8 * time.Hour,
+		MinSaving:     0.2,
+	}
+}
+
+// defaultExploreConfig returns the default explore configuration
+func defaultExploreConfig() ExploreConfig {
+	return ExploreConfig{
+		MaxDays:             7,
+		ProviderConcurrency: 2,
+	}
+}
+
+// defaultCacheConfig returns the default cache configuration, disabled
+func defaultCacheConfig() CacheConfig {
+	return CacheConfig{}
+}
+
+// defaultRateLimitConfig returns the default rate limit configuration
+func defaultRateLimitConfig() RateLimitConfig {
+	return RateLimitConfig{QueueTimeout: 100 * time.Millisecond}
+}
+
+// defaultTracingConfig returns the default tracing configuration
+func defaultTracingConfig() TracingConfig {
+	return TracingConfig{
+		Exporter:    "none",
+		File:        "traces.jsonl",
+		ServiceName: "flight-search-api",
+		SampleRatio: 1,
+	}
+}
+
+// defaultCurrencyConfig returns the default currency configuration
+func defaultCurrencyConfig() CurrencyConfig {
+	return CurrencyConfig{
+		BaseCurrency:     "IDR",
+		ExchangeRateFile: "external/exchange-rate/rates.json",
+	}
+}
+
 func TestValidate(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -38,7 +93,9 @@ func TestValidate(t *testing.T) {
 					GlobalSearch: 5 * time.Second,
 					Provider:     2 * time.Second,
 				},
-				Retry: validRetryConfig(),
+				Retry:   validRetryConfig(),
+				Ranking: validRankingConfig(),
+				Explore: defaultExploreConfig(),
 				Logging: LoggingConfig{
 					Level:  "info",
 					Format: "json",
@@ -61,7 +118,9 @@ func TestValidate(t *testing.T) {
 					GlobalSearch: 5 * time.Second,
 					Provider:     2 * time.Second,
 				},
-				Retry: validRetryConfig(),
+				Retry:   validRetryConfig(),
+				Ranking: validRankingConfig(),
+				Explore: defaultExploreConfig(),
 				Logging: LoggingConfig{
 					Level:  "info",
 					Format: "json",
@@ -85,7 +144,9 @@ func TestValidate(t *testing.T) {
 					GlobalSearch: 5 * time.Second,
 					Provider:     2 * time.Second,
 				},
-				Retry: validRetryConfig(),
+				Retry:   validRetryConfig(),
+				Ranking: validRankingConfig(),
+				Explore: defaultExploreConfig(),
 				Logging: LoggingConfig{
 					Level:  "info",
 					Format: "json",
@@ -109,7 +170,9 @@ func TestValidate(t *testing.T) {
 					GlobalSearch: 5 * time.Second,
 					Provider:     2 * time.Second,
 				},
-				Retry: validRetryConfig(),
+				Retry:   validRetryConfig(),
+				Ranking: validRankingConfig(),
+				Explore: defaultExploreConfig(),
 				Logging: LoggingConfig{
 					Level:  "info",
 					Format: "json",
@@ -133,7 +196,9 @@ func TestValidate(t *testing.T) {
 					GlobalSearch: 5 * time.Second,
 					Provider:     2 * time.Second,
 				},
-				Retry: validRetryConfig(),
+				Retry:   validRetryConfig(),
+				Ranking: validRankingConfig(),
+				Explore: defaultExploreConfig(),
 				Logging: LoggingConfig{
 					Level:  "info",
 					Format: "json",
@@ -156,7 +221,9 @@ func TestValidate(t *testing.T) {
 					GlobalSearch: 5 * time.Second,
 					Provider:     2 * time.Second,
 				},
-				Retry: validRetryConfig(),
+				Retry:   validRetryConfig(),
+				Ranking: validRankingConfig(),
+				Explore: defaultExploreConfig(),
 				Logging: LoggingConfig{
 					Level:  "info",
 					Format: "json",
@@ -179,7 +246,9 @@ func TestValidate(t *testing.T) {
 					GlobalSearch: 5 * time.Second,
 					Provider:     2 * time.Second,
 				},
-				Retry: validRetryConfig(),
+				Retry:   validRetryConfig(),
+				Ranking: validRankingConfig(),
+				Explore: defaultExploreConfig(),
 				Logging: LoggingConfig{
 					Level:  "info",
 					Format: "json",
@@ -203,7 +272,9 @@ func TestValidate(t *testing.T) {
 					GlobalSearch: 5 * time.Second,
 					Provider:     2 * time.Second,
 				},
-				Retry: validRetryConfig(),
+				Retry:   validRetryConfig(),
+				Ranking: validRankingConfig(),
+				Explore: defaultExploreConfig(),
 				Logging: LoggingConfig{
 					Level:  "info",
 					Format: "json",
@@ -227,7 +298,9 @@ func TestValidate(t *testing.T) {
 					GlobalSearch: 5 * time.Second,
 					Provider:     2 * time.Second,
 				},
-				Retry: validRetryConfig(),
+				Retry:   validRetryConfig(),
+				Ranking: validRankingConfig(),
+				Explore: defaultExploreConfig(),
 				Logging: LoggingConfig{
 					Level:  "info",
 					Format: "json",
@@ -251,7 +324,9 @@ func TestValidate(t *testing.T) {
 					GlobalSearch: 0,
 					Provider:     2 * time.Second,
 				},
-				Retry: validRetryConfig(),
+				Retry:   validRetryConfig(),
+				Ranking: validRankingConfig(),
+				Explore: defaultExploreConfig(),
 				Logging: LoggingConfig{
 					Level:  "info",
 					Format: "json",
@@ -344,7 +419,9 @@ func TestValidate(t *testing.T) {
 					GlobalSearch: 5 * time.Second,
 					Provider:     2 * time.Second,
 				},
-				Retry: validRetryConfig(),
+				Retry:   validRetryConfig(),
+				Ranking: validRankingConfig(),
+				Explore: defaultExploreConfig(),
 				Logging: LoggingConfig{
 					Level:  "invalid",
 					Format: "json",
@@ -368,7 +445,9 @@ func TestValidate(t *testing.T) {
 					GlobalSearch: 5 * time.Second,
 					Provider:     2 * time.Second,
 				},
-				Retry: validRetryConfig(),
+				Retry:   validRetryConfig(),
+				Ranking: validRankingConfig(),
+				Explore: defaultExploreConfig(),
 				Logging: LoggingConfig{
 					Level:  "debug",
 					Format: "json",
@@ -391,7 +470,9 @@ func TestValidate(t *testing.T) {
 					GlobalSearch: 5 * time.Second,
 					Provider:     2 * time.Second,
 				},
-				Retry: validRetryConfig(),
+				Retry:   validRetryConfig(),
+				Ranking: validRankingConfig(),
+				Explore: defaultExploreConfig(),
 				Logging: LoggingConfig{
 					Level:  "warn",
 					Format: "json",
@@ -414,7 +495,9 @@ func TestValidate(t *testing.T) {
 					GlobalSearch: 5 * time.Second,
 					Provider:     2 * time.Second,
 				},
-				Retry: validRetryConfig(),
+				Retry:   validRetryConfig(),
+				Ranking: validRankingConfig(),
+				Explore: defaultExploreConfig(),
 				Logging: LoggingConfig{
 					Level:  "error",
 					Format: "json",
@@ -437,7 +520,9 @@ func TestValidate(t *testing.T) {
 					GlobalSearch: 5 * time.Second,
 					Provider:     2 * time.Second,
 				},
-				Retry: validRetryConfig(),
+				Retry:   validRetryConfig(),
+				Ranking: validRankingConfig(),
+				Explore: defaultExploreConfig(),
 				Logging: LoggingConfig{
 					Level:  "info",
 					Format: "text", // invalid, should be json or console
@@ -461,7 +546,9 @@ func TestValidate(t *testing.T) {
 					GlobalSearch: 5 * time.Second,
 					Provider:     2 * time.Second,
 				},
-				Retry: validRetryConfig(),
+				Retry:   validRetryConfig(),
+				Ranking: validRankingConfig(),
+				Explore: defaultExploreConfig(),
 				Logging: LoggingConfig{
 					Level:  "info",
 					Format: "console",
@@ -484,7 +571,9 @@ func TestValidate(t *testing.T) {
 					GlobalSearch: 5 * time.Second,
 					Provider:     2 * time.Second,
 				},
-				Retry: validRetryConfig(),
+				Retry:   validRetryConfig(),
+				Ranking: validRankingConfig(),
+				Explore: defaultExploreConfig(),
 				Logging: LoggingConfig{
 					Level:  "info",
 					Format: "json",
@@ -508,7 +597,9 @@ func TestValidate(t *testing.T) {
 					GlobalSearch: 5 * time.Second,
 					Provider:     2 * time.Second,
 				},
-				Retry: validRetryConfig(),
+				Retry:   validRetryConfig(),
+				Ranking: validRankingConfig(),
+				Explore: defaultExploreConfig(),
 				Logging: LoggingConfig{
 					Level:  "info",
 					Format: "json",
@@ -531,7 +622,9 @@ func TestValidate(t *testing.T) {
 					GlobalSearch: 5 * time.Second,
 					Provider:     2 * time.Second,
 				},
-				Retry: validRetryConfig(),
+				Retry:   validRetryConfig(),
+				Ranking: validRankingConfig(),
+				Explore: defaultExploreConfig(),
 				Logging: LoggingConfig{
 					Level:  "info",
 					Format: "json",
@@ -705,6 +798,407 @@ func TestValidate(t *testing.T) {
 					MaxDelay:     0,
 					Multiplier:   1.0,
 				},
+				Ranking: validRankingConfig(),
+				Explore: defaultExploreConfig(),
+				Logging: LoggingConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				App: AppConfig{
+					Env: "development",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid base currency",
+			cfg: &Config{
+				Server: ServerConfig{
+					Port:         8080,
+					ReadTimeout:  5 * time.Second,
+					WriteTimeout: 5 * time.Second,
+				},
+				Timeouts: TimeoutConfig{
+					GlobalSearch: 5 * time.Second,
+					Provider:     2 * time.Second,
+				},
+				Retry:    validRetryConfig(),
+				Ranking:  validRankingConfig(),
+				Explore:  defaultExploreConfig(),
+				Currency: CurrencyConfig{BaseCurrency: "rupiah"},
+				Logging: LoggingConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				App: AppConfig{
+					Env: "development",
+				},
+			},
+			wantErr: true,
+			errMsg:  `BASE_CURRENCY must be a 3-letter uppercase ISO 4217 code; got "rupiah"`,
+		},
+		{
+			name: "invalid ranking weights - negative",
+			cfg: &Config{
+				Server: ServerConfig{
+					Port:         8080,
+					ReadTimeout:  5 * time.Second,
+					WriteTimeout: 5 * time.Second,
+				},
+				Timeouts: TimeoutConfig{
+					GlobalSearch: 5 * time.Second,
+					Provider:     2 * time.Second,
+				},
+				Retry:   validRetryConfig(),
+				Ranking: RankingConfig{WeightPrice: 1.2, WeightDuration: -0.2, WeightStops: 0},
+				Explore: defaultExploreConfig(),
+				Logging: LoggingConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				App: AppConfig{
+					Env: "development",
+				},
+			},
+			wantErr: true,
+			errMsg:  "RANKING_WEIGHT_DURATION must be non-negative; got -0.2",
+		},
+		{
+			name: "invalid ranking weights - not normalized",
+			cfg: &Config{
+				Server: ServerConfig{
+					Port:         8080,
+					ReadTimeout:  5 * time.Second,
+					WriteTimeout: 5 * time.Second,
+				},
+				Timeouts: TimeoutConfig{
+					GlobalSearch: 5 * time.Second,
+					Provider:     2 * time.Second,
+				},
+				Retry:   validRetryConfig(),
+				Ranking: RankingConfig{WeightPrice: 0.5, WeightDuration: 0.5, WeightStops: 0.5},
+				Explore: defaultExploreConfig(),
+				Logging: LoggingConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				App: AppConfig{
+					Env: "development",
+				},
+			},
+			wantErr: true,
+			errMsg:  "RANKING_WEIGHT_* values must sum to 1; got 1.5",
+		},
+		{
+			name: "valid self-transfer config",
+			cfg: &Config{
+				Server: ServerConfig{
+					Port:         8080,
+					ReadTimeout:  5 * time.Second,
+					WriteTimeout: 5 * time.Second,
+				},
+				Timeouts: TimeoutConfig{
+					GlobalSearch: 5 * time.Second,
+					Provider:     2 * time.Second,
+				},
+				Retry:        validRetryConfig(),
+				Ranking:      validRankingConfig(),
+				Explore:      defaultExploreConfig(),
+				SelfTransfer: defaultSelfTransferConfig(),
+				Logging: LoggingConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				App: AppConfig{
+					Env: "development",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid self-transfer hub",
+			cfg: &Config{
+				Server: ServerConfig{
+					Port:         8080,
+					ReadTimeout:  5 * time.Second,
+					WriteTimeout: 5 * time.Second,
+				},
+				Timeouts: TimeoutConfig{
+					GlobalSearch: 5 * time.Second,
+					Provider:     2 * time.Second,
+				},
+				Retry:        validRetryConfig(),
+				Ranking:      validRankingConfig(),
+				Explore:      defaultExploreConfig(),
+				SelfTransfer: SelfTransferConfig{Enabled: true, Hubs: []string{"CGK", "sub"}, MinConnection: time.Hour, MaxConnection: 8 * time.Hour},
+				Logging: LoggingConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				App: AppConfig{
+					Env: "development",
+				},
+			},
+			wantErr: true,
+			errMsg:  `SELF_TRANSFER_HUBS must contain 3-letter uppercase IATA codes; got "sub"`,
+		},
+		{
+			name: "invalid self-transfer connection window",
+			cfg: &Config{
+				Server: ServerConfig{
+					Port:         8080,
+					ReadTimeout:  5 * time.Second,
+					WriteTimeout: 5 * time.Second,
+				},
+				Timeouts: TimeoutConfig{
+					GlobalSearch: 5 * time.Second,
+					Provider:     2 * time.Second,
+				},
+				Retry:        validRetryConfig(),
+				Ranking:      validRankingConfig(),
+				Explore:      defaultExploreConfig(),
+				SelfTransfer: SelfTransferConfig{Enabled: true, Hubs: []string{"SUB"}, MinConnection: 2 * time.Hour, MaxConnection: time.Hour},
+				Logging: LoggingConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				App: AppConfig{
+					Env: "development",
+				},
+			},
+			wantErr: true,
+			errMsg:  "SELF_TRANSFER_MAX_CONNECTION (1h0m0s) should not be less than SELF_TRANSFER_MIN_CONNECTION (2h0m0s)",
+		},
+		{
+			name: "invalid self-transfer min saving",
+			cfg: &Config{
+				Server: ServerConfig{
+					Port:         8080,
+					ReadTimeout:  5 * time.Second,
+					WriteTimeout: 5 * time.Second,
+				},
+				Timeouts: TimeoutConfig{
+					GlobalSearch: 5 * time.Second,
+					Provider:     2 * time.Second,
+				},
+				Retry:        validRetryConfig(),
+				Ranking:      validRankingConfig(),
+				Explore:      defaultExploreConfig(),
+				SelfTransfer: SelfTransferConfig{Enabled: true, Hubs: []string{"SUB"}, MinConnection: time.Hour, MaxConnection: 8 * time.Hour, MinSaving: 1},
+				Logging: LoggingConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				App: AppConfig{
+					Env: "development",
+				},
+			},
+			wantErr: true,
+			errMsg:  "SELF_TRANSFER_MIN_SAVING must be at least 0 and less than 1; got 1",
+		},
+		{
+			name: "invalid explore max days",
+			cfg: &Config{
+				Server: ServerConfig{
+					Port:         8080,
+					ReadTimeout:  5 * time.Second,
+					WriteTimeout: 5 * time.Second,
+				},
+				Timeouts: TimeoutConfig{
+					GlobalSearch: 5 * time.Second,
+					Provider:     2 * time.Second,
+				},
+				Retry:   validRetryConfig(),
+				Ranking: validRankingConfig(),
+				Explore: ExploreConfig{MaxDays: 0, ProviderConcurrency: 2},
+				Logging: LoggingConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				App: AppConfig{
+					Env: "development",
+				},
+			},
+			wantErr: true,
+			errMsg:  "EXPLORE_MAX_DAYS must be at least 1; got 0",
+		},
+		{
+			name: "invalid explore provider concurrency",
+			cfg: &Config{
+				Server: ServerConfig{
+					Port:         8080,
+					ReadTimeout:  5 * time.Second,
+					WriteTimeout: 5 * time.Second,
+				},
+				Timeouts: TimeoutConfig{
+					GlobalSearch: 5 * time.Second,
+					Provider:     2 * time.Second,
+				},
+				Retry:   validRetryConfig(),
+				Ranking: validRankingConfig(),
+				Explore: ExploreConfig{MaxDays: 7, ProviderConcurrency: 0},
+				Logging: LoggingConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				App: AppConfig{
+					Env: "development",
+				},
+			},
+			wantErr: true,
+			errMsg:  "EXPLORE_PROVIDER_CONCURRENCY must be at least 1; got 0",
+		},
+		{
+			name: "negative provider rate limit",
+			cfg: &Config{
+				Server: ServerConfig{
+					Port:         8080,
+					ReadTimeout:  5 * time.Second,
+					WriteTimeout: 5 * time.Second,
+				},
+				Timeouts: TimeoutConfig{
+					GlobalSearch: 5 * time.Second,
+					Provider:     2 * time.Second,
+				},
+				Retry:     validRetryConfig(),
+				Ranking:   validRankingConfig(),
+				Explore:   defaultExploreConfig(),
+				RateLimit: RateLimitConfig{RequestsPerSecond: -1},
+				Logging: LoggingConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				App: AppConfig{
+					Env: "development",
+				},
+			},
+			wantErr: true,
+			errMsg:  "PROVIDER_RATE_LIMIT_RPS must not be negative; got -1",
+		},
+		{
+			name: "malformed provider rate limit override",
+			cfg: &Config{
+				Server: ServerConfig{
+					Port:         8080,
+					ReadTimeout:  5 * time.Second,
+					WriteTimeout: 5 * time.Second,
+				},
+				Timeouts: TimeoutConfig{
+					GlobalSearch: 5 * time.Second,
+					Provider:     2 * time.Second,
+				},
+				Retry:     validRetryConfig(),
+				Ranking:   validRankingConfig(),
+				Explore:   defaultExploreConfig(),
+				RateLimit: RateLimitConfig{Overrides: map[string]string{"garuda_indonesia": "5:10"}},
+				Logging: LoggingConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				App: AppConfig{
+					Env: "development",
+				},
+			},
+			wantErr: true,
+			errMsg:  `PROVIDER_RATE_LIMITS: rate limit of "garuda_indonesia" must be rps:burst:max_in_flight; got "5:10"`,
+		},
+		{
+			name: "invalid tracing exporter",
+			cfg: &Config{
+				Server: ServerConfig{
+					Port:         8080,
+					ReadTimeout:  5 * time.Second,
+					WriteTimeout: 5 * time.Second,
+				},
+				Timeouts: TimeoutConfig{
+					GlobalSearch: 5 * time.Second,
+					Provider:     2 * time.Second,
+				},
+				Retry:   validRetryConfig(),
+				Ranking: validRankingConfig(),
+				Explore: defaultExploreConfig(),
+				Tracing: TracingConfig{Exporter: "jaeger", SampleRatio: 1},
+				Logging: LoggingConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				App: AppConfig{
+					Env: "development",
+				},
+			},
+			wantErr: true,
+			errMsg:  `TRACING_EXPORTER must be one of: none, stdout, otlp-file; got "jaeger"`,
+		},
+		{
+			name: "tracing sample ratio above 1",
+			cfg: &Config{
+				Server: ServerConfig{
+					Port:         8080,
+					ReadTimeout:  5 * time.Second,
+					WriteTimeout: 5 * time.Second,
+				},
+				Timeouts: TimeoutConfig{
+					GlobalSearch: 5 * time.Second,
+					Provider:     2 * time.Second,
+				},
+				Retry:   validRetryConfig(),
+				Ranking: validRankingConfig(),
+				Explore: defaultExploreConfig(),
+				Tracing: TracingConfig{Exporter: "stdout", SampleRatio: 1.5},
+				Logging: LoggingConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				App: AppConfig{
+					Env: "development",
+				},
+			},
+			wantErr: true,
+			errMsg:  "TRACING_SAMPLE_RATIO must be between 0 and 1; got 1.5",
+		},
+		{
+			name: "negative result cache TTL",
+			cfg: &Config{
+				Server: ServerConfig{
+					Port:         8080,
+					ReadTimeout:  5 * time.Second,
+					WriteTimeout: 5 * time.Second,
+				},
+				Timeouts: TimeoutConfig{
+					GlobalSearch: 5 * time.Second,
+					Provider:     2 * time.Second,
+				},
+				Retry:   validRetryConfig(),
+				Ranking: validRankingConfig(),
+				Explore: defaultExploreConfig(),
+				Cache:   CacheConfig{ResultTTL: -time.Second},
+				Logging: LoggingConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				App: AppConfig{
+					Env: "development",
+				},
+			},
+			wantErr: true,
+			errMsg:  "RESULT_CACHE_TTL must not be negative; got -1s",
+		},
+		{
+			name: "disabled self-transfer is not validated",
+			cfg: &Config{
+				Server: ServerConfig{
+					Port:         8080,
+					ReadTimeout:  5 * time.Second,
+					WriteTimeout: 5 * time.Second,
+				},
+				Timeouts: TimeoutConfig{
+					GlobalSearch: 5 * time.Second,
+					Provider:     2 * time.Second,
+				},
+				Retry:        validRetryConfig(),
+				Ranking:      validRankingConfig(),
+				Explore:      defaultExploreConfig(),
+				SelfTransfer: SelfTransferConfig{Enabled: false, MinSaving: 5},
 				Logging: LoggingConfig{
 					Level:  "info",
 					Format: "json",
@@ -751,11 +1245,18 @@ func TestLoadConfig(t *testing.T) {
 					GlobalSearch: 5 * time.Second,
 					Provider:     2 * time.Second,
 				},
-				Retry: validRetryConfig(),
+				Retry:        validRetryConfig(),
+				Currency:     defaultCurrencyConfig(),
+				Ranking:      validRankingConfig(),
+				Explore:      defaultExploreConfig(),
+				Cache:        defaultCacheConfig(),
+				RateLimit:    defaultRateLimitConfig(),
+				SelfTransfer: defaultSelfTransferConfig(),
 				Logging: LoggingConfig{
 					Level:  "info",
 					Format: "json",
 				},
+				Tracing: defaultTracingConfig(),
 				App: AppConfig{
 					Env: "development",
 				},
@@ -777,11 +1278,18 @@ func TestLoadConfig(t *testing.T) {
 					GlobalSearch: 5 * time.Second,
 					Provider:     2 * time.Second,
 				},
-				Retry: validRetryConfig(),
+				Retry:        validRetryConfig(),
+				Currency:     defaultCurrencyConfig(),
+				Ranking:      validRankingConfig(),
+				Explore:      defaultExploreConfig(),
+				Cache:        defaultCacheConfig(),
+				RateLimit:    defaultRateLimitConfig(),
+				SelfTransfer: defaultSelfTransferConfig(),
 				Logging: LoggingConfig{
 					Level:  "info",
 					Format: "json",
 				},
+				Tracing: defaultTracingConfig(),
 				App: AppConfig{
 					Env: "development",
 				},
@@ -806,11 +1314,18 @@ func TestLoadConfig(t *testing.T) {
 					GlobalSearch: 30 * time.Second,
 					Provider:     5 * time.Second,
 				},
-				Retry: validRetryConfig(),
+				Retry:        validRetryConfig(),
+				Currency:     defaultCurrencyConfig(),
+				Ranking:      validRankingConfig(),
+				Explore:      defaultExploreConfig(),
+				Cache:        defaultCacheConfig(),
+				RateLimit:    defaultRateLimitConfig(),
+				SelfTransfer: defaultSelfTransferConfig(),
 				Logging: LoggingConfig{
 					Level:  "info",
 					Format: "json",
 				},
+				Tracing: defaultTracingConfig(),
 				App: AppConfig{
 					Env: "development",
 				},
@@ -833,11 +1348,18 @@ func TestLoadConfig(t *testing.T) {
 					GlobalSearch: 5 * time.Second,
 					Provider:     2 * time.Second,
 				},
-				Retry: validRetryConfig(),
+				Retry:        validRetryConfig(),
+				Currency:     defaultCurrencyConfig(),
+				Ranking:      validRankingConfig(),
+				Explore:      defaultExploreConfig(),
+				Cache:        defaultCacheConfig(),
+				RateLimit:    defaultRateLimitConfig(),
+				SelfTransfer: defaultSelfTransferConfig(),
 				Logging: LoggingConfig{
 					Level:  "debug",
 					Format: "console",
 				},
+				Tracing: defaultTracingConfig(),
 				App: AppConfig{
 					Env: "development",
 				},
@@ -859,11 +1381,18 @@ func TestLoadConfig(t *testing.T) {
 					GlobalSearch: 5 * time.Second,
 					Provider:     2 * time.Second,
 				},
-				Retry: validRetryConfig(),
+				Retry:        validRetryConfig(),
+				Currency:     defaultCurrencyConfig(),
+				Ranking:      validRankingConfig(),
+				Explore:      defaultExploreConfig(),
+				Cache:        defaultCacheConfig(),
+				RateLimit:    defaultRateLimitConfig(),
+				SelfTransfer: defaultSelfTransferConfig(),
 				Logging: LoggingConfig{
 					Level:  "info",
 					Format: "json",
 				},
+				Tracing: defaultTracingConfig(),
 				App: AppConfig{
 					Env: "production",
 				},
@@ -943,10 +1472,251 @@ func TestLoadConfig(t *testing.T) {
 					MaxDelay:     5 * time.Second,
 					Multiplier:   1.5,
 				},
+				Currency:     defaultCurrencyConfig(),
+				Ranking:      validRankingConfig(),
+				Explore:      defaultExploreConfig(),
+				Cache:        defaultCacheConfig(),
+				RateLimit:    defaultRateLimitConfig(),
+				SelfTransfer: defaultSelfTransferConfig(),
+				Logging: LoggingConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				Tracing: defaultTracingConfig(),
+				App: AppConfig{
+					Env: "development",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "custom self-transfer config from env",
+			envVars: map[string]string{
+				"SELF_TRANSFER_ENABLED":        "true",
+				"SELF_TRANSFER_HUBS":           "SUB,UPG",
+				"SELF_TRANSFER_MIN_CONNECTION": "2h",
+				"SELF_TRANSFER_MAX_CONNECTION": "6h",
+				"SELF_TRANSFER_MIN_SAVING":     "0.1",
+			},
+			wantCfg: &Config{
+				Server: ServerConfig{
+					Port:         8080,
+					ReadTimeout:  5 * time.Second,
+					WriteTimeout: 5 * time.Second,
+				},
+				Timeouts: TimeoutConfig{
+					GlobalSearch: 5 * time.Second,
+					Provider:     2 * time.Second,
+				},
+				Retry:     validRetryConfig(),
+				Currency:  defaultCurrencyConfig(),
+				Ranking:   validRankingConfig(),
+				Explore:   defaultExploreConfig(),
+				Cache:     defaultCacheConfig(),
+				RateLimit: defaultRateLimitConfig(),
+				SelfTransfer: SelfTransferConfig{
+					Enabled:       true,
+					Hubs:          []string{"SUB", "UPG"},
+					MinConnection: 2 * time.Hour,
+					MaxConnection: 6 * time.Hour,
+					MinSaving:     0.1,
+				},
+				Logging: LoggingConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				Tracing: defaultTracingConfig(),
+				App: AppConfig{
+					Env: "development",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "custom explore and cache config from env",
+			envVars: map[string]string{
+				"EXPLORE_MAX_DAYS":             "14",
+				"EXPLORE_PROVIDER_CONCURRENCY": "1",
+				"RESULT_CACHE_TTL":             "0s",
+			},
+			wantCfg: &Config{
+				Server: ServerConfig{
+					Port:         8080,
+					ReadTimeout:  5 * time.Second,
+					WriteTimeout: 5 * time.Second,
+				},
+				Timeouts: TimeoutConfig{
+					GlobalSearch: 5 * time.Second,
+					Provider:     2 * time.Second,
+				},
+				Retry:        validRetryConfig(),
+				Currency:     defaultCurrencyConfig(),
+				Ranking:      validRankingConfig(),
+				SelfTransfer: defaultSelfTransferConfig(),
+				Explore:      ExploreConfig{MaxDays: 14, ProviderConcurrency: 1},
+				Cache:        CacheConfig{ResultTTL: 0},
+				RateLimit:    defaultRateLimitConfig(),
+				Logging: LoggingConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				Tracing: defaultTracingConfig(),
+				App: AppConfig{
+					Env: "development",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "admin API key from env",
+			envVars: map[string]string{
+				"ADMIN_API_KEY": "s3cret",
+			},
+			wantCfg: &Config{
+				Server: ServerConfig{
+					Port:         8080,
+					ReadTimeout:  5 * time.Second,
+					WriteTimeout: 5 * time.Second,
+				},
+				Timeouts: TimeoutConfig{
+					GlobalSearch: 5 * time.Second,
+					Provider:     2 * time.Second,
+				},
+				Retry:        validRetryConfig(),
+				Currency:     defaultCurrencyConfig(),
+				Ranking:      validRankingConfig(),
+				SelfTransfer: defaultSelfTransferConfig(),
+				Explore:      defaultExploreConfig(),
+				Cache:        defaultCacheConfig(),
+				RateLimit:    defaultRateLimitConfig(),
+				Admin:        AdminConfig{APIKey: "s3cret"},
 				Logging: LoggingConfig{
 					Level:  "info",
 					Format: "json",
 				},
+				Tracing: defaultTracingConfig(),
+				App: AppConfig{
+					Env: "development",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "provider mapping files from env",
+			envVars: map[string]string{
+				"PROVIDER_MAPPING_FILES": "external/provider-mapping/lion_air.yaml,external/provider-mapping/citilink.yaml",
+			},
+			wantCfg: &Config{
+				Server: ServerConfig{
+					Port:         8080,
+					ReadTimeout:  5 * time.Second,
+					WriteTimeout: 5 * time.Second,
+				},
+				Timeouts: TimeoutConfig{
+					GlobalSearch: 5 * time.Second,
+					Provider:     2 * time.Second,
+				},
+				Retry:        validRetryConfig(),
+				Currency:     defaultCurrencyConfig(),
+				Ranking:      validRankingConfig(),
+				SelfTransfer: defaultSelfTransferConfig(),
+				Explore:      defaultExploreConfig(),
+				Cache:        defaultCacheConfig(),
+				RateLimit:    defaultRateLimitConfig(),
+				Providers: ProviderConfig{MappingFiles: []string{
+					"external/provider-mapping/lion_air.yaml",
+					"external/provider-mapping/citilink.yaml",
+				}},
+				Logging: LoggingConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				Tracing: defaultTracingConfig(),
+				App: AppConfig{
+					Env: "development",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "provider rate limits from env",
+			envVars: map[string]string{
+				"PROVIDER_RATE_LIMIT_RPS":           "10",
+				"PROVIDER_MAX_IN_FLIGHT":            "4",
+				"PROVIDER_RATE_LIMIT_QUEUE_TIMEOUT": "250ms",
+				"PROVIDER_RATE_LIMITS":              "garuda_indonesia=5:10:2,lion_air=1.5:0:0",
+			},
+			wantCfg: &Config{
+				Server: ServerConfig{
+					Port:         8080,
+					ReadTimeout:  5 * time.Second,
+					WriteTimeout: 5 * time.Second,
+				},
+				Timeouts: TimeoutConfig{
+					GlobalSearch: 5 * time.Second,
+					Provider:     2 * time.Second,
+				},
+				Retry:        validRetryConfig(),
+				Currency:     defaultCurrencyConfig(),
+				Ranking:      validRankingConfig(),
+				SelfTransfer: defaultSelfTransferConfig(),
+				Explore:      defaultExploreConfig(),
+				Cache:        defaultCacheConfig(),
+				RateLimit: RateLimitConfig{
+					RequestsPerSecond: 10,
+					MaxInFlight:       4,
+					QueueTimeout:      250 * time.Millisecond,
+					Overrides: map[string]string{
+						"garuda_indonesia": "5:10:2",
+						"lion_air":         "1.5:0:0",
+					},
+				},
+				Logging: LoggingConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				Tracing: defaultTracingConfig(),
+				App: AppConfig{
+					Env: "development",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "tracing config from env",
+			envVars: map[string]string{
+				"TRACING_EXPORTER":     "otlp-file",
+				"TRACING_FILE":         "/tmp/traces.jsonl",
+				"TRACING_SERVICE_NAME": "flight-search-staging",
+				"TRACING_SAMPLE_RATIO": "0.25",
+			},
+			wantCfg: &Config{
+				Server: ServerConfig{
+					Port:         8080,
+					ReadTimeout:  5 * time.Second,
+					WriteTimeout: 5 * time.Second,
+				},
+				Timeouts: TimeoutConfig{
+					GlobalSearch: 5 * time.Second,
+					Provider:     2 * time.Second,
+				},
+				Retry:        validRetryConfig(),
+				Currency:     defaultCurrencyConfig(),
+				Ranking:      validRankingConfig(),
+				SelfTransfer: defaultSelfTransferConfig(),
+				Explore:      defaultExploreConfig(),
+				Cache:        defaultCacheConfig(),
+				RateLimit:    defaultRateLimitConfig(),
+				Logging: LoggingConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				Tracing: TracingConfig{
+					Exporter:    "otlp-file",
+					File:        "/tmp/traces.jsonl",
+					ServiceName: "flight-search-staging",
+					SampleRatio: 0.25,
+				},
 				App: AppConfig{
 					Env: "development",
 				},
@@ -970,6 +1740,13 @@ func TestLoadConfig(t *testing.T) {
 				"PORT", "READ_TIMEOUT", "WRITE_TIMEOUT",
 				"GLOBAL_SEARCH_TIMEOUT", "PROVIDER_TIMEOUT",
 				"RETRY_MAX_ATTEMPTS", "RETRY_INITIAL_DELAY", "RETRY_MAX_DELAY", "RETRY_MULTIPLIER",
+				"SELF_TRANSFER_ENABLED", "SELF_TRANSFER_HUBS", "SELF_TRANSFER_MIN_CONNECTION",
+				"SELF_TRANSFER_MAX_CONNECTION", "SELF_TRANSFER_MIN_SAVING",
+				"EXPLORE_MAX_DAYS", "EXPLORE_PROVIDER_CONCURRENCY", "RESULT_CACHE_TTL",
+				"PROVIDER_MAPPING_FILES", "ADMIN_API_KEY",
+				"PROVIDER_RATE_LIMIT_RPS", "PROVIDER_RATE_LIMIT_BURST", "PROVIDER_MAX_IN_FLIGHT",
+				"PROVIDER_RATE_LIMIT_QUEUE_TIMEOUT", "PROVIDER_RATE_LIMITS",
+				"TRACING_EXPORTER", "TRACING_FILE", "TRACING_SERVICE_NAME", "TRACING_SAMPLE_RATIO",
 				"LOG_LEVEL", "LOG_FORMAT", "ENV",
 			}
 			for _, key := range envVarsToClear {
@@ -1002,3 +1779,50 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestRateLimitConfigProviderLimits(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides map[string]string
+		want      map[string]ProviderRateLimit
+		errMsg    string
+	}{
+		{
+			name:      "no overrides",
+			overrides: nil,
+			want:      map[string]ProviderRateLimit{},
+		},
+		{
+			name:      "parses rps, burst and max in flight",
+			overrides: map[string]string{"garuda_indonesia": "5:10:2", "lion_air": "0.5:0:1"},
+			want: map[string]ProviderRateLimit{
+				"garuda_indonesia": {RequestsPerSecond: 5, Burst: 10, MaxInFlight: 2},
+				"lion_air":         {RequestsPerSecond: 0.5, MaxInFlight: 1},
+			},
+		},
+		{
+			name:      "invalid rps",
+			overrides: map[string]string{"lion_air": "fast:1:1"},
+			errMsg:    `rate limit of "lion_air": invalid rps "fast"`,
+		},
+		{
+			name:      "negative max in flight",
+			overrides: map[string]string{"lion_air": "1:1:-1"},
+			errMsg:    `rate limit of "lion_air" must not be negative; got "1:1:-1"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RateLimitConfig{Overrides: tt.overrides}.ProviderLimits()
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
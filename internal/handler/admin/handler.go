@@ -0,0 +1,20 @@
+package admin
+
+import (
+	"github.com/herdiagusthio/flight-search-system/internal/usecase"
+	"github.com/rs/zerolog"
+)
+
+// AdminHandler handles HTTP requests for runtime administration such as provider management.
+type AdminHandler struct {
+	providerAdmin usecase.ProviderAdminUseCase
+	logger        *zerolog.Logger
+}
+
+// NewAdminHandler creates a new AdminHandler instance.
+func NewAdminHandler(providerAdmin usecase.ProviderAdminUseCase, logger *zerolog.Logger) *AdminHandler {
+	return &AdminHandler{
+		providerAdmin: providerAdmin,
+		logger:        logger,
+	}
+}
@@ -0,0 +1,203 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/internal/handler/httputil"
+	"github.com/herdiagusthio/flight-search-system/internal/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// ProviderDTO represents the runtime status of a flight provider.
+type ProviderDTO struct {
+	Name    string  `json:"name" example:"garuda_indonesia"` // Provider identifier
+	Enabled bool    `json:"enabled" example:"true"`          // Whether the provider is queried by searches
+	Weight  float64 `json:"weight" example:"1"`              // Ranking weight in [0, 2]; (weight − 1) × 0.1 is subtracted from best-value scores
+}
+
+// ProviderListResponse represents the provider list response.
+type ProviderListResponse struct {
+	Providers []ProviderDTO `json:"providers"` // Registered providers in registration order
+}
+
+// WeightRequest represents a provider reweight request.
+type WeightRequest struct {
+	Weight *float64 `json:"weight" example:"1.5"` // New ranking weight in [0, 2]
+}
+
+// AuditEntryDTO represents a recorded provider change.
+type AuditEntryDTO struct {
+	Time       string      `json:"time" example:"2025-12-01T08:00:00Z"`                      // When the change was made (RFC 3339)
+	Action     string      `json:"action" example:"disable" enums:"enable,disable,reweight"` // Change made
+	Provider   string      `json:"provider" example:"airasia"`                               // Provider changed
+	RemoteAddr string      `json:"remote_addr" example:"10.0.0.1"`                           // Client address of the request
+	RequestID  string      `json:"request_id,omitempty"`                                     // Request ID of the change
+	Before     ProviderDTO `json:"before"`                                                   // Provider status before the change
+	After      ProviderDTO `json:"after"`                                                    // Provider status after the change
+}
+
+// AuditLogResponse represents the provider audit log response.
+type AuditLogResponse struct {
+	Entries []AuditEntryDTO `json:"entries"` // Most recent changes, newest first
+}
+
+// HandleListProviders returns the runtime status of every provider.
+// @Summary		List providers
+// @Description	List registered flight providers with their enabled state and ranking weight
+// @Tags		admin
+// @Produce		json
+// @Security	ApiKeyAuth
+// @Success		200	{object}	ProviderListResponse	"Registered providers"
+// @Failure		401	{object}	httputil.ErrorDetail	"Missing or invalid API key"
+// @Router		/api/v1/admin/providers [get]
+func (h *AdminHandler) HandleListProviders(c echo.Context) error {
+	statuses := h.providerAdmin.ListProviders(c.Request().Context())
+
+	resp := ProviderListResponse{Providers: make([]ProviderDTO, 0, len(statuses))}
+	for _, s := range statuses {
+		resp.Providers = append(resp.Providers, ToProviderDTO(s))
+	}
+	return httputil.OK(c, resp)
+}
+
+// HandleEnableProvider enables a provider, so that searches query it again.
+// @Summary		Enable provider
+// @Description	Enable a flight provider at runtime; the change is recorded in the audit log
+// @Tags		admin
+// @Produce		json
+// @Security	ApiKeyAuth
+// @Param		name	path		string	true	"Provider name"	example(airasia)
+// @Success		200		{object}	ProviderDTO				"Updated provider"
+// @Failure		401		{object}	httputil.ErrorDetail	"Missing or invalid API key"
+// @Failure		404		{object}	httputil.ErrorDetail	"Unknown provider"
+// @Router		/api/v1/admin/providers/{name}/enable [post]
+func (h *AdminHandler) HandleEnableProvider(c echo.Context) error {
+	return h.setEnabled(c, true)
+}
+
+// HandleDisableProvider disables a provider, so that searches no longer query it.
+// @Summary		Disable provider
+// @Description	Disable a flight provider at runtime; the change is recorded in the audit log
+// @Tags		admin
+// @Produce		json
+// @Security	ApiKeyAuth
+// @Param		name	path		string	true	"Provider name"	example(airasia)
+// @Success		200		{object}	ProviderDTO				"Updated provider"
+// @Failure		401		{object}	httputil.ErrorDetail	"Missing or invalid API key"
+// @Failure		404		{object}	httputil.ErrorDetail	"Unknown provider"
+// @Router		/api/v1/admin/providers/{name}/disable [post]
+func (h *AdminHandler) HandleDisableProvider(c echo.Context) error {
+	return h.setEnabled(c, false)
+}
+
+// HandleSetProviderWeight sets the ranking weight of a provider.
+// @Summary		Reweight provider
+// @Description	Set the best-value ranking weight of a flight provider at runtime, between 0 and 2 (default 1)
+// @Description	(Weight − 1) × 0.1 is subtracted from the provider's flight scores; the change is recorded in the audit log
+// @Tags		admin
+// @Accept		json
+// @Produce		json
+// @Security	ApiKeyAuth
+// @Param		name	path		string			true	"Provider name"	example(garuda_indonesia)
+// @Param		request	body		WeightRequest	true	"New weight"
+// @Success		200		{object}	ProviderDTO				"Updated provider"
+// @Failure		400		{object}	httputil.ErrorDetail	"Invalid request body or weight"
+// @Failure		401		{object}	httputil.ErrorDetail	"Missing or invalid API key"
+// @Failure		404		{object}	httputil.ErrorDetail	"Unknown provider"
+// @Router		/api/v1/admin/providers/{name}/weight [put]
+func (h *AdminHandler) HandleSetProviderWeight(c echo.Context) error {
+	var req WeightRequest
+	if err := c.Bind(&req); err != nil {
+		h.logger.Warn().
+			Err(err).
+			Str("method", "HandleSetProviderWeight").
+			Msg("Failed to parse request body")
+		return httputil.InvalidRequest(c)
+	}
+	if req.Weight == nil {
+		return httputil.ValidationErrorWithMessage(c, "weight is required")
+	}
+
+	status, err := h.providerAdmin.SetProviderWeight(c.Request().Context(), c.Param("name"), *req.Weight, actor(c))
+	if err != nil {
+		return h.handleError(c, err, "HandleSetProviderWeight")
+	}
+	return httputil.OK(c, ToProviderDTO(status))
+}
+
+// HandleProviderAuditLog returns the most recent provider changes.
+// @Summary		Provider audit log
+// @Description	List the most recent runtime provider changes, newest first
+// @Tags		admin
+// @Produce		json
+// @Security	ApiKeyAuth
+// @Success		200	{object}	AuditLogResponse		"Recent provider changes"
+// @Failure		401	{object}	httputil.ErrorDetail	"Missing or invalid API key"
+// @Router		/api/v1/admin/providers/audit [get]
+func (h *AdminHandler) HandleProviderAuditLog(c echo.Context) error {
+	entries := h.providerAdmin.AuditLog(c.Request().Context())
+
+	resp := AuditLogResponse{Entries: make([]AuditEntryDTO, 0, len(entries))}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, ToAuditEntryDTO(e))
+	}
+	return httputil.OK(c, resp)
+}
+
+// setEnabled enables or disables the provider named in the path.
+func (h *AdminHandler) setEnabled(c echo.Context, enabled bool) error {
+	status, err := h.providerAdmin.SetProviderEnabled(c.Request().Context(), c.Param("name"), enabled, actor(c))
+	if err != nil {
+		return h.handleError(c, err, "HandleSetProviderEnabled")
+	}
+	return httputil.OK(c, ToProviderDTO(status))
+}
+
+// handleError maps provider admin errors to HTTP responses.
+func (h *AdminHandler) handleError(c echo.Context, err error, method string) error {
+	if errors.Is(err, domain.ErrProviderNotFound) {
+		return httputil.NotFound(c, fmt.Sprintf("provider %q not found", c.Param("name")))
+	}
+	if errors.Is(err, domain.ErrInvalidRequest) {
+		return httputil.ValidationErrorWithMessage(c, err.Error())
+	}
+
+	h.logger.Error().
+		Err(err).
+		Str("method", method).
+		Msg("Unexpected error during provider update")
+	return httputil.InternalError(c)
+}
+
+// actor identifies the client making an admin request for the audit log.
+func actor(c echo.Context) usecase.Actor {
+	return usecase.Actor{
+		RemoteAddr: c.RealIP(),
+		RequestID:  c.Request().Header.Get(echo.HeaderXRequestID),
+	}
+}
+
+// ToProviderDTO converts a domain.ProviderStatus to ProviderDTO.
+func ToProviderDTO(s domain.ProviderStatus) ProviderDTO {
+	return ProviderDTO{
+		Name:    s.Name,
+		Enabled: s.Enabled,
+		Weight:  s.Weight,
+	}
+}
+
+// ToAuditEntryDTO converts a usecase.ProviderAuditEntry to AuditEntryDTO.
+func ToAuditEntryDTO(e usecase.ProviderAuditEntry) AuditEntryDTO {
+	return AuditEntryDTO{
+		Time:       e.Time.UTC().Format(time.RFC3339),
+		Action:     e.Action,
+		Provider:   e.Provider,
+		RemoteAddr: e.Actor.RemoteAddr,
+		RequestID:  e.Actor.RequestID,
+		Before:     ToProviderDTO(e.Before),
+		After:      ToProviderDTO(e.After),
+	}
+}
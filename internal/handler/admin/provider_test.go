@@ -0,0 +1,204 @@
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// newAdminContext creates an Echo context for an admin request on the named provider.
+func newAdminContext(method, name string, body io.Reader) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(method, "/api/v1/admin/providers", body)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderXRealIP, "10.0.0.1")
+	req.Header.Set(echo.HeaderXRequestID, "req-1")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	if name != "" {
+		c.SetParamNames("name")
+		c.SetParamValues(name)
+	}
+	return c, rec
+}
+
+func newTestHandler(t *testing.T) (*AdminHandler, *usecase.MockProviderAdminUseCase) {
+	ctrl := gomock.NewController(t)
+	mockUseCase := usecase.NewMockProviderAdminUseCase(ctrl)
+	logger := zerolog.Nop()
+	return NewAdminHandler(mockUseCase, &logger), mockUseCase
+}
+
+func TestHandleListProviders(t *testing.T) {
+	handler, mockUseCase := newTestHandler(t)
+	c, rec := newAdminContext(http.MethodGet, "", nil)
+
+	mockUseCase.EXPECT().ListProviders(gomock.Any()).Return([]domain.ProviderStatus{
+		{Name: "garuda_indonesia", Enabled: true, Weight: 1.5},
+		{Name: "airasia", Enabled: false, Weight: 1},
+	})
+
+	err := handler.HandleListProviders(c)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response ProviderListResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, []ProviderDTO{
+		{Name: "garuda_indonesia", Enabled: true, Weight: 1.5},
+		{Name: "airasia", Enabled: false, Weight: 1},
+	}, response.Providers)
+}
+
+func TestHandleEnableDisableProvider(t *testing.T) {
+	tests := []struct {
+		name    string
+		enabled bool
+		handle  func(*AdminHandler, echo.Context) error
+	}{
+		{"enable", true, (*AdminHandler).HandleEnableProvider},
+		{"disable", false, (*AdminHandler).HandleDisableProvider},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, mockUseCase := newTestHandler(t)
+			c, rec := newAdminContext(http.MethodPost, "airasia", nil)
+
+			expectedActor := usecase.Actor{RemoteAddr: "10.0.0.1", RequestID: "req-1"}
+			mockUseCase.EXPECT().
+				SetProviderEnabled(gomock.Any(), "airasia", tt.enabled, expectedActor).
+				Return(domain.ProviderStatus{Name: "airasia", Enabled: tt.enabled, Weight: 1}, nil)
+
+			err := tt.handle(handler, c)
+
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var response ProviderDTO
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, ProviderDTO{Name: "airasia", Enabled: tt.enabled, Weight: 1}, response)
+		})
+	}
+}
+
+func TestHandleSetProviderWeight(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         string
+		setupMock    func(*usecase.MockProviderAdminUseCase)
+		expectedCode int
+		expectedErr  string
+	}{
+		{
+			name: "success",
+			body: `{"weight": 1.5}`,
+			setupMock: func(m *usecase.MockProviderAdminUseCase) {
+				m.EXPECT().SetProviderWeight(gomock.Any(), "garuda_indonesia", 1.5, gomock.Any()).
+					Return(domain.ProviderStatus{Name: "garuda_indonesia", Enabled: true, Weight: 1.5}, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "missing weight",
+			body:         `{}`,
+			expectedCode: http.StatusBadRequest,
+			expectedErr:  "validation_error",
+		},
+		{
+			name:         "malformed body",
+			body:         `{"weight": "heavy"}`,
+			expectedCode: http.StatusBadRequest,
+			expectedErr:  "invalid_request",
+		},
+		{
+			name: "weight out of range",
+			body: `{"weight": 3}`,
+			setupMock: func(m *usecase.MockProviderAdminUseCase) {
+				m.EXPECT().SetProviderWeight(gomock.Any(), "garuda_indonesia", 3.0, gomock.Any()).
+					Return(domain.ProviderStatus{}, domain.WrapInvalidRequest("provider weight must be between 0 and 2, got 3"))
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedErr:  "validation_error",
+		},
+		{
+			name: "unknown provider",
+			body: `{"weight": 1}`,
+			setupMock: func(m *usecase.MockProviderAdminUseCase) {
+				m.EXPECT().SetProviderWeight(gomock.Any(), "garuda_indonesia", 1.0, gomock.Any()).
+					Return(domain.ProviderStatus{}, fmt.Errorf("%w: garuda_indonesia", domain.ErrProviderNotFound))
+			},
+			expectedCode: http.StatusNotFound,
+			expectedErr:  "not_found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, mockUseCase := newTestHandler(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockUseCase)
+			}
+			c, rec := newAdminContext(http.MethodPut, "garuda_indonesia", strings.NewReader(tt.body))
+
+			err := handler.HandleSetProviderWeight(c)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedCode, rec.Code)
+
+			if tt.expectedErr != "" {
+				var response map[string]interface{}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedErr, response["code"])
+			}
+		})
+	}
+}
+
+func TestHandleProviderAuditLog(t *testing.T) {
+	handler, mockUseCase := newTestHandler(t)
+	c, rec := newAdminContext(http.MethodGet, "", nil)
+
+	changedAt := time.Date(2025, 12, 1, 15, 0, 0, 0, time.FixedZone("WIB", 7*3600))
+	mockUseCase.EXPECT().AuditLog(gomock.Any()).Return([]usecase.ProviderAuditEntry{
+		{
+			Time:     changedAt,
+			Actor:    usecase.Actor{RemoteAddr: "10.0.0.1", RequestID: "req-1"},
+			Action:   usecase.ProviderActionDisable,
+			Provider: "airasia",
+			Before:   domain.ProviderStatus{Name: "airasia", Enabled: true, Weight: 1},
+			After:    domain.ProviderStatus{Name: "airasia", Enabled: false, Weight: 1},
+		},
+	})
+
+	err := handler.HandleProviderAuditLog(c)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response AuditLogResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.Entries, 1)
+	entry := response.Entries[0]
+	assert.Equal(t, "2025-12-01T08:00:00Z", entry.Time)
+	assert.Equal(t, "disable", entry.Action)
+	assert.Equal(t, "airasia", entry.Provider)
+	assert.Equal(t, "10.0.0.1", entry.RemoteAddr)
+	assert.Equal(t, "req-1", entry.RequestID)
+	assert.True(t, entry.Before.Enabled)
+	assert.False(t, entry.After.Enabled)
+}
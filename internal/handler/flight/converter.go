@@ -18,17 +18,70 @@ func ToSearchCriteria(req SearchRequest) domain.SearchCriteria {
 		Class:         req.Class,
 	}
 
+	if req.TravelDocuments != nil {
+		criteria.TravelDocuments = &domain.TravelDocuments{
+			Nationality: req.TravelDocuments.Nationality,
+			HasPassport: req.TravelDocuments.HasPassport,
+			Visas:       req.TravelDocuments.Visas,
+		}
+	}
+
+	// Apply defaults
+	criteria.SetDefaults()
+
+	return criteria
+}
+
+// ToExploreCriteria converts ExploreRequest to domain.ExploreCriteria.
+func ToExploreCriteria(req ExploreRequest) domain.ExploreCriteria {
+	criteria := domain.ExploreCriteria{
+		Origin:            req.Origin,
+		DepartureDateFrom: req.DepartureDateFrom,
+		DepartureDateTo:   req.DepartureDateTo,
+		Passengers:        req.Passengers,
+		Class:             req.Class,
+	}
+
 	// Apply defaults
 	criteria.SetDefaults()
 
 	return criteria
 }
 
+// ToMetadata converts domain.SearchMetadata to Metadata, reporting the given
+// processing time as the search time.
+func ToMetadata(metadata domain.SearchMetadata, processingTimeMs int64) Metadata {
+	return Metadata{
+		TotalResults:         metadata.TotalResults,
+		ProvidersQueried:     metadata.ProvidersQueried,
+		ProvidersSucceeded:   metadata.ProvidersSucceeded,
+		ProvidersFailed:      metadata.ProvidersFailed,
+		ProvidersSkipped:     metadata.ProvidersSkipped,
+		ProvidersRateLimited: metadata.ProvidersRateLimited,
+		FailedProviders:      metadata.FailedProviders,
+		SkippedProviders:     metadata.SkippedProviders,
+		RateLimitedProviders: metadata.RateLimitedProviders,
+		SearchTimeMs:         processingTimeMs,
+		CacheHit:             metadata.CacheHit,
+	}
+}
+
 // ToSearchOptions converts DTO fields to usecase.SearchOptions.
 func ToSearchOptions(req SearchRequest) usecase.SearchOptions {
 	options := usecase.SearchOptions{
-		Filters: ToFilterOptions(req.Filters),
-		SortBy:  ToSortOption(req.SortBy),
+		Filters:         ToFilterOptions(req.Filters),
+		SortBy:          ToSortOption(req.SortBy),
+		SortKeys:        ToSortKeys(req.SortBy),
+		DisplayCurrency: req.Currency,
+		RankingProfile:  req.RankingProfile,
+		Explain:         req.Explain,
+
+		PreferredDepartureWindow: ToTimeRange(req.PreferredDepartureWindow),
+	}
+
+	// SortBy mirrors the primary sort key
+	if len(options.SortKeys) > 0 {
+		options.SortBy = options.SortKeys[0].Option
 	}
 
 	return options
@@ -41,9 +94,19 @@ func ToFilterOptions(dto *FilterDTO) *domain.FilterOptions {
 	}
 
 	filters := &domain.FilterOptions{
-		MaxPrice: dto.MaxPrice,
-		MaxStops: dto.MaxStops,
-		Airlines: dto.Airlines,
+		MinPrice:            dto.MinPrice,
+		MaxPrice:            dto.MaxPrice,
+		MaxStops:            dto.MaxStops,
+		Airlines:            dto.Airlines,
+		ExcludedAirlines:    dto.ExcludedAirlines,
+		Amenities:           dto.Amenities,
+		MinCheckedBaggageKg: dto.MinCheckedBaggageKg,
+		Aircraft:            dto.Aircraft,
+		DepartureTerminals:  dto.DepartureTerminals,
+		ArrivalTerminals:    dto.ArrivalTerminals,
+
+		ExcludedConnectionAirports: dto.ExcludedConnectionAirports,
+		NoOvernightLayovers:        dto.NoOvernightLayovers,
 	}
 
 	// Convert time ranges
@@ -58,6 +121,9 @@ func ToFilterOptions(dto *FilterDTO) *domain.FilterOptions {
 	if dto.DurationRange != nil {
 		filters.DurationRange = ToDurationRange(dto.DurationRange)
 	}
+	if dto.LayoverDurationRange != nil {
+		filters.LayoverDurationRange = ToDurationRange(dto.LayoverDurationRange)
+	}
 
 	return filters
 }
@@ -102,7 +168,21 @@ func ToDurationRange(dto *DurationRangeDTO) *domain.DurationRange {
 	}
 }
 
-// ToSortOption converts string sortBy to domain.SortOption.
+// ToSortKeys converts a comma-separated sortBy to sort keys.
+// Returns nil if sortBy is empty or invalid; Validate() reports invalid keys.
+func ToSortKeys(sortBy string) []domain.SortKey {
+	if sortBy == "" {
+		return nil
+	}
+	keys, err := domain.ParseSortKeys(sortBy)
+	if err != nil {
+		// This should not happen if Validate() was called
+		return nil
+	}
+	return keys
+}
+
+// ToSortOption converts a single-key sortBy to domain.SortOption.
 func ToSortOption(sortBy string) domain.SortOption {
 	switch sortBy {
 	case "price":
@@ -111,8 +191,18 @@ func ToSortOption(sortBy string) domain.SortOption {
 		return domain.SortByDuration
 	case "departure":
 		return domain.SortByDeparture
+	case "arrival":
+		return domain.SortByArrival
+	case "stops":
+		return domain.SortByStops
+	case "seats":
+		return domain.SortBySeats
+	case "airline":
+		return domain.SortByAirline
 	case "best":
 		return domain.SortByBestValue
+	case "pareto":
+		return domain.SortByPareto
 	default:
 		// Default to best value if empty or invalid
 		return domain.SortByBestValue
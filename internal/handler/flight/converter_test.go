@@ -5,6 +5,7 @@ import (
 
 	"github.com/herdiagusthio/flight-search-system/domain"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestToSearchCriteria(t *testing.T) {
@@ -79,6 +80,66 @@ func TestToSearchCriteria(t *testing.T) {
 	}
 }
 
+func TestToSearchCriteria_TravelDocuments(t *testing.T) {
+	t.Run("not declared", func(t *testing.T) {
+		result := ToSearchCriteria(SearchRequest{Origin: "CGK", Destination: "SIN"})
+		assert.Nil(t, result.TravelDocuments)
+	})
+
+	t.Run("declared", func(t *testing.T) {
+		result := ToSearchCriteria(SearchRequest{
+			Origin:          "CGK",
+			Destination:     "NRT",
+			TravelDocuments: &TravelDocumentsDTO{Nationality: "ID", HasPassport: true, Visas: []string{"JP"}},
+		})
+		assert.Equal(t, &domain.TravelDocuments{Nationality: "ID", HasPassport: true, Visas: []string{"JP"}}, result.TravelDocuments)
+	})
+}
+
+func TestToMetadata(t *testing.T) {
+	metadata := ToMetadata(domain.SearchMetadata{
+		TotalResults:         3,
+		ProvidersQueried:     3,
+		ProvidersSucceeded:   2,
+		ProvidersFailed:      1,
+		ProvidersSkipped:     1,
+		ProvidersRateLimited: 1,
+		FailedProviders:      []string{"airasia"},
+		SkippedProviders:     []string{"batik_air"},
+		RateLimitedProviders: []string{"lion_air"},
+		SearchTimeMs:         10,
+		CacheHit:             true,
+	}, 25)
+
+	assert.Equal(t, Metadata{
+		TotalResults:         3,
+		ProvidersQueried:     3,
+		ProvidersSucceeded:   2,
+		ProvidersFailed:      1,
+		ProvidersSkipped:     1,
+		ProvidersRateLimited: 1,
+		FailedProviders:      []string{"airasia"},
+		SkippedProviders:     []string{"batik_air"},
+		RateLimitedProviders: []string{"lion_air"},
+		SearchTimeMs:         25,
+		CacheHit:             true,
+	}, metadata)
+}
+
+func TestToSearchOptions_DisplayCurrency(t *testing.T) {
+	assert.Empty(t, ToSearchOptions(SearchRequest{}).DisplayCurrency)
+	assert.Equal(t, "USD", ToSearchOptions(SearchRequest{Currency: "USD"}).DisplayCurrency)
+}
+
+func TestToSearchOptions_PreferredDepartureWindow(t *testing.T) {
+	assert.Nil(t, ToSearchOptions(SearchRequest{}).PreferredDepartureWindow)
+
+	opts := ToSearchOptions(SearchRequest{PreferredDepartureWindow: &TimeRangeDTO{Start: "07:30", End: "11:00"}})
+	require.NotNil(t, opts.PreferredDepartureWindow)
+	assert.Equal(t, "07:30", opts.PreferredDepartureWindow.Start.Format("15:04"))
+	assert.Equal(t, "11:00", opts.PreferredDepartureWindow.End.Format("15:04"))
+}
+
 func TestToSearchOptions(t *testing.T) {
 	maxPrice := 1000000.0
 	maxStops := 1
@@ -217,6 +278,47 @@ func TestToFilterOptions(t *testing.T) {
 	}
 }
 
+func TestToFilterOptions_ExtendedFilters(t *testing.T) {
+	minPrice := 500000.0
+	minBaggage := 20
+
+	result := ToFilterOptions(&FilterDTO{
+		MinPrice:            &minPrice,
+		ExcludedAirlines:    []string{"QZ"},
+		Amenities:           []string{"wifi", "meal"},
+		MinCheckedBaggageKg: &minBaggage,
+		Aircraft:            []string{"737"},
+		DepartureTerminals:  []string{"3"},
+		ArrivalTerminals:    []string{"I"},
+	})
+
+	assert.NotNil(t, result)
+	assert.Equal(t, &minPrice, result.MinPrice)
+	assert.Equal(t, []string{"QZ"}, result.ExcludedAirlines)
+	assert.Equal(t, []string{"wifi", "meal"}, result.Amenities)
+	assert.Equal(t, &minBaggage, result.MinCheckedBaggageKg)
+	assert.Equal(t, []string{"737"}, result.Aircraft)
+	assert.Equal(t, []string{"3"}, result.DepartureTerminals)
+	assert.Equal(t, []string{"I"}, result.ArrivalTerminals)
+}
+
+func TestToFilterOptions_LayoverFilters(t *testing.T) {
+	minLayover := 45
+	maxLayover := 180
+
+	result := ToFilterOptions(&FilterDTO{
+		LayoverDurationRange:       &DurationRangeDTO{MinMinutes: &minLayover, MaxMinutes: &maxLayover},
+		ExcludedConnectionAirports: []string{"SUB"},
+		NoOvernightLayovers:        true,
+	})
+
+	assert.NotNil(t, result)
+	assert.Equal(t, &domain.DurationRange{MinMinutes: &minLayover, MaxMinutes: &maxLayover}, result.LayoverDurationRange)
+	assert.Equal(t, []string{"SUB"}, result.ExcludedConnectionAirports)
+	assert.True(t, result.NoOvernightLayovers)
+	assert.Nil(t, result.DurationRange)
+}
+
 func TestToTimeRange(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -332,6 +434,23 @@ func TestToDurationRange(t *testing.T) {
 	}
 }
 
+func TestToSortOption_Pareto(t *testing.T) {
+	assert.Equal(t, domain.SortByPareto, ToSortOption("pareto"))
+}
+
+func TestToSortKeys(t *testing.T) {
+	assert.Nil(t, ToSortKeys(""))
+	assert.Nil(t, ToSortKeys("cheapest"))
+	assert.Equal(t, []domain.SortKey{
+		{Option: domain.SortByAirline},
+		{Option: domain.SortByArrival, Descending: true},
+	}, ToSortKeys("airline,arrival:desc"))
+
+	opts := ToSearchOptions(SearchRequest{SortBy: "seats:desc"})
+	assert.Equal(t, domain.SortBySeats, opts.SortBy)
+	assert.Equal(t, []domain.SortKey{{Option: domain.SortBySeats, Descending: true}}, opts.SortKeys)
+}
+
 func TestToSortOption(t *testing.T) {
 	tests := []struct {
 		name     string
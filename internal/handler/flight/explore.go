@@ -0,0 +1,75 @@
+package flight
+
+import (
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/internal/handler/httputil"
+	"github.com/labstack/echo/v4"
+)
+
+// HandleExplore processes explore requests.
+// @Summary		Explore destinations
+// @Description	Find the cheapest fare to every destination served from an origin over a range of departure dates
+// @Description	Each provider is queried once per date; results are served from the result cache when available
+// @Tags		flights
+// @Accept		json
+// @Produce		json
+// @Param		request	body		ExploreRequest	true	"Explore parameters"
+// @Success		200		{object}	ExploreResponse	"Cheapest fare per destination"
+// @Failure		400		{object}	httputil.ErrorDetail	"Invalid request body or validation error"
+// @Failure		504		{object}	httputil.ErrorDetail	"Gateway timeout - explore took too long"
+// @Failure		503		{object}	httputil.ErrorDetail	"Service unavailable - all providers failed"
+// @Failure		500		{object}	httputil.ErrorDetail	"Internal server error"
+// @Router		/api/v1/flights/explore [post]
+// It parses the request, validates input, calls the use case, and returns the response.
+func (h *FlightHandler) HandleExplore(c echo.Context) error {
+	start := time.Now()
+	ctx := c.Request().Context()
+
+	var req ExploreRequest
+	if err := c.Bind(&req); err != nil {
+		h.logger.Warn().
+			Err(err).
+			Str("method", "HandleExplore").
+			Msg("Failed to parse request body")
+		return httputil.InvalidRequest(c)
+	}
+
+	req.Normalize()
+
+	if err := req.Validate(); err != nil {
+		h.logger.Warn().
+			Err(err).
+			Str("method", "HandleExplore").
+			Interface("request", req).
+			Msg("Request validation failed")
+		return httputil.ValidationErrorWithMessage(c, err.Error())
+	}
+
+	criteria := ToExploreCriteria(req)
+
+	h.logger.Info().
+		Str("method", "HandleExplore").
+		Str("origin", criteria.Origin).
+		Str("date_from", criteria.DepartureDateFrom).
+		Str("date_to", criteria.DepartureDateTo).
+		Int("passengers", criteria.Passengers).
+		Msg("Processing explore request")
+
+	result, err := h.searchUseCase.Explore(ctx, criteria)
+	if err != nil {
+		return h.handleError(c, err, start)
+	}
+
+	processingTime := time.Since(start).Milliseconds()
+	metadata := ToMetadata(result.Metadata, processingTime)
+
+	h.logger.Info().
+		Str("method", "HandleExplore").
+		Int("destinations", metadata.TotalResults).
+		Bool("cache_hit", metadata.CacheHit).
+		Int64("processing_time_ms", processingTime).
+		Msg("Explore completed successfully")
+
+	return httputil.OK(c, NewExploreResponse(criteria, result.Destinations, metadata))
+}
@@ -0,0 +1,186 @@
+package flight
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// newExploreContext creates an Echo context for an explore request with the given body.
+func newExploreContext(body string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/flights/explore", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestHandleExplore_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUseCase := usecase.NewMockFlightSearchUseCase(ctrl)
+	logger := zerolog.Nop()
+	handler := NewFlightHandler(mockUseCase, &logger)
+
+	c, rec := newExploreContext(`{
+		"origin": "cgk",
+		"departureDateFrom": "2025-12-15",
+		"departureDateTo": "2025-12-17"
+	}`)
+
+	expectedCriteria := domain.ExploreCriteria{
+		Origin:            "CGK",
+		DepartureDateFrom: "2025-12-15",
+		DepartureDateTo:   "2025-12-17",
+		Passengers:        1,
+		Class:             "economy",
+	}
+	departure := time.Date(2025, 12, 16, 8, 0, 0, 0, time.UTC)
+	mockUseCase.EXPECT().
+		Explore(gomock.Any(), expectedCriteria).
+		Return(&domain.ExploreResponse{
+			Destinations: []domain.DestinationFare{
+				{
+					Destination: "DPS",
+					City:        "Denpasar",
+					Country:     "ID",
+					Flight: domain.Flight{
+						ID:        "GA400",
+						Departure: domain.FlightPoint{AirportCode: "CGK", DateTime: departure},
+						Arrival:   domain.FlightPoint{AirportCode: "DPS", DateTime: departure.Add(2 * time.Hour)},
+						Price:     domain.PriceInfo{Amount: 900000, Currency: "IDR"},
+					},
+				},
+				{Destination: "KNO", Flight: domain.Flight{ID: "GA180"}},
+			},
+			Metadata: domain.SearchMetadata{
+				TotalResults:       2,
+				ProvidersQueried:   4,
+				ProvidersSucceeded: 4,
+				CacheHit:           true,
+			},
+		}, nil)
+
+	err := handler.HandleExplore(c)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response ExploreResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, "CGK", response.ExploreCriteria.Origin)
+	assert.Equal(t, "2025-12-17", response.ExploreCriteria.DepartureDateTo)
+	assert.Equal(t, 2, response.Metadata.TotalResults)
+	assert.True(t, response.Metadata.CacheHit)
+	require.Len(t, response.Destinations, 2)
+	assert.Equal(t, "DPS", response.Destinations[0].Destination)
+	assert.Equal(t, "Denpasar", response.Destinations[0].City)
+	assert.Equal(t, "2025-12-16", response.Destinations[0].DepartureDate)
+	assert.Equal(t, "Rp 900.000", response.Destinations[0].Price.Formatted)
+	assert.Equal(t, "GA400", response.Destinations[0].Flight.ID)
+	assert.Equal(t, "KNO", response.Destinations[1].City, "airport code if the city is unknown")
+}
+
+func TestHandleExplore_ValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUseCase := usecase.NewMockFlightSearchUseCase(ctrl)
+	logger := zerolog.Nop()
+	handler := NewFlightHandler(mockUseCase, &logger)
+
+	c, rec := newExploreContext(`{
+		"origin": "CGK",
+		"departureDateFrom": "2025-12-17",
+		"departureDateTo": "2025-12-15"
+	}`)
+
+	err := handler.HandleExplore(c)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, "validation_error", response["code"])
+	assert.Contains(t, response["message"], "departureDateTo must not be before departureDateFrom")
+}
+
+func TestHandleExplore_UseCaseErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		expectedCode int
+	}{
+		{"date range too long", domain.WrapInvalidRequest("departure date range must not exceed 7 days, got 31"), http.StatusBadRequest},
+		{"all providers failed", domain.ErrAllProvidersFailed, http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := usecase.NewMockFlightSearchUseCase(ctrl)
+			logger := zerolog.Nop()
+			handler := NewFlightHandler(mockUseCase, &logger)
+
+			c, rec := newExploreContext(`{"origin": "CGK", "departureDateFrom": "2025-12-01", "departureDateTo": "2025-12-31"}`)
+			mockUseCase.EXPECT().Explore(gomock.Any(), gomock.Any()).Return(nil, tt.err)
+
+			err := handler.HandleExplore(c)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedCode, rec.Code)
+		})
+	}
+}
+
+func TestExploreRequest_Validate(t *testing.T) {
+	valid := ExploreRequest{Origin: "CGK", DepartureDateFrom: "2025-12-15", DepartureDateTo: "2025-12-21"}
+
+	tests := []struct {
+		name    string
+		modify  func(*ExploreRequest)
+		wantErr string
+	}{
+		{name: "valid request"},
+		{name: "passengers and class", modify: func(r *ExploreRequest) { r.Passengers = 2; r.Class = "Business" }},
+		{name: "missing origin", modify: func(r *ExploreRequest) { r.Origin = "" }, wantErr: "origin is required"},
+		{name: "invalid origin", modify: func(r *ExploreRequest) { r.Origin = "JAKARTA" }, wantErr: "origin must be a valid 3-letter IATA code"},
+		{name: "missing start date", modify: func(r *ExploreRequest) { r.DepartureDateFrom = "" }, wantErr: "departureDateFrom is required"},
+		{name: "invalid end date", modify: func(r *ExploreRequest) { r.DepartureDateTo = "2025-13-01" }, wantErr: "departureDateTo is not a valid date"},
+		{name: "too many passengers", modify: func(r *ExploreRequest) { r.Passengers = 10 }, wantErr: "passengers must be at most 9"},
+		{name: "invalid class", modify: func(r *ExploreRequest) { r.Class = "premium" }, wantErr: "class must be one of"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid
+			if tt.modify != nil {
+				tt.modify(&req)
+			}
+
+			err := req.Validate()
+
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
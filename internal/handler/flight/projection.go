@@ -0,0 +1,134 @@
+package flight
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// flightFieldPaths contains every selectable field path of FlightDTO.
+// Paths use the JSON names joined with dots (e.g. "departure.datetime").
+var flightFieldPaths = buildFieldPaths(reflect.TypeOf(FlightDTO{}), "", map[string]struct{}{})
+
+// buildFieldPaths walks a struct type and collects the dotted JSON paths of its fields.
+// Nested structs contribute both their own path and the paths of their fields.
+func buildFieldPaths(t reflect.Type, prefix string, paths map[string]struct{}) map[string]struct{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return paths
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+		paths[path] = struct{}{}
+
+		buildFieldPaths(field.Type, path, paths)
+	}
+
+	return paths
+}
+
+// ParseFields splits a comma-separated field list into trimmed, lowercase field paths.
+// Returns nil if the list is empty.
+func ParseFields(fields string) []string {
+	if strings.TrimSpace(fields) == "" {
+		return nil
+	}
+
+	parts := strings.Split(fields, ",")
+	result := make([]string, 0, len(parts))
+	for _, part := range parts {
+		part = strings.ToLower(strings.TrimSpace(part))
+		if part != "" {
+			result = append(result, part)
+		}
+	}
+	return result
+}
+
+// ValidateFields checks that every requested field path exists in the FlightDTO schema.
+func ValidateFields(fields []string) error {
+	var unknown []string
+	for _, field := range fields {
+		if _, ok := flightFieldPaths[field]; !ok {
+			unknown = append(unknown, field)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return fmt.Errorf("unknown field(s): %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// AvailableFields returns all selectable field paths in sorted order.
+func AvailableFields() []string {
+	fields := make([]string, 0, len(flightFieldPaths))
+	for path := range flightFieldPaths {
+		fields = append(fields, path)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// projectFlight returns a copy of the flight containing only the requested field paths.
+// Selecting a parent path (e.g. "departure") includes the whole nested object.
+func projectFlight(flight FlightDTO, fields []string) (map[string]interface{}, error) {
+	raw, err := json.Marshal(flight)
+	if err != nil {
+		return nil, err
+	}
+
+	var source map[string]interface{}
+	if err := json.Unmarshal(raw, &source); err != nil {
+		return nil, err
+	}
+
+	projected := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		copyPath(source, projected, strings.Split(field, "."))
+	}
+
+	return projected, nil
+}
+
+// copyPath copies the value found at path in src into dst, creating intermediate objects as needed.
+// Paths that are absent from src are ignored.
+func copyPath(src, dst map[string]interface{}, path []string) {
+	value, ok := src[path[0]]
+	if !ok {
+		return
+	}
+
+	if len(path) == 1 {
+		dst[path[0]] = value
+		return
+	}
+
+	nestedSrc, ok := value.(map[string]interface{})
+	if !ok {
+		return
+	}
+	nestedDst, ok := dst[path[0]].(map[string]interface{})
+	if !ok {
+		nestedDst = make(map[string]interface{})
+		dst[path[0]] = nestedDst
+	}
+	copyPath(nestedSrc, nestedDst, path[1:])
+}
@@ -0,0 +1,147 @@
+package flight
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{"empty string", "", nil},
+		{"whitespace only", "   ", nil},
+		{"single field", "price", []string{"price"}},
+		{"multiple fields", "flight_number,price,departure.datetime", []string{"flight_number", "price", "departure.datetime"}},
+		{"trims and lowercases", " Flight_Number , PRICE ", []string{"flight_number", "price"}},
+		{"skips empty entries", "price,,stops,", []string{"price", "stops"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ParseFields(tt.input))
+		})
+	}
+}
+
+func TestValidateFields(t *testing.T) {
+	tests := []struct {
+		name        string
+		fields      []string
+		expectError bool
+		errContains string
+	}{
+		{"nil fields", nil, false, ""},
+		{"top-level fields", []string{"flight_number", "price", "stops"}, false, ""},
+		{"nested fields", []string{"departure.datetime", "arrival.airport", "price.amount"}, false, ""},
+		{"nullable field", []string{"aircraft"}, false, ""},
+		{"unknown top-level field", []string{"price", "seat_map"}, true, "seat_map"},
+		{"unknown nested field", []string{"departure.gate"}, true, "departure.gate"},
+		{"nested path on scalar", []string{"stops.count"}, true, "stops.count"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateFields(tt.fields)
+			if tt.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAvailableFields(t *testing.T) {
+	fields := AvailableFields()
+
+	assert.Contains(t, fields, "flight_number")
+	assert.Contains(t, fields, "departure")
+	assert.Contains(t, fields, "departure.datetime")
+	assert.Contains(t, fields, "baggage.carry_on")
+	assert.IsNonDecreasing(t, fields)
+}
+
+func TestSearchResponseMarshalJSON_WithFields(t *testing.T) {
+	flights := []domain.Flight{
+		{
+			ID:           "GA400",
+			FlightNumber: "GA400",
+			Provider:     "garuda_indonesia",
+			Airline:      domain.AirlineInfo{Code: "GA", Name: "Garuda Indonesia"},
+			Departure: domain.FlightPoint{
+				AirportCode: "CGK",
+				DateTime:    time.Date(2025, 12, 15, 6, 0, 0, 0, time.UTC),
+			},
+			Arrival: domain.FlightPoint{
+				AirportCode: "DPS",
+				DateTime:    time.Date(2025, 12, 15, 8, 0, 0, 0, time.UTC),
+			},
+			Duration: domain.NewDurationInfo(120),
+			Price:    domain.PriceInfo{Amount: 1250000, Currency: "IDR"},
+			Stops:    0,
+		},
+	}
+	criteria := domain.SearchCriteria{Origin: "CGK", Destination: "DPS", DepartureDate: "2025-12-15", Passengers: 1}
+
+	t.Run("projects selected fields only", func(t *testing.T) {
+		response := NewSearchResponse(criteria, flights, Metadata{TotalResults: 1}, []string{"flight_number", "price", "departure.datetime", "stops"})
+
+		data, err := json.Marshal(response)
+		require.NoError(t, err)
+
+		var body map[string]interface{}
+		require.NoError(t, json.Unmarshal(data, &body))
+		assert.Contains(t, body, "search_criteria")
+		assert.Contains(t, body, "metadata")
+
+		resultFlights := body["flights"].([]interface{})
+		require.Len(t, resultFlights, 1)
+		flight := resultFlights[0].(map[string]interface{})
+
+		assert.Len(t, flight, 4)
+		assert.Equal(t, "GA400", flight["flight_number"])
+		assert.Equal(t, float64(0), flight["stops"])
+		price := flight["price"].(map[string]interface{})
+		assert.Equal(t, float64(1250000), price["amount"])
+		assert.Equal(t, "IDR", price["currency"])
+		assert.Equal(t, "Rp 1.250.000", price["formatted"])
+		assert.Contains(t, price, "breakdown")
+		assert.Equal(t, map[string]interface{}{"datetime": "2025-12-15T06:00:00Z"}, flight["departure"])
+	})
+
+	t.Run("parent and child path selects whole object", func(t *testing.T) {
+		response := NewSearchResponse(criteria, flights, Metadata{}, []string{"departure.datetime", "departure"})
+
+		data, err := json.Marshal(response)
+		require.NoError(t, err)
+
+		var body struct {
+			Flights []map[string]map[string]interface{} `json:"flights"`
+		}
+		require.NoError(t, json.Unmarshal(data, &body))
+		assert.Equal(t, "CGK", body.Flights[0]["departure"]["airport"])
+		assert.Equal(t, "2025-12-15T06:00:00Z", body.Flights[0]["departure"]["datetime"])
+	})
+
+	t.Run("without fields returns full flight objects", func(t *testing.T) {
+		response := NewSearchResponse(criteria, flights, Metadata{}, nil)
+
+		data, err := json.Marshal(response)
+		require.NoError(t, err)
+
+		var body SearchResponse
+		require.NoError(t, json.Unmarshal(data, &body))
+		require.Len(t, body.Flights, 1)
+		assert.Equal(t, "GA400", body.Flights[0].ID)
+		assert.Equal(t, "Garuda Indonesia", body.Flights[0].Airline.Name)
+	})
+}
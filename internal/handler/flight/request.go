@@ -5,11 +5,16 @@ import (
 	"regexp"
 	"strings"
 	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/internal/usecase"
 )
 
 var (
 	airportCodeRegex = regexp.MustCompile(`^[A-Z]{3}$`)
 	dateFormatRegex  = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	countryCodeRegex = regexp.MustCompile(`^[A-Z]{2}$`)
+	currencyRegex    = regexp.MustCompile(`^[A-Z]{3}$`)
 )
 
 // SearchRequest represents the HTTP request for flight search.
@@ -20,21 +25,57 @@ type SearchRequest struct {
 	Passengers    int            `json:"passengers" binding:"required,min=1,max=9" example:"2" minimum:"1" maximum:"9" validate:"required,min=1,max=9"`      // Number of passengers (1-9)
 	Class         string         `json:"class,omitempty" example:"economy" enums:"economy,business,first"`                                                    // Cabin class preference (optional)
 	Filters       *FilterDTO     `json:"filters,omitempty"`                                                                                                   // Optional filters for search results
-	SortBy        string         `json:"sortBy,omitempty" example:"price" enums:"best,price,duration,departure"`                                             // Sort order for results (optional)
+	SortBy        string         `json:"sortBy,omitempty" example:"stops,price:asc,departure:desc"`                                                          // Comma-separated sort keys with optional :asc/:desc (optional; keys: best, price, duration, departure, arrival, stops, seats, airline, pareto)
+	Fields        string         `json:"fields,omitempty" example:"flight_number,price,departure.datetime"`                                                  // Comma-separated flight fields to return (optional, sparse fieldset)
+
+	TravelDocuments          *TravelDocumentsDTO `json:"travelDocuments,omitempty"`                                                                      // Passenger nationality and travel documents (optional)
+	Currency                 string              `json:"currency,omitempty" example:"USD"`                                                               // ISO 4217 currency to display prices in (optional, default IDR)
+	RankingProfile           string              `json:"rankingProfile,omitempty" example:"business" enums:"balanced,business,cheapest-ish,fastest-ish"` // Ranking weights used for best-value sorting (optional, default balanced)
+	Explain                  bool                `json:"explain,omitempty" example:"false"`                                                              // Include each flight's ranking score breakdown (optional)
+	PreferredDepartureWindow *TimeRangeDTO       `json:"preferredDepartureWindow,omitempty"`                                                             // Preferred local departure window, scored by the departureTime ranking factor (optional, default 06:00-22:00)
+}
+
+// ExploreRequest represents the HTTP request for exploring destinations from an origin.
+type ExploreRequest struct {
+	Origin            string `json:"origin" binding:"required" example:"CGK" validate:"required,len=3" format:"IATA code"` // Origin airport IATA code (3 letters)
+	DepartureDateFrom string `json:"departureDateFrom" binding:"required" example:"2025-12-15" format:"date"`              // First departure date in YYYY-MM-DD format
+	DepartureDateTo   string `json:"departureDateTo" binding:"required" example:"2025-12-21" format:"date"`                // Last departure date in YYYY-MM-DD format (inclusive)
+	Passengers        int    `json:"passengers,omitempty" example:"1" minimum:"1" maximum:"9"`                             // Number of passengers (1-9, optional, default 1)
+	Class             string `json:"class,omitempty" example:"economy" enums:"economy,business,first"`                     // Cabin class preference (optional, default economy)
+}
+
+// TravelDocumentsDTO represents the travel documents declared by the passenger.
+// International flights needing documents not declared here are annotated with missing_documents.
+type TravelDocumentsDTO struct {
+	Nationality string   `json:"nationality,omitempty" example:"ID"` // Passenger nationality, ISO 3166-1 alpha-2 (optional)
+	HasPassport bool     `json:"hasPassport" example:"true"`         // Whether the passenger holds a valid passport
+	Visas       []string `json:"visas,omitempty" example:"JP"`       // Countries (ISO 3166-1 alpha-2) the passenger holds a visa for (optional)
 }
 
 // FilterDTO represents filter options in HTTP requests.
 type FilterDTO struct {
-	MaxPrice           *float64          `json:"maxPrice,omitempty" example:"5000000" minimum:"0"`                // Maximum price in IDR (optional)
-	MaxStops           *int              `json:"maxStops,omitempty" example:"1" minimum:"0"`                      // Maximum number of stops (optional)
-	Airlines           []string          `json:"airlines,omitempty" example:"GA,JT"`                              // Filter by airline codes (optional)
-	DepartureTimeRange *TimeRangeDTO     `json:"departureTimeRange,omitempty"`                                    // Filter by departure time range (optional)
-	ArrivalTimeRange   *TimeRangeDTO     `json:"arrivalTimeRange,omitempty"`                                      // Filter by arrival time range (optional)
-	DurationRange      *DurationRangeDTO `json:"durationRange,omitempty"`                                         // Filter by flight duration range (optional)
+	MinPrice            *float64          `json:"minPrice,omitempty" example:"500000" minimum:"0"`                 // Minimum price in the base currency, IDR by default (optional)
+	MaxPrice            *float64          `json:"maxPrice,omitempty" example:"5000000" minimum:"0"`                // Maximum price in the base currency, IDR by default (optional)
+	MaxStops            *int              `json:"maxStops,omitempty" example:"1" minimum:"0"`                      // Maximum number of stops (optional)
+	Airlines            []string          `json:"airlines,omitempty" example:"GA,JT"`                              // Filter by airline codes (optional)
+	ExcludedAirlines    []string          `json:"excludedAirlines,omitempty" example:"QZ"`                         // Exclude airline codes (optional)
+	DepartureTimeRange  *TimeRangeDTO     `json:"departureTimeRange,omitempty"`                                    // Filter by departure time range (optional)
+	ArrivalTimeRange    *TimeRangeDTO     `json:"arrivalTimeRange,omitempty"`                                      // Filter by arrival time range (optional)
+	DurationRange       *DurationRangeDTO `json:"durationRange,omitempty"`                                         // Filter by flight duration range (optional)
+	Amenities           []string          `json:"amenities,omitempty" example:"wifi,meal"`                         // Required amenities; flights must offer all of them (optional)
+	MinCheckedBaggageKg *int              `json:"minCheckedBaggageKg,omitempty" example:"20" minimum:"0"`          // Minimum included checked baggage in kg (optional)
+	Aircraft            []string          `json:"aircraft,omitempty" example:"737,A320"`                           // Aircraft types or families, any may match (optional)
+	DepartureTerminals  []string          `json:"departureTerminals,omitempty" example:"3"`                        // Departure terminals (optional)
+	ArrivalTerminals    []string          `json:"arrivalTerminals,omitempty" example:"I"`                          // Arrival terminals (optional)
+
+	LayoverDurationRange       *DurationRangeDTO `json:"layoverDurationRange,omitempty"`                     // Every layover must fall within this duration range (optional)
+	ExcludedConnectionAirports []string          `json:"excludedConnectionAirports,omitempty" example:"SUB"` // Exclude flights connecting at these airports (optional)
+	NoOvernightLayovers        bool              `json:"noOvernightLayovers,omitempty" example:"false"`      // Exclude flights with a layover between 00:00 and 05:00 local time (optional)
 }
 
 // TimeRangeDTO represents a time range filter in HTTP requests.
-// Time format should be "HH:MM" (24-hour format).
+// Time format should be "HH:MM" (24-hour format), in the airport's local time.
+// An end before the start crosses midnight (e.g. 22:00-02:00).
 type TimeRangeDTO struct {
 	Start string `json:"start" binding:"required" example:"06:00" pattern:"^([01]\\d|2[0-3]):([0-5]\\d)$"` // Start time in HH:MM format (24-hour)
 	End   string `json:"end" binding:"required" example:"22:00" pattern:"^([01]\\d|2[0-3]):([0-5]\\d)$"`   // End time in HH:MM format (24-hour)
@@ -100,9 +141,8 @@ func (r *SearchRequest) Validate() error {
 
 	// Validate sortBy (optional)
 	if r.SortBy != "" {
-		sortBy := strings.ToLower(r.SortBy)
-		if sortBy != "best" && sortBy != "price" && sortBy != "duration" && sortBy != "departure" {
-			return fmt.Errorf("sortBy must be one of: best, price, duration, departure; got %q", r.SortBy)
+		if _, err := domain.ParseSortKeys(r.SortBy); err != nil {
+			return fmt.Errorf("invalid sortBy: %w", err)
 		}
 	}
 
@@ -113,10 +153,38 @@ func (r *SearchRequest) Validate() error {
 		}
 	}
 
+	// Validate fields (optional)
+	if err := ValidateFields(ParseFields(r.Fields)); err != nil {
+		return fmt.Errorf("invalid fields: %w", err)
+	}
+
+	// Validate currency (optional)
+	if r.Currency != "" && !currencyRegex.MatchString(strings.ToUpper(strings.TrimSpace(r.Currency))) {
+		return fmt.Errorf("currency must be a 3-letter ISO 4217 code, got %q", r.Currency)
+	}
+
+	// Validate ranking profile (optional)
+	if r.RankingProfile != "" && !usecase.IsRankingProfile(r.RankingProfile) {
+		return fmt.Errorf("rankingProfile must be one of: %s; got %q",
+			strings.Join(usecase.RankingProfiles(), ", "), r.RankingProfile)
+	}
+
+	// Validate preferred departure window (optional)
+	if err := r.PreferredDepartureWindow.Validate(); err != nil {
+		return fmt.Errorf("invalid preferredDepartureWindow: %w", err)
+	}
+
+	// Validate travel documents (optional)
+	if r.TravelDocuments != nil {
+		if err := r.TravelDocuments.Validate(); err != nil {
+			return fmt.Errorf("invalid travelDocuments: %w", err)
+		}
+	}
+
 	return nil
 }
 
-// Normalize normalizes the request fields (uppercase airport codes, lowercase class and sortBy).
+// Normalize normalizes the request fields (uppercase airport and currency codes, lowercase class, sortBy and rankingProfile).
 func (r *SearchRequest) Normalize() {
 	r.Origin = strings.ToUpper(strings.TrimSpace(r.Origin))
 	r.Destination = strings.ToUpper(strings.TrimSpace(r.Destination))
@@ -126,6 +194,87 @@ func (r *SearchRequest) Normalize() {
 	if r.SortBy != "" {
 		r.SortBy = strings.ToLower(r.SortBy)
 	}
+	r.Currency = strings.ToUpper(strings.TrimSpace(r.Currency))
+	r.RankingProfile = strings.ToLower(strings.TrimSpace(r.RankingProfile))
+	if r.TravelDocuments != nil {
+		r.TravelDocuments.Nationality = strings.ToUpper(strings.TrimSpace(r.TravelDocuments.Nationality))
+		for i, v := range r.TravelDocuments.Visas {
+			r.TravelDocuments.Visas[i] = strings.ToUpper(strings.TrimSpace(v))
+		}
+	}
+}
+
+// Validate validates the explore request.
+func (r *ExploreRequest) Validate() error {
+	if r.Origin == "" {
+		return fmt.Errorf("origin is required")
+	}
+	if !airportCodeRegex.MatchString(strings.ToUpper(strings.TrimSpace(r.Origin))) {
+		return fmt.Errorf("origin must be a valid 3-letter IATA code, got %q", r.Origin)
+	}
+
+	from, err := validateDate("departureDateFrom", r.DepartureDateFrom)
+	if err != nil {
+		return err
+	}
+	to, err := validateDate("departureDateTo", r.DepartureDateTo)
+	if err != nil {
+		return err
+	}
+	if to.Before(from) {
+		return fmt.Errorf("departureDateTo must not be before departureDateFrom")
+	}
+
+	// Passengers are optional and default to 1
+	if r.Passengers < 0 {
+		return fmt.Errorf("passengers must be at least 1")
+	}
+	if r.Passengers > 9 {
+		return fmt.Errorf("passengers must be at most 9")
+	}
+
+	if r.Class != "" {
+		class := strings.ToLower(r.Class)
+		if class != "economy" && class != "business" && class != "first" {
+			return fmt.Errorf("class must be one of: economy, business, first; got %q", r.Class)
+		}
+	}
+
+	return nil
+}
+
+// Normalize normalizes the request fields (uppercase origin, lowercase class).
+func (r *ExploreRequest) Normalize() {
+	r.Origin = strings.ToUpper(strings.TrimSpace(r.Origin))
+	r.Class = strings.ToLower(strings.TrimSpace(r.Class))
+}
+
+// validateDate checks that a required date field is a valid YYYY-MM-DD date.
+func validateDate(field, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, fmt.Errorf("%s is required", field)
+	}
+	if !dateFormatRegex.MatchString(value) {
+		return time.Time{}, fmt.Errorf("%s must be in YYYY-MM-DD format, got %q", field, value)
+	}
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s is not a valid date: %s", field, value)
+	}
+	return date, nil
+}
+
+// Validate validates the declared travel documents.
+func (d *TravelDocumentsDTO) Validate() error {
+	if d.Nationality != "" && !countryCodeRegex.MatchString(strings.ToUpper(strings.TrimSpace(d.Nationality))) {
+		return fmt.Errorf("nationality must be a 2-letter country code, got %q", d.Nationality)
+	}
+	for _, v := range d.Visas {
+		if !countryCodeRegex.MatchString(strings.ToUpper(strings.TrimSpace(v))) {
+			return fmt.Errorf("visas must contain 2-letter country codes, got %q", v)
+		}
+	}
+	return nil
 }
 
 // Validate validates filter options.
@@ -134,10 +283,18 @@ func (f *FilterDTO) Validate() error {
 		return nil
 	}
 
+	// Validate minPrice
+	if f.MinPrice != nil && *f.MinPrice < 0 {
+		return fmt.Errorf("minPrice must be non-negative")
+	}
+
 	// Validate maxPrice
 	if f.MaxPrice != nil && *f.MaxPrice < 0 {
 		return fmt.Errorf("maxPrice must be non-negative")
 	}
+	if f.MinPrice != nil && f.MaxPrice != nil && *f.MinPrice > *f.MaxPrice {
+		return fmt.Errorf("minPrice must be less than or equal to maxPrice")
+	}
 
 	// Validate maxStops
 	if f.MaxStops != nil && *f.MaxStops < 0 {
@@ -163,6 +320,62 @@ func (f *FilterDTO) Validate() error {
 		}
 	}
 
+	// Validate layover duration range
+	if f.LayoverDurationRange != nil {
+		if err := f.LayoverDurationRange.Validate(); err != nil {
+			return fmt.Errorf("layoverDurationRange: %w", err)
+		}
+	}
+
+	// Validate airline lists
+	for _, code := range f.Airlines {
+		if containsCode(f.ExcludedAirlines, code) {
+			return fmt.Errorf("airline %q cannot be both included and excluded", code)
+		}
+	}
+
+	// Validate minCheckedBaggageKg
+	if f.MinCheckedBaggageKg != nil && *f.MinCheckedBaggageKg < 0 {
+		return fmt.Errorf("minCheckedBaggageKg must be non-negative")
+	}
+
+	// Validate list entries are not blank
+	if err := validateNonBlank("amenities", f.Amenities); err != nil {
+		return err
+	}
+	if err := validateNonBlank("aircraft", f.Aircraft); err != nil {
+		return err
+	}
+	if err := validateNonBlank("departureTerminals", f.DepartureTerminals); err != nil {
+		return err
+	}
+	if err := validateNonBlank("arrivalTerminals", f.ArrivalTerminals); err != nil {
+		return err
+	}
+	if err := validateNonBlank("excludedConnectionAirports", f.ExcludedConnectionAirports); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// containsCode reports whether codes contains code, ignoring case and surrounding spaces.
+func containsCode(codes []string, code string) bool {
+	for _, c := range codes {
+		if strings.EqualFold(strings.TrimSpace(c), strings.TrimSpace(code)) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateNonBlank returns an error if any entry of a list filter is empty.
+func validateNonBlank(field string, values []string) error {
+	for _, v := range values {
+		if strings.TrimSpace(v) == "" {
+			return fmt.Errorf("%s must not contain empty values", field)
+		}
+	}
 	return nil
 }
 
@@ -208,7 +208,7 @@ func TestSearchRequest_Validate(t *testing.T) {
 				SortBy:        "cheapest",
 			},
 			wantErr: true,
-			errMsg:  "sortBy must be one of: best, price, duration, departure",
+			errMsg:  "invalid sortBy: sort key must be one of: best, price, duration, departure",
 		},
 		{
 			name: "valid sortBy - duration",
@@ -221,6 +221,167 @@ func TestSearchRequest_Validate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid sortBy - multiple keys with directions",
+			request: SearchRequest{
+				Origin:        "CGK",
+				Destination:   "DPS",
+				DepartureDate: "2025-12-15",
+				Passengers:    1,
+				SortBy:        "stops,price:asc,departure:desc",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid sortBy direction",
+			request: SearchRequest{
+				Origin:        "CGK",
+				Destination:   "DPS",
+				DepartureDate: "2025-12-15",
+				Passengers:    1,
+				SortBy:        "price,seats:down",
+			},
+			wantErr: true,
+			errMsg:  `invalid sortBy: sort direction of "seats" must be asc or desc`,
+		},
+		{
+			name: "valid sortBy - pareto",
+			request: SearchRequest{
+				Origin:        "CGK",
+				Destination:   "DPS",
+				DepartureDate: "2025-12-15",
+				Passengers:    1,
+				SortBy:        "Pareto",
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid fields",
+			request: SearchRequest{
+				Origin:        "CGK",
+				Destination:   "DPS",
+				DepartureDate: "2025-12-15",
+				Passengers:    1,
+				Fields:        "flight_number,price,departure.datetime,stops",
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown field",
+			request: SearchRequest{
+				Origin:        "CGK",
+				Destination:   "DPS",
+				DepartureDate: "2025-12-15",
+				Passengers:    1,
+				Fields:        "flight_number,gate",
+			},
+			wantErr: true,
+			errMsg:  "invalid fields: unknown field(s): gate",
+		},
+		{
+			name: "valid currency",
+			request: SearchRequest{
+				Origin:        "CGK",
+				Destination:   "DPS",
+				DepartureDate: "2025-12-15",
+				Passengers:    1,
+				Currency:      "usd",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid currency",
+			request: SearchRequest{
+				Origin:        "CGK",
+				Destination:   "DPS",
+				DepartureDate: "2025-12-15",
+				Passengers:    1,
+				Currency:      "US$",
+			},
+			wantErr: true,
+			errMsg:  "currency must be a 3-letter ISO 4217 code",
+		},
+		{
+			name: "valid ranking profile",
+			request: SearchRequest{
+				Origin:         "CGK",
+				Destination:    "DPS",
+				DepartureDate:  "2025-12-15",
+				Passengers:     1,
+				RankingProfile: "Business",
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown ranking profile",
+			request: SearchRequest{
+				Origin:         "CGK",
+				Destination:    "DPS",
+				DepartureDate:  "2025-12-15",
+				Passengers:     1,
+				RankingProfile: "luxury",
+			},
+			wantErr: true,
+			errMsg:  "rankingProfile must be one of: balanced, business, cheapest-ish, fastest-ish",
+		},
+		{
+			name: "valid preferred departure window",
+			request: SearchRequest{
+				Origin:                   "CGK",
+				Destination:              "DPS",
+				DepartureDate:            "2025-12-15",
+				Passengers:               1,
+				PreferredDepartureWindow: &TimeRangeDTO{Start: "22:00", End: "02:00"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid preferred departure window",
+			request: SearchRequest{
+				Origin:                   "CGK",
+				Destination:              "DPS",
+				DepartureDate:            "2025-12-15",
+				Passengers:               1,
+				PreferredDepartureWindow: &TimeRangeDTO{Start: "6:00", End: "10:00"},
+			},
+			wantErr: true,
+			errMsg:  "invalid preferredDepartureWindow: start time must be in HH:MM format",
+		},
+		{
+			name: "valid travel documents",
+			request: SearchRequest{
+				Origin:          "CGK",
+				Destination:     "SIN",
+				DepartureDate:   "2025-12-15",
+				Passengers:      1,
+				TravelDocuments: &TravelDocumentsDTO{Nationality: "id", HasPassport: true, Visas: []string{"JP"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid nationality",
+			request: SearchRequest{
+				Origin:          "CGK",
+				Destination:     "SIN",
+				DepartureDate:   "2025-12-15",
+				Passengers:      1,
+				TravelDocuments: &TravelDocumentsDTO{Nationality: "Indonesia"},
+			},
+			wantErr: true,
+			errMsg:  "invalid travelDocuments: nationality must be a 2-letter country code",
+		},
+		{
+			name: "invalid visa country",
+			request: SearchRequest{
+				Origin:          "CGK",
+				Destination:     "NRT",
+				DepartureDate:   "2025-12-15",
+				Passengers:      1,
+				TravelDocuments: &TravelDocumentsDTO{Visas: []string{"JPN"}},
+			},
+			wantErr: true,
+			errMsg:  "invalid travelDocuments: visas must contain 2-letter country codes",
+		},
 	}
 
 	for _, tt := range tests {
@@ -304,8 +465,38 @@ func TestSearchRequest_Normalize(t *testing.T) {
 	}
 }
 
+func TestSearchRequest_NormalizeCurrency(t *testing.T) {
+	req := SearchRequest{Origin: "CGK", Destination: "DPS", Currency: " sgd "}
+
+	req.Normalize()
+
+	assert.Equal(t, "SGD", req.Currency)
+}
+
+func TestSearchRequest_NormalizeRankingProfile(t *testing.T) {
+	req := SearchRequest{Origin: "CGK", Destination: "DPS", RankingProfile: " Fastest-ISH "}
+
+	req.Normalize()
+
+	assert.Equal(t, "fastest-ish", req.RankingProfile)
+}
+
+func TestSearchRequest_NormalizeTravelDocuments(t *testing.T) {
+	req := SearchRequest{
+		Origin:          "CGK",
+		Destination:     "NRT",
+		TravelDocuments: &TravelDocumentsDTO{Nationality: " id ", Visas: []string{"jp", " kr"}},
+	}
+
+	req.Normalize()
+
+	assert.Equal(t, "ID", req.TravelDocuments.Nationality)
+	assert.Equal(t, []string{"JP", "KR"}, req.TravelDocuments.Visas)
+}
+
 func TestFilterDTO_Validate(t *testing.T) {
 	maxPrice := 1000000.0
+	minPriceOnly := 500000.0
 	negativePrice := -100.0
 	maxStops := 1
 	negativeStops := -1
@@ -346,6 +537,95 @@ func TestFilterDTO_Validate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "maxStops must be non-negative",
 		},
+		{
+			name: "negative minPrice",
+			filter: &FilterDTO{
+				MinPrice: &negativePrice,
+			},
+			wantErr: true,
+			errMsg:  "minPrice must be non-negative",
+		},
+		{
+			name: "minPrice greater than maxPrice",
+			filter: &FilterDTO{
+				MinPrice: &maxPrice,
+				MaxPrice: &minPriceOnly,
+			},
+			wantErr: true,
+			errMsg:  "minPrice must be less than or equal to maxPrice",
+		},
+		{
+			name: "airline both included and excluded",
+			filter: &FilterDTO{
+				Airlines:         []string{"GA", "JT"},
+				ExcludedAirlines: []string{"ga"},
+			},
+			wantErr: true,
+			errMsg:  `airline "GA" cannot be both included and excluded`,
+		},
+		{
+			name: "negative minCheckedBaggageKg",
+			filter: &FilterDTO{
+				MinCheckedBaggageKg: &negativeStops,
+			},
+			wantErr: true,
+			errMsg:  "minCheckedBaggageKg must be non-negative",
+		},
+		{
+			name: "blank amenity",
+			filter: &FilterDTO{
+				Amenities: []string{"wifi", " "},
+			},
+			wantErr: true,
+			errMsg:  "amenities must not contain empty values",
+		},
+		{
+			name: "blank terminal",
+			filter: &FilterDTO{
+				ArrivalTerminals: []string{""},
+			},
+			wantErr: true,
+			errMsg:  "arrivalTerminals must not contain empty values",
+		},
+		{
+			name: "invalid layover duration range",
+			filter: &FilterDTO{
+				LayoverDurationRange: &DurationRangeDTO{MinMinutes: &negativeStops},
+			},
+			wantErr: true,
+			errMsg:  "layoverDurationRange: minMinutes must be non-negative",
+		},
+		{
+			name: "blank connection airport",
+			filter: &FilterDTO{
+				ExcludedConnectionAirports: []string{"SUB", ""},
+			},
+			wantErr: true,
+			errMsg:  "excludedConnectionAirports must not contain empty values",
+		},
+		{
+			name: "valid layover filters",
+			filter: &FilterDTO{
+				LayoverDurationRange:       &DurationRangeDTO{MaxMinutes: &maxStops},
+				ExcludedConnectionAirports: []string{"SUB"},
+				NoOvernightLayovers:        true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid filter with new fields",
+			filter: &FilterDTO{
+				MinPrice:            &minPriceOnly,
+				MaxPrice:            &maxPrice,
+				ExcludedAirlines:    []string{"QZ"},
+				Amenities:           []string{"wifi", "meal"},
+				MinCheckedBaggageKg: &maxStops,
+				Aircraft:            []string{"737", "A320"},
+				DepartureTerminals:  []string{"3"},
+				ArrivalTerminals:    []string{"I"},
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1,10 +1,12 @@
 package flight
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/pkg/util"
 )
 
 // SearchResponse is the main response structure for flight search API.
@@ -13,6 +15,62 @@ type SearchResponse struct {
 	SearchCriteria SearchCriteria `json:"search_criteria"` // Echo of the search criteria submitted
 	Metadata       Metadata       `json:"metadata"`        // Search execution metadata and statistics
 	Flights        []FlightDTO    `json:"flights"`         // List of available flights matching criteria
+	Facets         *FacetsDTO     `json:"facets,omitempty"` // Filter facets computed over all gathered flights
+
+	// fields restricts the serialized flight objects to the selected paths (sparse fieldset).
+	fields []string
+}
+
+// MarshalJSON implements json.Marshaler.
+// When a sparse fieldset was requested, each flight is projected to the selected fields only.
+func (r SearchResponse) MarshalJSON() ([]byte, error) {
+	type plain SearchResponse
+	if len(r.fields) == 0 {
+		return json.Marshal(plain(r))
+	}
+
+	flights := make([]map[string]interface{}, len(r.Flights))
+	for i, flight := range r.Flights {
+		projected, err := projectFlight(flight, r.fields)
+		if err != nil {
+			return nil, fmt.Errorf("project flight %s: %w", flight.ID, err)
+		}
+		flights[i] = projected
+	}
+
+	return json.Marshal(struct {
+		plain
+		Flights []map[string]interface{} `json:"flights"`
+	}{
+		plain:   plain(r),
+		Flights: flights,
+	})
+}
+
+// ExploreResponse is the response structure for the explore API.
+type ExploreResponse struct {
+	ExploreCriteria ExploreCriteria      `json:"explore_criteria"` // Echo of the explore criteria submitted
+	Metadata        Metadata             `json:"metadata"`         // Explore execution metadata; total_results counts destinations
+	Destinations    []DestinationFareDTO `json:"destinations"`     // Cheapest fare per destination, cheapest first
+}
+
+// ExploreCriteria echoes back the explore parameters.
+type ExploreCriteria struct {
+	Origin            string `json:"origin" example:"CGK"`                     // Origin airport IATA code
+	DepartureDateFrom string `json:"departure_date_from" example:"2025-12-15"` // First departure date
+	DepartureDateTo   string `json:"departure_date_to" example:"2025-12-21"`   // Last departure date
+	Passengers        int    `json:"passengers" example:"1"`                   // Number of passengers
+	CabinClass        string `json:"cabin_class" example:"economy"`            // Cabin class
+}
+
+// DestinationFareDTO is the cheapest fare found to a destination.
+type DestinationFareDTO struct {
+	Destination   string    `json:"destination" example:"DPS"`           // Destination airport IATA code
+	City          string    `json:"city" example:"Denpasar"`             // Destination city (airport code if unknown)
+	Country       string    `json:"country" example:"ID"`                // ISO 3166-1 alpha-2 country code (empty if unknown)
+	DepartureDate string    `json:"departure_date" example:"2025-12-15"` // Local departure date of the cheapest flight
+	Price         PriceDTO  `json:"price"`                               // Cheapest fare
+	Flight        FlightDTO `json:"flight"`                              // The cheapest flight
 }
 
 // SearchCriteria echoes back the search parameters.
@@ -26,12 +84,17 @@ type SearchCriteria struct {
 
 // Metadata contains search execution statistics and provider information.
 type Metadata struct {
-	TotalResults       int   `json:"total_results" example:"15"`       // Total number of flights found
-	ProvidersQueried   int   `json:"providers_queried" example:"4"`    // Number of providers queried
-	ProvidersSucceeded int   `json:"providers_succeeded" example:"4"`  // Number of providers that responded successfully
-	ProvidersFailed    int   `json:"providers_failed" example:"0"`     // Number of providers that failed
-	SearchTimeMs       int64 `json:"search_time_ms" example:"1234"`    // Total search execution time in milliseconds
-	CacheHit           bool  `json:"cache_hit" example:"false"`        // Whether result was served from cache
+	TotalResults         int      `json:"total_results" example:"15"`                      // Total number of flights found
+	ProvidersQueried     int      `json:"providers_queried" example:"3"`                   // Number of providers queried
+	ProvidersSucceeded   int      `json:"providers_succeeded" example:"3"`                 // Number of providers that responded successfully
+	ProvidersFailed      int      `json:"providers_failed" example:"0"`                    // Number of providers that failed
+	ProvidersSkipped     int      `json:"providers_skipped" example:"1"`                   // Number of providers not serving the route, not queried
+	ProvidersRateLimited int      `json:"providers_rate_limited" example:"0"`              // Number of providers over their rate limit, not queried
+	FailedProviders      []string `json:"failed_providers,omitempty"`                      // Names of the providers that failed
+	SkippedProviders     []string `json:"skipped_providers,omitempty" example:"batik_air"` // Names of the providers not serving the route
+	RateLimitedProviders []string `json:"rate_limited_providers,omitempty"`                // Names of the providers over their rate limit
+	SearchTimeMs         int64    `json:"search_time_ms" example:"1234"`                   // Total search execution time in milliseconds
+	CacheHit             bool     `json:"cache_hit" example:"false"`                       // Whether result was served from cache
 }
 
 // FlightDTO extends domain.Flight with additional formatted fields.
@@ -44,26 +107,85 @@ type FlightDTO struct {
 	Arrival        LocationDTO `json:"arrival"`                              // Arrival information
 	Duration       DurationDTO `json:"duration"`                             // Flight duration
 	Stops          int         `json:"stops" example:"0"`                    // Number of stops (0 for direct)
+	Layovers       []LayoverDTO `json:"layovers"`                            // Layover details (empty for direct flights)
 	Price          PriceDTO    `json:"price"`                                // Price information
 	AvailableSeats int         `json:"available_seats" example:"0"`          // Available seats (0 if not available)
 	CabinClass     string      `json:"cabin_class" example:"economy"`        // Cabin class
 	Aircraft       *string     `json:"aircraft" example:"Boeing 737"`        // Aircraft type (nullable)
 	Amenities      []string    `json:"amenities" example:"WiFi,Meals"`       // Available amenities
 	Baggage        BaggageDTO  `json:"baggage"`                              // Baggage allowance
+
+	International    bool     `json:"international" example:"false"`                     // Whether departure and arrival are in different countries
+	MissingDocuments []string `json:"missing_documents,omitempty" example:"passport,visa"` // Required travel documents the passenger did not declare
+
+	RankingExplanation *RankingExplanationDTO `json:"ranking_explanation,omitempty"`                         // Best-value score breakdown (only when explain is requested)
+	ParetoTags         []string               `json:"pareto_tags,omitempty" example:"cheapest,best balance"` // Trade-off tags: cheapest, fastest, best balance (only with sortBy pareto)
+
+	SelfTransfer *SelfTransferDTO `json:"self_transfer,omitempty"` // Separately ticketed connection details (only for self-transfer itineraries)
+}
+
+// SelfTransferDTO describes a virtual connection combining separately ticketed flights.
+// Passengers collect their baggage and check in again at the connection airport.
+type SelfTransferDTO struct {
+	Status            string               `json:"status" example:"unprotected"`     // protected if both legs are of the same airline group, otherwise unprotected
+	ConnectionAirport string               `json:"connection_airport" example:"SUB"` // Airport where the passenger changes flights
+	ConnectionMinutes int                  `json:"connection_minutes" example:"140"` // Time between the first leg's arrival and the second leg's departure
+	Legs              []SelfTransferLegDTO `json:"legs"`                             // The separately ticketed flights, in travel order
+}
+
+// SelfTransferLegDTO is a single separately ticketed flight of a self-transfer.
+type SelfTransferLegDTO struct {
+	ID           string      `json:"id" example:"QZ7510_AirAsia"`    // Unique flight identifier
+	Provider     string      `json:"provider" example:"AirAsia"`     // Provider the leg is booked with
+	Airline      AirlineDTO  `json:"airline"`                        // Airline information
+	FlightNumber string      `json:"flight_number" example:"QZ7510"` // Flight number
+	Departure    LocationDTO `json:"departure"`                      // Departure information
+	Arrival      LocationDTO `json:"arrival"`                        // Arrival information
+	Duration     DurationDTO `json:"duration"`                       // Flight duration
+	Price        PriceDTO    `json:"price"`                          // Price information
+}
+
+// RankingExplanationDTO breaks the best-value score into its parts: the score is the sum
+// of weights[factor]×components[factor] over the scored factors, minus
+// (provider_weight − 1) × 0.1 if provider_weight is set.
+type RankingExplanationDTO struct {
+	Score          float64                    `json:"score" example:"0.35"`                    // Best-value score (lower is better)
+	Components     map[string]float64         `json:"components"`                              // Normalized factor scores in [0,1], 0 being the best, keyed by factor
+	Weights        map[string]float64         `json:"weights"`                                 // Weights applied to each factor
+	Bounds         map[string]RankingBoundDTO `json:"bounds"`                                  // Result set min/max for factors normalized against it
+	ProviderWeight *float64                   `json:"provider_weight,omitempty" example:"1.5"` // Provider weight if not 1; (provider_weight − 1) × 0.1 is subtracted from the weighted sum
+}
+
+// RankingBoundDTO holds the min/max values used to normalize a ranking factor.
+type RankingBoundDTO struct {
+	Min float64 `json:"min" example:"650000"`  // Lowest value in the result set
+	Max float64 `json:"max" example:"1450000"` // Highest value in the result set
+}
+
+// LayoverDTO contains connection stop details.
+type LayoverDTO struct {
+	Airport         string `json:"airport" example:"SUB"`         // Connection airport IATA code
+	DurationMinutes int    `json:"duration_minutes" example:"75"` // Layover duration in minutes
+	Overnight       bool   `json:"overnight" example:"false"`     // Whether the layover falls between 00:00 and 05:00 local time
 }
 
 // AirlineDTO contains airline information.
 type AirlineDTO struct {
-	Name string `json:"name" example:"Garuda Indonesia"` // Airline full name
-	Code string `json:"code" example:"GA"`              // IATA airline code
+	Name    string `json:"name" example:"Garuda Indonesia"`                             // Airline full name
+	Code    string `json:"code" example:"GA"`                                           // IATA airline code
+	Logo    string `json:"logo,omitempty" example:"https://pics.avs.io/200/200/GA.png"` // Airline logo URL
+	LowCost bool   `json:"low_cost" example:"false"`                                    // Whether the airline is a low-cost carrier
+	Group   string `json:"group,omitempty" example:"Garuda Indonesia Group"`            // Parent airline group
 }
 
 // LocationDTO contains airport and time information.
 type LocationDTO struct {
-	Airport   string `json:"airport" example:"CGK"`                           // Airport IATA code
-	City      string `json:"city" example:"Jakarta"`                          // City name
-	Datetime  string `json:"datetime" example:"2025-01-15T08:00:00Z"`        // ISO 8601 datetime
-	Timestamp int64  `json:"timestamp" example:"1736928000"`                  // Unix timestamp
+	Airport     string `json:"airport" example:"CGK"`                                       // Airport IATA code
+	AirportName string `json:"airport_name" example:"Soekarno-Hatta International Airport"` // Airport name (empty if unknown)
+	City        string `json:"city" example:"Jakarta"`                                      // City name
+	Country     string `json:"country" example:"ID"`                                        // ISO 3166-1 alpha-2 country code (empty if unknown)
+	Datetime    string `json:"datetime" example:"2025-01-15T08:00:00Z"`                     // ISO 8601 datetime
+	Timestamp   int64  `json:"timestamp" example:"1736928000"`                              // Unix timestamp
 }
 
 // DurationDTO contains both numeric and formatted duration.
@@ -74,8 +196,26 @@ type DurationDTO struct {
 
 // PriceDTO contains price information.
 type PriceDTO struct {
-	Amount   float64 `json:"amount" example:"1500000"`   // Price amount
-	Currency string  `json:"currency" example:"IDR"`    // Currency code (ISO 4217)
+	Amount    float64 `json:"amount" example:"1500000"`         // Price amount
+	Currency  string  `json:"currency" example:"IDR"`          // Currency code (ISO 4217)
+	Formatted string  `json:"formatted" example:"Rp 1.500.000"` // Amount formatted for the currency's locale
+
+	Breakdown FareBreakdownDTO `json:"breakdown"` // Itemized fare
+}
+
+// FareBreakdownDTO itemizes a fare. Components are per passenger and null when the
+// provider does not report them.
+
+type FareBreakdownDTO struct {
+	BaseFare          *float64 `json:"base_fare" example:"1300000"`                  // Fare before taxes, fees and surcharges (nullable)
+	Taxes             *float64 `json:"taxes" example:"200000"`                       // Government taxes (nullable)
+	Fees              *float64 `json:"fees" example:"0"`                             // Airport and service fees (nullable)
+	Surcharges        *float64 `json:"surcharges" example:"0"`                       // Fuel and other carrier surcharges (nullable)
+	UnknownComponents []string `json:"unknown_components" example:"fees,surcharges"` // Components the provider does not report
+	PerPassenger      float64  `json:"per_passenger" example:"1500000"`              // Price for a single passenger
+	Passengers        int      `json:"passengers" example:"2"`                       // Number of passengers the total covers
+	Total             float64  `json:"total" example:"3000000"`                      // Price for all passengers
+	TotalFormatted    string   `json:"total_formatted" example:"Rp 3.000.000"`       // Total formatted for the currency's locale
 }
 
 // BaggageDTO contains baggage allowance information.
@@ -84,11 +224,53 @@ type BaggageDTO struct {
 	Checked string `json:"checked" example:"20kg checked"`  // Checked baggage allowance
 }
 
+// FacetsDTO contains filter facets for building a filter sidebar.
+// Values and bounds cover all gathered flights; counts reflect the other active filters.
+type FacetsDTO struct {
+	Airlines       []FacetCountDTO  `json:"airlines"`        // Airlines with matching flight counts
+	Stops          []FacetCountDTO  `json:"stops"`           // Stop counts with matching flight counts
+	DepartureTimes []FacetCountDTO  `json:"departure_times"` // Departure time-of-day buckets with matching flight counts
+	Price          PriceFacetDTO    `json:"price"`           // Price bounds and histogram
+	Duration       DurationFacetDTO `json:"duration"`        // Duration bounds
+	Amenities      []FacetCountDTO  `json:"amenities"`       // Amenities with matching flight counts
+}
+
+// FacetCountDTO is a single facet value with its flight count.
+type FacetCountDTO struct {
+	Value string `json:"value" example:"GA"`               // Filter value
+	Label string `json:"label" example:"Garuda Indonesia"` // Human-readable label
+	Count int    `json:"count" example:"4"`                // Number of matching flights
+}
+
+// PriceFacetDTO contains price bounds and a price histogram.
+type PriceFacetDTO struct {
+	Min       float64          `json:"min" example:"650000"`  // Lowest price before filtering
+	Max       float64          `json:"max" example:"1450000"` // Highest price before filtering
+	Histogram []PriceBucketDTO `json:"histogram"`             // Equal-width price buckets
+}
+
+// PriceBucketDTO is a single price histogram bucket.
+type PriceBucketDTO struct {
+	Min   float64 `json:"min" example:"650000"` // Bucket lower bound (inclusive)
+	Max   float64 `json:"max" example:"810000"` // Bucket upper bound (exclusive, inclusive for the last bucket)
+	Count int     `json:"count" example:"3"`    // Number of matching flights
+}
+
+// DurationFacetDTO contains duration bounds.
+type DurationFacetDTO struct {
+	MinMinutes int `json:"min_minutes" example:"100"` // Shortest duration before filtering
+	MaxMinutes int `json:"max_minutes" example:"320"` // Longest duration before filtering
+	Count      int `json:"count" example:"12"`        // Number of flights matching the other filters
+}
+
 // NewSearchResponse creates a SearchResponse from domain objects.
+// If fields is non-empty, flights are serialized with only the selected field paths;
+// fields must have been checked with ValidateFields beforehand.
 func NewSearchResponse(
 	criteria domain.SearchCriteria,
 	flights []domain.Flight,
 	metadata Metadata,
+	fields []string,
 ) SearchResponse {
 	if flights == nil {
 		flights = []domain.Flight{}
@@ -109,17 +291,52 @@ func NewSearchResponse(
 		},
 		Metadata: metadata,
 		Flights:  flightDTOs,
+		fields:   fields,
+	}
+}
+
+// NewExploreResponse creates an ExploreResponse from domain objects.
+func NewExploreResponse(criteria domain.ExploreCriteria, destinations []domain.DestinationFare, metadata Metadata) ExploreResponse {
+	dtos := make([]DestinationFareDTO, len(destinations))
+	for i, d := range destinations {
+		// Use the city if available, otherwise the airport code
+		city := d.City
+		if city == "" {
+			city = d.Destination
+		}
+
+		flight := ToFlightDTO(d.Flight)
+		dtos[i] = DestinationFareDTO{
+			Destination:   d.Destination,
+			City:          city,
+			Country:       d.Country,
+			DepartureDate: d.Flight.Departure.LocalTime().Format("2006-01-02"),
+			Price:         flight.Price,
+			Flight:        flight,
+		}
+	}
+
+	return ExploreResponse{
+		ExploreCriteria: ExploreCriteria{
+			Origin:            criteria.Origin,
+			DepartureDateFrom: criteria.DepartureDateFrom,
+			DepartureDateTo:   criteria.DepartureDateTo,
+			Passengers:        criteria.Passengers,
+			CabinClass:        criteria.Class,
+		},
+		Metadata:     metadata,
+		Destinations: dtos,
 	}
 }
 
 // ToFlightDTO converts domain.Flight to FlightDTO with formatted fields.
 func ToFlightDTO(flight domain.Flight) FlightDTO {
-	// Extract city from airport name if available, otherwise use airport code
-	departureCity := flight.Departure.AirportName
+	// Use the city if available, otherwise the airport code
+	departureCity := flight.Departure.City
 	if departureCity == "" {
 		departureCity = flight.Departure.AirportCode
 	}
-	arrivalCity := flight.Arrival.AirportName
+	arrivalCity := flight.Arrival.City
 	if arrivalCity == "" {
 		arrivalCity = flight.Arrival.AirportCode
 	}
@@ -154,34 +371,53 @@ func ToFlightDTO(flight domain.Flight) FlightDTO {
 		amenities = []string{}
 	}
 
+	layovers := make([]LayoverDTO, 0, len(flight.Layovers))
+	for _, l := range flight.Layovers {
+		layovers = append(layovers, LayoverDTO{
+			Airport:         l.AirportCode,
+			DurationMinutes: l.DurationMinutes,
+			Overnight:       l.Overnight,
+		})
+	}
+
 	return FlightDTO{
 		ID:       flight.ID,
 		Provider: flight.Provider,
 		Airline: AirlineDTO{
-			Name: flight.Airline.Name,
-			Code: flight.Airline.Code,
+			Name:    flight.Airline.Name,
+			Code:    flight.Airline.Code,
+			Logo:    flight.Airline.Logo,
+			LowCost: flight.Airline.LowCost,
+			Group:   flight.Airline.Group,
 		},
 		FlightNumber: flight.FlightNumber,
 		Departure: LocationDTO{
-			Airport:   flight.Departure.AirportCode,
-			City:      departureCity,
-			Datetime:  flight.Departure.DateTime.Format(time.RFC3339),
-			Timestamp: flight.Departure.DateTime.Unix(),
+			Airport:     flight.Departure.AirportCode,
+			AirportName: flight.Departure.AirportName,
+			City:        departureCity,
+			Country:     flight.Departure.Country,
+			Datetime:    flight.Departure.DateTime.Format(time.RFC3339),
+			Timestamp:   flight.Departure.DateTime.Unix(),
 		},
 		Arrival: LocationDTO{
-			Airport:   flight.Arrival.AirportCode,
-			City:      arrivalCity,
-			Datetime:  flight.Arrival.DateTime.Format(time.RFC3339),
-			Timestamp: flight.Arrival.DateTime.Unix(),
+			Airport:     flight.Arrival.AirportCode,
+			AirportName: flight.Arrival.AirportName,
+			City:        arrivalCity,
+			Country:     flight.Arrival.Country,
+			Datetime:    flight.Arrival.DateTime.Format(time.RFC3339),
+			Timestamp:   flight.Arrival.DateTime.Unix(),
 		},
 		Duration: DurationDTO{
 			TotalMinutes: flight.Duration.TotalMinutes,
 			Formatted:    flight.Duration.Formatted,
 		},
-		Stops: flight.Stops,
+		Stops:    flight.Stops,
+		Layovers: layovers,
 		Price: PriceDTO{
-			Amount:   flight.Price.Amount,
-			Currency: flight.Price.Currency,
+			Amount:    flight.Price.Amount,
+			Currency:  flight.Price.Currency,
+			Formatted: priceFormatted(flight.Price),
+			Breakdown: toFareBreakdownDTO(flight.Price),
 		},
 		AvailableSeats: flight.AvailableSeats,
 		CabinClass:     flight.Class,
@@ -191,6 +427,101 @@ func ToFlightDTO(flight domain.Flight) FlightDTO {
 			CarryOn: carryOn,
 			Checked: checked,
 		},
+		International:    flight.International,
+		MissingDocuments: flight.MissingDocuments,
+
+		RankingExplanation: toRankingExplanationDTO(flight),
+		ParetoTags:         flight.ParetoTags,
+
+		SelfTransfer: toSelfTransferDTO(flight.SelfTransfer),
+	}
+}
+
+// toSelfTransferDTO converts a flight's self-transfer details to their DTO.
+// Returns nil if the flight is not a self-transfer.
+func toSelfTransferDTO(st *domain.SelfTransfer) *SelfTransferDTO {
+	if st == nil {
+		return nil
+	}
+
+	legs := make([]SelfTransferLegDTO, len(st.Legs))
+	for i, leg := range st.Legs {
+		dto := ToFlightDTO(leg)
+		legs[i] = SelfTransferLegDTO{
+			ID:           dto.ID,
+			Provider:     dto.Provider,
+			Airline:      dto.Airline,
+			FlightNumber: dto.FlightNumber,
+			Departure:    dto.Departure,
+			Arrival:      dto.Arrival,
+			Duration:     dto.Duration,
+			Price:        dto.Price,
+		}
+	}
+
+	return &SelfTransferDTO{
+		Status:            st.Status,
+		ConnectionAirport: st.ConnectionAirport,
+		ConnectionMinutes: st.ConnectionMinutes,
+		Legs:              legs,
+	}
+}
+
+// toRankingExplanationDTO converts a flight's ranking explanation to its DTO.
+// Returns nil if the flight has no explanation.
+func toRankingExplanationDTO(flight domain.Flight) *RankingExplanationDTO {
+	e := flight.RankingExplanation
+	if e == nil {
+		return nil
+	}
+
+	bounds := make(map[string]RankingBoundDTO, len(e.Bounds))
+	for name, b := range e.Bounds {
+		bounds[name] = RankingBoundDTO{Min: b.Min, Max: b.Max}
+	}
+
+	return &RankingExplanationDTO{
+		Score:          flight.RankingScore,
+		Components:     e.Components,
+		Weights:        e.Weights,
+		Bounds:         bounds,
+		ProviderWeight: e.ProviderWeight,
+	}
+}
+
+// priceFormatted returns the price's formatted amount, formatting it from the amount
+// and currency if the provider left it empty.
+func priceFormatted(price domain.PriceInfo) string {
+	if price.Formatted != "" {
+		return price.Formatted
+	}
+	return util.FormatCurrency(price.Amount, price.Currency)
+}
+
+// toFareBreakdownDTO converts a price's fare breakdown to its DTO.
+// A breakdown that was never totaled for passengers is reported for a single passenger.
+func toFareBreakdownDTO(price domain.PriceInfo) FareBreakdownDTO {
+	breakdown := price.Breakdown
+	if breakdown.Passengers < 1 {
+		price.ApplyPassengers(1)
+		breakdown = price.Breakdown
+	}
+
+	unknown := breakdown.UnknownComponents()
+	if unknown == nil {
+		unknown = []string{}
+	}
+
+	return FareBreakdownDTO{
+		BaseFare:          breakdown.BaseFare,
+		Taxes:             breakdown.Taxes,
+		Fees:              breakdown.Fees,
+		Surcharges:        breakdown.Surcharges,
+		UnknownComponents: unknown,
+		PerPassenger:      breakdown.PerPassenger,
+		Passengers:        breakdown.Passengers,
+		Total:             breakdown.Total,
+		TotalFormatted:    util.FormatCurrency(breakdown.Total, price.Currency),
 	}
 }
 
@@ -208,3 +539,41 @@ func formatDuration(minutes int) string {
 	return fmt.Sprintf("%dh %dm", hours, mins)
 }
 
+// ToFacetsDTO converts domain.SearchFacets to FacetsDTO.
+// Returns nil if facets is nil.
+func ToFacetsDTO(facets *domain.SearchFacets) *FacetsDTO {
+	if facets == nil {
+		return nil
+	}
+
+	histogram := make([]PriceBucketDTO, len(facets.Price.Histogram))
+	for i, b := range facets.Price.Histogram {
+		histogram[i] = PriceBucketDTO{Min: b.Min, Max: b.Max, Count: b.Count}
+	}
+
+	return &FacetsDTO{
+		Airlines:       toFacetCountDTOs(facets.Airlines),
+		Stops:          toFacetCountDTOs(facets.Stops),
+		DepartureTimes: toFacetCountDTOs(facets.DepartureTimes),
+		Price: PriceFacetDTO{
+			Min:       facets.Price.Min,
+			Max:       facets.Price.Max,
+			Histogram: histogram,
+		},
+		Duration: DurationFacetDTO{
+			MinMinutes: facets.Duration.MinMinutes,
+			MaxMinutes: facets.Duration.MaxMinutes,
+			Count:      facets.Duration.Count,
+		},
+		Amenities: toFacetCountDTOs(facets.Amenities),
+	}
+}
+
+// toFacetCountDTOs converts facet counts, never returning nil for consistent JSON.
+func toFacetCountDTOs(counts []domain.FacetCount) []FacetCountDTO {
+	result := make([]FacetCountDTO, len(counts))
+	for i, c := range counts {
+		result[i] = FacetCountDTO{Value: c.Value, Label: c.Label, Count: c.Count}
+	}
+	return result
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/herdiagusthio/flight-search-system/domain"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewSearchResponse(t *testing.T) {
@@ -28,12 +29,14 @@ func TestNewSearchResponse(t *testing.T) {
 			},
 			Departure: domain.FlightPoint{
 				AirportCode: "CGK",
-				AirportName: "Jakarta",
+				AirportName: "Soekarno-Hatta International Airport",
+				City:        "Jakarta",
 				DateTime:    time.Date(2025, 12, 15, 4, 45, 0, 0, time.UTC),
 			},
 			Arrival: domain.FlightPoint{
 				AirportCode: "DPS",
-				AirportName: "Denpasar",
+				AirportName: "I Gusti Ngurah Rai International Airport",
+				City:        "Denpasar",
 				DateTime:    time.Date(2025, 12, 15, 7, 25, 0, 0, time.UTC),
 			},
 			Duration: domain.DurationInfo{
@@ -62,7 +65,7 @@ func TestNewSearchResponse(t *testing.T) {
 		CacheHit:           false,
 	}
 
-	response := NewSearchResponse(criteria, flights, metadata)
+	response := NewSearchResponse(criteria, flights, metadata, nil)
 
 	// Check search criteria
 	assert.Equal(t, "CGK", response.SearchCriteria.Origin)
@@ -107,7 +110,7 @@ func TestNewSearchResponse_EmptyFlights(t *testing.T) {
 		CacheHit:           false,
 	}
 
-	response := NewSearchResponse(criteria, nil, metadata)
+	response := NewSearchResponse(criteria, nil, metadata, nil)
 
 	assert.Equal(t, 0, len(response.Flights))
 	assert.NotNil(t, response.Flights)
@@ -122,17 +125,22 @@ func TestToFlightDTO(t *testing.T) {
 		FlightNumber: "GA400",
 		Provider:     "Garuda Indonesia",
 		Airline: domain.AirlineInfo{
-			Code: "GA",
-			Name: "Garuda Indonesia",
+			Code:  "GA",
+			Name:  "Garuda Indonesia",
+			Logo:  "https://pics.avs.io/200/200/GA.png",
+			Group: "Garuda Indonesia Group",
 		},
 		Departure: domain.FlightPoint{
 			AirportCode: "CGK",
-			AirportName: "Jakarta",
+			AirportName: "Soekarno-Hatta International Airport",
+			City:        "Jakarta",
+			Country:     "ID",
 			DateTime:    departureTime,
 		},
 		Arrival: domain.FlightPoint{
 			AirportCode: "DPS",
-			AirportName: "Denpasar",
+			AirportName: "I Gusti Ngurah Rai International Airport",
+			City:        "Denpasar",
 			DateTime:    arrivalTime,
 		},
 		Duration: domain.DurationInfo{
@@ -157,11 +165,18 @@ func TestToFlightDTO(t *testing.T) {
 	assert.Equal(t, "Garuda Indonesia", dto.Provider)
 	assert.Equal(t, "Garuda Indonesia", dto.Airline.Name)
 	assert.Equal(t, "GA", dto.Airline.Code)
+	assert.Equal(t, "https://pics.avs.io/200/200/GA.png", dto.Airline.Logo)
+	assert.False(t, dto.Airline.LowCost)
+	assert.Equal(t, "Garuda Indonesia Group", dto.Airline.Group)
+	assert.False(t, dto.International)
+	assert.Nil(t, dto.MissingDocuments)
 	assert.Equal(t, "GA400", dto.FlightNumber)
 
 	// Check departure
 	assert.Equal(t, "CGK", dto.Departure.Airport)
 	assert.Equal(t, "Jakarta", dto.Departure.City)
+	assert.Equal(t, "ID", dto.Departure.Country)
+	assert.Equal(t, "Soekarno-Hatta International Airport", dto.Departure.AirportName)
 	assert.Equal(t, departureTime.Format(time.RFC3339), dto.Departure.Datetime)
 	assert.Equal(t, departureTime.Unix(), dto.Departure.Timestamp)
 
@@ -346,6 +361,181 @@ func TestToFlightDTO_NilFields(t *testing.T) {
 	assert.Equal(t, "Not included", dto.Baggage.CarryOn)
 	assert.Equal(t, "Not included", dto.Baggage.Checked)
 	assert.Empty(t, dto.Amenities)
-	assert.Equal(t, "CGK", dto.Departure.City) // Falls back to airport code when name is empty
+	assert.NotNil(t, dto.Layovers)
+	assert.Empty(t, dto.Layovers)
+	assert.Equal(t, "CGK", dto.Departure.City) // Falls back to airport code when city is empty
+	assert.Empty(t, dto.Departure.AirportName)
 	assert.Equal(t, "DPS", dto.Arrival.City)
 }
+
+func TestToFacetsDTO(t *testing.T) {
+	t.Run("nil facets", func(t *testing.T) {
+		assert.Nil(t, ToFacetsDTO(nil))
+	})
+
+	t.Run("converts all facet groups", func(t *testing.T) {
+		facets := &domain.SearchFacets{
+			Airlines:       []domain.FacetCount{{Value: "GA", Label: "Garuda Indonesia", Count: 4}},
+			Stops:          []domain.FacetCount{{Value: "0", Label: "Direct", Count: 3}},
+			DepartureTimes: []domain.FacetCount{{Value: "morning", Label: "06:00 - 12:00", Count: 2}},
+			Price: domain.PriceFacet{
+				Min:       650000,
+				Max:       1450000,
+				Histogram: []domain.PriceBucket{{Min: 650000, Max: 1450000, Count: 4}},
+			},
+			Duration: domain.DurationFacet{MinMinutes: 100, MaxMinutes: 320, Count: 4},
+		}
+
+		dto := ToFacetsDTO(facets)
+
+		assert.Equal(t, []FacetCountDTO{{Value: "GA", Label: "Garuda Indonesia", Count: 4}}, dto.Airlines)
+		assert.Equal(t, []FacetCountDTO{{Value: "0", Label: "Direct", Count: 3}}, dto.Stops)
+		assert.Equal(t, []FacetCountDTO{{Value: "morning", Label: "06:00 - 12:00", Count: 2}}, dto.DepartureTimes)
+		assert.Equal(t, 650000.0, dto.Price.Min)
+		assert.Equal(t, 1450000.0, dto.Price.Max)
+		assert.Equal(t, []PriceBucketDTO{{Min: 650000, Max: 1450000, Count: 4}}, dto.Price.Histogram)
+		assert.Equal(t, DurationFacetDTO{MinMinutes: 100, MaxMinutes: 320, Count: 4}, dto.Duration)
+		assert.NotNil(t, dto.Amenities)
+		assert.Empty(t, dto.Amenities)
+	})
+}
+
+func TestToFlightDTO_Layovers(t *testing.T) {
+	flight := domain.Flight{
+		ID:    "JT650",
+		Stops: 1,
+		Layovers: []domain.Layover{
+			{AirportCode: "SUB", DurationMinutes: 75, Overnight: false},
+		},
+	}
+
+	dto := ToFlightDTO(flight)
+
+	assert.Equal(t, []LayoverDTO{{Airport: "SUB", DurationMinutes: 75, Overnight: false}}, dto.Layovers)
+}
+
+func TestToFlightDTO_ParetoTags(t *testing.T) {
+	assert.Nil(t, ToFlightDTO(domain.Flight{ID: "GA400"}).ParetoTags)
+
+	dto := ToFlightDTO(domain.Flight{ID: "GA400", ParetoTags: []string{domain.ParetoTagFastest}})
+
+	assert.Equal(t, []string{"fastest"}, dto.ParetoTags)
+}
+
+func TestToFlightDTO_SelfTransfer(t *testing.T) {
+	assert.Nil(t, ToFlightDTO(domain.Flight{ID: "GA400"}).SelfTransfer)
+
+	departure := time.Date(2025, 12, 15, 7, 10, 0, 0, time.UTC)
+	flight := domain.Flight{
+		ID: "QZ7510+JT828",
+		SelfTransfer: &domain.SelfTransfer{
+			Status:            domain.SelfTransferProtected,
+			ConnectionAirport: "SUB",
+			ConnectionMinutes: 140,
+			Legs: []domain.Flight{
+				{
+					ID:           "QZ7510",
+					FlightNumber: "QZ7510",
+					Provider:     "AirAsia",
+					Departure:    domain.FlightPoint{AirportCode: "CGK", DateTime: departure},
+					Price:        domain.PriceInfo{Amount: 540000, Currency: "IDR"},
+				},
+				{ID: "JT828", FlightNumber: "JT828", Provider: "Lion Air"},
+			},
+		},
+	}
+
+	dto := ToFlightDTO(flight)
+
+	require.NotNil(t, dto.SelfTransfer)
+	assert.Equal(t, "protected", dto.SelfTransfer.Status)
+	assert.Equal(t, "SUB", dto.SelfTransfer.ConnectionAirport)
+	assert.Equal(t, 140, dto.SelfTransfer.ConnectionMinutes)
+	require.Len(t, dto.SelfTransfer.Legs, 2)
+	leg := dto.SelfTransfer.Legs[0]
+	assert.Equal(t, "QZ7510", leg.FlightNumber)
+	assert.Equal(t, "AirAsia", leg.Provider)
+	assert.Equal(t, "CGK", leg.Departure.Airport)
+	assert.Equal(t, "2025-12-15T07:10:00Z", leg.Departure.Datetime)
+	assert.Equal(t, "Rp 540.000", leg.Price.Formatted)
+	assert.Equal(t, "Lion Air", dto.SelfTransfer.Legs[1].Provider)
+}
+
+func TestToFlightDTO_International(t *testing.T) {
+	flight := domain.Flight{
+		ID:               "GA820",
+		Departure:        domain.FlightPoint{AirportCode: "CGK", Country: "ID"},
+		Arrival:          domain.FlightPoint{AirportCode: "NRT", Country: "JP"},
+		International:    true,
+		MissingDocuments: []string{domain.DocumentVisa},
+	}
+
+	dto := ToFlightDTO(flight)
+
+	assert.True(t, dto.International)
+	assert.Equal(t, []string{"visa"}, dto.MissingDocuments)
+	assert.Equal(t, "ID", dto.Departure.Country)
+	assert.Equal(t, "JP", dto.Arrival.Country)
+}
+
+func TestToFlightDTO_PriceFormatted(t *testing.T) {
+	t.Run("uses formatted price", func(t *testing.T) {
+		dto := ToFlightDTO(domain.Flight{Price: domain.PriceInfo{Amount: 100, Currency: "USD", Formatted: "$100.00"}})
+		assert.Equal(t, float64(100), dto.Price.Amount)
+		assert.Equal(t, "USD", dto.Price.Currency)
+		assert.Equal(t, "$100.00", dto.Price.Formatted)
+	})
+
+	t.Run("formats missing formatted price", func(t *testing.T) {
+		dto := ToFlightDTO(domain.Flight{Price: domain.PriceInfo{Amount: 1250000, Currency: "IDR"}})
+		assert.Equal(t, "Rp 1.250.000", dto.Price.Formatted)
+	})
+}
+
+func TestToFlightDTO_FareBreakdown(t *testing.T) {
+	base, taxes, zero := 980000.0, 120000.0, 0.0
+
+	tests := []struct {
+		name     string
+		price    domain.PriceInfo
+		expected FareBreakdownDTO
+	}{
+		{
+			name: "itemized fare totaled for passengers",
+			price: domain.PriceInfo{
+				Amount:   1100000,
+				Currency: "IDR",
+				Breakdown: domain.FareBreakdown{
+					BaseFare: &base, Taxes: &taxes, Fees: &zero, Surcharges: &zero,
+					PerPassenger: 1100000, Passengers: 2, Total: 2200000,
+				},
+			},
+			expected: FareBreakdownDTO{
+				BaseFare: &base, Taxes: &taxes, Fees: &zero, Surcharges: &zero,
+				UnknownComponents: []string{},
+				PerPassenger:      1100000,
+				Passengers:        2,
+				Total:             2200000,
+				TotalFormatted:    "Rp 2.200.000",
+			},
+		},
+		{
+			name:  "total-only fare defaults to a single passenger",
+			price: domain.PriceInfo{Amount: 950000, Currency: "IDR"},
+			expected: FareBreakdownDTO{
+				UnknownComponents: []string{"base", "taxes", "fees", "surcharges"},
+				PerPassenger:      950000,
+				Passengers:        1,
+				Total:             950000,
+				TotalFormatted:    "Rp 950.000",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dto := ToFlightDTO(domain.Flight{Price: tt.price})
+			assert.Equal(t, tt.expected, dto.Price.Breakdown)
+		})
+	}
+}
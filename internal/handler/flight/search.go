@@ -3,6 +3,8 @@ package flight
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/herdiagusthio/flight-search-system/domain"
@@ -10,8 +12,14 @@ import (
 	"github.com/herdiagusthio/flight-search-system/internal/usecase"
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 )
 
+// tracer creates the spans of the flight handlers.
+var tracer = otel.Tracer("github.com/herdiagusthio/flight-search-system/internal/handler/flight")
+
 // FlightHandler handles HTTP requests for flight search operations.
 type FlightHandler struct {
 	searchUseCase usecase.FlightSearchUseCase
@@ -29,11 +37,13 @@ func NewFlightHandler(searchUseCase usecase.FlightSearchUseCase, logger *zerolog
 // HandleSearch processes flight search requests.
 // @Summary		Search for flights
 // @Description	Search for available flights from multiple airline providers based on search criteria
-// @Description	This endpoint aggregates flight data from Garuda Indonesia, Lion Air, Batik Air, and AirAsia
+// @Description	This endpoint aggregates flight data from Garuda Indonesia, Lion Air, Batik Air, AirAsia, Citilink, and Super Air Jet
 // @Tags		flights
 // @Accept		json
 // @Produce		json
 // @Param		request	body		SearchRequest	true	"Flight search parameters"
+// @Param		fields	query		string			false	"Comma-separated flight fields to return (e.g. flight_number,price,departure.datetime)"
+// @Param		explain	query		bool			false	"Include each flight's ranking score breakdown"
 // @Success		200		{object}	SearchResponse	"Successful flight search with results"
 // @Failure		400		{object}	httputil.ErrorDetail	"Invalid request body or validation error"
 // @Failure		504		{object}	httputil.ErrorDetail	"Gateway timeout - search took too long"
@@ -43,7 +53,8 @@ func NewFlightHandler(searchUseCase usecase.FlightSearchUseCase, logger *zerolog
 // It parses the request, validates input, calls the use case, and returns the response.
 func (h *FlightHandler) HandleSearch(c echo.Context) error {
 	start := time.Now()
-	ctx := c.Request().Context()
+	ctx, span := tracer.Start(c.Request().Context(), "FlightHandler.HandleSearch")
+	defer span.End()
 
 	// Parse request body
 	var req SearchRequest
@@ -55,6 +66,20 @@ func (h *FlightHandler) HandleSearch(c echo.Context) error {
 		return httputil.InvalidRequest(c)
 	}
 
+	// Sparse fieldset may also be given as a query parameter (?fields=...)
+	if req.Fields == "" {
+		req.Fields = c.QueryParam("fields")
+	}
+
+	// Ranking explanations may also be requested as a query parameter (?explain=true)
+	if explain := c.QueryParam("explain"); explain != "" {
+		enabled, err := strconv.ParseBool(explain)
+		if err != nil {
+			return httputil.ValidationErrorWithMessage(c, fmt.Sprintf("explain must be a boolean, got %q", explain))
+		}
+		req.Explain = req.Explain || enabled
+	}
+
 	// Normalize request (uppercase airport codes, lowercase options)
 	req.Normalize()
 
@@ -72,6 +97,13 @@ func (h *FlightHandler) HandleSearch(c echo.Context) error {
 	criteria := ToSearchCriteria(req)
 	options := ToSearchOptions(req)
 
+	span.SetAttributes(
+		attribute.String("origin", criteria.Origin),
+		attribute.String("destination", criteria.Destination),
+		attribute.String("departure_date", criteria.DepartureDate),
+		attribute.Int("passengers", criteria.Passengers),
+	)
+
 	// Log search request
 	h.logger.Info().
 		Str("method", "HandleSearch").
@@ -84,6 +116,8 @@ func (h *FlightHandler) HandleSearch(c echo.Context) error {
 	// Execute search
 	result, err := h.searchUseCase.Search(ctx, criteria, options)
 	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
 		return h.handleError(c, err, start)
 	}
 
@@ -91,17 +125,12 @@ func (h *FlightHandler) HandleSearch(c echo.Context) error {
 	processingTime := time.Since(start).Milliseconds()
 
 	// Update metadata with processing time
-	metadata := Metadata{
-		TotalResults:       result.Metadata.TotalResults,
-		ProvidersQueried:   result.Metadata.ProvidersQueried,
-		ProvidersSucceeded: result.Metadata.ProvidersSucceeded,
-		ProvidersFailed:    result.Metadata.ProvidersFailed,
-		SearchTimeMs:       processingTime,
-		CacheHit:           result.Metadata.CacheHit,
-	}
+	metadata := ToMetadata(result.Metadata, processingTime)
 
 	// Build response
-	respDTO := NewSearchResponse(criteria, result.Flights, metadata)
+	respDTO := NewSearchResponse(criteria, result.Flights, metadata, ParseFields(req.Fields))
+	respDTO.Facets = ToFacetsDTO(result.Facets)
+	span.SetAttributes(attribute.Int("flight_count", metadata.TotalResults))
 
 	h.logger.Info().
 		Str("method", "HandleSearch").
@@ -75,12 +75,14 @@ func TestHandleSearch_Success_WithResults(t *testing.T) {
 			},
 			Departure: domain.FlightPoint{
 				AirportCode: "CGK",
-				AirportName: "Jakarta",
+				AirportName: "Soekarno-Hatta International Airport",
+				City:        "Jakarta",
 				DateTime:    time.Date(2025, 12, 15, 6, 0, 0, 0, time.UTC),
 			},
 			Arrival: domain.FlightPoint{
 				AirportCode: "DPS",
-				AirportName: "Denpasar",
+				AirportName: "I Gusti Ngurah Rai International Airport",
+				City:        "Denpasar",
 				DateTime:    time.Date(2025, 12, 15, 8, 30, 0, 0, time.UTC),
 			},
 			Duration: domain.DurationInfo{
@@ -566,3 +568,177 @@ func TestHandleSearch_NormalizeRequest(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusOK, rec.Code)
 }
+
+func TestHandleSearch_WithFieldsQueryParam(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUseCase := usecase.NewMockFlightSearchUseCase(ctrl)
+	logger := zerolog.Nop()
+	handler := NewFlightHandler(mockUseCase, &logger)
+
+	reqBody := `{
+		"origin": "CGK",
+		"destination": "DPS",
+		"departureDate": "2025-12-15",
+		"passengers": 1
+	}`
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/api/flights/search?fields=flight_number,price.amount", strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	domainResponse := &domain.SearchResponse{
+		Flights: []domain.Flight{
+			{
+				ID:           "GA400",
+				FlightNumber: "GA400",
+				Airline:      domain.AirlineInfo{Code: "GA", Name: "Garuda Indonesia"},
+				Price:        domain.PriceInfo{Amount: 1250000, Currency: "IDR"},
+			},
+		},
+		Metadata: domain.SearchMetadata{TotalResults: 1, ProvidersQueried: 4, ProvidersSucceeded: 4},
+	}
+
+	mockUseCase.EXPECT().
+		Search(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(domainResponse, nil)
+
+	err := handler.HandleSearch(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response struct {
+		Flights []map[string]interface{} `json:"flights"`
+	}
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, response.Flights, 1)
+	assert.Equal(t, map[string]interface{}{
+		"flight_number": "GA400",
+		"price":         map[string]interface{}{"amount": float64(1250000)},
+	}, response.Flights[0])
+}
+
+func TestHandleSearch_ValidationError_UnknownField(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUseCase := usecase.NewMockFlightSearchUseCase(ctrl)
+	logger := zerolog.Nop()
+	handler := NewFlightHandler(mockUseCase, &logger)
+
+	reqBody := `{
+		"origin": "CGK",
+		"destination": "DPS",
+		"departureDate": "2025-12-15",
+		"passengers": 1,
+		"fields": "flight_number,seat_map"
+	}`
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/api/flights/search", strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := handler.HandleSearch(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var response map[string]interface{}
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "validation_error", response["code"])
+	assert.Contains(t, response["message"], "unknown field(s): seat_map")
+}
+
+func TestHandleSearch_WithExplainQueryParam(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUseCase := usecase.NewMockFlightSearchUseCase(ctrl)
+	logger := zerolog.Nop()
+	handler := NewFlightHandler(mockUseCase, &logger)
+
+	reqBody := `{
+		"origin": "CGK",
+		"destination": "DPS",
+		"departureDate": "2025-12-15",
+		"passengers": 1
+	}`
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/api/flights/search?explain=true", strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	domainResponse := &domain.SearchResponse{
+		Flights: []domain.Flight{
+			{
+				ID:           "GA400",
+				FlightNumber: "GA400",
+				Price:        domain.PriceInfo{Amount: 1250000, Currency: "IDR"},
+				RankingScore: 0.5,
+				RankingExplanation: &domain.RankingExplanation{
+					Components: map[string]float64{"price": 1},
+					Weights:    map[string]float64{"price": 0.5, "duration": 0.3, "stops": 0.2},
+					Bounds:     map[string]domain.RankingBound{"price": {Min: 650000, Max: 1250000}},
+				},
+			},
+		},
+		Metadata: domain.SearchMetadata{TotalResults: 1},
+	}
+
+	mockUseCase.EXPECT().
+		Search(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ domain.SearchCriteria, opts usecase.SearchOptions) (*domain.SearchResponse, error) {
+			assert.True(t, opts.Explain)
+			return domainResponse, nil
+		})
+
+	err := handler.HandleSearch(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response SearchResponse
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, response.Flights, 1)
+	assert.Equal(t, &RankingExplanationDTO{
+		Score:      0.5,
+		Components: map[string]float64{"price": 1},
+		Weights:    map[string]float64{"price": 0.5, "duration": 0.3, "stops": 0.2},
+		Bounds:     map[string]RankingBoundDTO{"price": {Min: 650000, Max: 1250000}},
+	}, response.Flights[0].RankingExplanation)
+}
+
+func TestHandleSearch_ValidationError_InvalidExplain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUseCase := usecase.NewMockFlightSearchUseCase(ctrl)
+	logger := zerolog.Nop()
+	handler := NewFlightHandler(mockUseCase, &logger)
+
+	reqBody := `{"origin": "CGK", "destination": "DPS", "departureDate": "2025-12-15", "passengers": 1}`
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/api/flights/search?explain=maybe", strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := handler.HandleSearch(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), `explain must be a boolean`)
+}
@@ -34,6 +34,20 @@ func ValidationErrorWithMessage(c echo.Context, message string) error {
 	})
 }
 
+func NotFound(c echo.Context, message string) error {
+	return c.JSON(http.StatusNotFound, &ErrorDetail{
+		Code:    CodeNotFound,
+		Message: message,
+	})
+}
+
+func Unauthorized(c echo.Context) error {
+	return c.JSON(http.StatusUnauthorized, &ErrorDetail{
+		Code:    CodeUnauthorized,
+		Message: MsgUnauthorized,
+	})
+}
+
 func ServiceUnavailable(c echo.Context) error {
 	return c.JSON(http.StatusServiceUnavailable, &ErrorDetail{
 		Code:    CodeServiceUnavailable,
@@ -4,6 +4,8 @@ package httputil
 const (
 	CodeInvalidRequest     = "invalid_request"
 	CodeValidationError    = "validation_error"
+	CodeNotFound           = "not_found"
+	CodeUnauthorized       = "unauthorized"
 	CodeServiceUnavailable = "service_unavailable"
 	CodeTimeout            = "timeout"
 	CodeInternalError      = "internal_error"
@@ -14,6 +16,7 @@ const (
 	MsgInvalidRequestBody = "Failed to parse request body"
 	MsgValidationFailed   = "Request validation failed"
 	MsgServiceUnavailable = "All flight providers are currently unavailable"
+	MsgUnauthorized       = "Missing or invalid API key"
 	MsgTimeout            = "Request timed out"
 	MsgRequestCancelled   = "Request was cancelled"
 	MsgInternalError      = "An unexpected error occurred"
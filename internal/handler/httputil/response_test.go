@@ -55,6 +55,24 @@ func TestErrorResponses(t *testing.T) {
 			expectedCode:   CodeValidationError,
 			expectedMsg:    "field 'name' is required",
 		},
+		{
+			name: "NotFound",
+			handler: func(c echo.Context) error {
+				return NotFound(c, "provider \"citilink\" not found")
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   CodeNotFound,
+			expectedMsg:    "provider \"citilink\" not found",
+		},
+		{
+			name: "Unauthorized",
+			handler: func(c echo.Context) error {
+				return Unauthorized(c)
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   CodeUnauthorized,
+			expectedMsg:    MsgUnauthorized,
+		},
 		{
 			name: "ServiceUnavailable",
 			handler: func(c echo.Context) error {
@@ -210,6 +228,19 @@ func TestSearchFlights(t *testing.T) {
 	}
 }
 
+func TestOK(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/airports", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := OK(c, map[string]interface{}{"airports": []string{"CGK"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"airports":["CGK"]}`, rec.Body.String())
+}
+
 func TestErrorDetailSerialization(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -265,6 +296,8 @@ func TestConstants(t *testing.T) {
 	}{
 		{"CodeInvalidRequest", CodeInvalidRequest, "invalid_request"},
 		{"CodeValidationError", CodeValidationError, "validation_error"},
+		{"CodeNotFound", CodeNotFound, "not_found"},
+		{"CodeUnauthorized", CodeUnauthorized, "unauthorized"},
 		{"CodeServiceUnavailable", CodeServiceUnavailable, "service_unavailable"},
 		{"CodeTimeout", CodeTimeout, "timeout"},
 		{"CodeInternalError", CodeInternalError, "internal_error"},
@@ -285,6 +318,7 @@ func TestConstants(t *testing.T) {
 		{"MsgInvalidRequestBody", MsgInvalidRequestBody, "Failed to parse request body"},
 		{"MsgValidationFailed", MsgValidationFailed, "Request validation failed"},
 		{"MsgServiceUnavailable", MsgServiceUnavailable, "All flight providers are currently unavailable"},
+		{"MsgUnauthorized", MsgUnauthorized, "Missing or invalid API key"},
 		{"MsgTimeout", MsgTimeout, "Request timed out"},
 		{"MsgRequestCancelled", MsgRequestCancelled, "Request was cancelled"},
 		{"MsgInternalError", MsgInternalError, "An unexpected error occurred"},
@@ -28,3 +28,8 @@ func HealthCheck(c echo.Context) error {
 func SearchFlights(c echo.Context, result interface{}) error {
 	return c.JSON(http.StatusOK, result)
 }
+
+// OK writes result as a 200 JSON response.
+func OK(c echo.Context, result interface{}) error {
+	return c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,62 @@
+package reference
+
+import (
+	"github.com/herdiagusthio/flight-search-system/internal/handler/httputil"
+	"github.com/herdiagusthio/flight-search-system/pkg/airline"
+	"github.com/labstack/echo/v4"
+)
+
+// AirlineDTO represents an airline in the airline directory.
+type AirlineDTO struct {
+	Code       string  `json:"code" example:"GA"`                                 // IATA airline code
+	Name       string  `json:"name" example:"Garuda Indonesia"`                   // Canonical airline name
+	Logo       string  `json:"logo" example:"https://pics.avs.io/200/200/GA.png"` // Airline logo URL
+	LowCost    bool    `json:"low_cost" example:"false"`                          // Whether the airline is a low-cost carrier
+	Group      string  `json:"group" example:"Garuda Indonesia Group"`            // Parent airline group
+	Alliance   string  `json:"alliance,omitempty" example:"SkyTeam"`              // Airline alliance, omitted if unaligned
+	OnTimeRate float64 `json:"on_time_rate,omitempty" example:"0.88"`             // Indicative share of flights arriving on time (0-1), omitted if unknown
+}
+
+// AirlineListResponse represents the airline directory response.
+type AirlineListResponse struct {
+	Airlines []AirlineDTO `json:"airlines"` // All known airlines, sorted by code
+}
+
+// HandleListAirlines returns the airline directory.
+// @Summary		Airline directory
+// @Description	List all known airlines with canonical name, logo, low-cost flag, parent group, alliance and on-time rate
+// @Tags		reference
+// @Produce		json
+// @Success		200	{object}	AirlineListResponse	"Airline directory"
+// @Router		/api/v1/airlines [get]
+func (h *ReferenceHandler) HandleListAirlines(c echo.Context) error {
+	airlines := airline.All()
+
+	resp := AirlineListResponse{
+		Airlines: make([]AirlineDTO, 0, len(airlines)),
+	}
+	for _, a := range airlines {
+		resp.Airlines = append(resp.Airlines, ToAirlineDTO(a))
+	}
+
+	h.logger.Debug().
+		Str("method", "HandleListAirlines").
+		Int("results", len(resp.Airlines)).
+		Msg("Airline directory listed")
+
+	return httputil.OK(c, resp)
+}
+
+// ToAirlineDTO converts an airline reference entry to AirlineDTO.
+func ToAirlineDTO(a airline.Airline) AirlineDTO {
+	return AirlineDTO{
+		Code:     a.Code,
+		Name:     a.Name,
+		Logo:     a.Logo,
+		LowCost:  a.LowCost,
+		Group:    a.Group,
+		Alliance: a.Alliance,
+
+		OnTimeRate: a.OnTimeRate,
+	}
+}
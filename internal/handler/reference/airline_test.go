@@ -0,0 +1,65 @@
+package reference
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/herdiagusthio/flight-search-system/pkg/airline"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleListAirlines(t *testing.T) {
+	logger := zerolog.Nop()
+	handler := NewReferenceHandler(&logger)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/airlines", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := handler.HandleListAirlines(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var resp AirlineListResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Len(t, resp.Airlines, len(airline.All()))
+
+	byCode := make(map[string]AirlineDTO, len(resp.Airlines))
+	for _, a := range resp.Airlines {
+		byCode[a.Code] = a
+	}
+	require.Contains(t, byCode, "GA")
+	assert.Equal(t, "Garuda Indonesia", byCode["GA"].Name)
+	assert.False(t, byCode["GA"].LowCost)
+	assert.Equal(t, "SkyTeam", byCode["GA"].Alliance)
+	assert.Equal(t, 0.88, byCode["GA"].OnTimeRate)
+	require.Contains(t, byCode, "JT")
+	assert.True(t, byCode["JT"].LowCost)
+	assert.Equal(t, "Lion Group", byCode["JT"].Group)
+}
+
+func TestToAirlineDTO(t *testing.T) {
+	dto := ToAirlineDTO(airline.Airline{
+		Code:       "QZ",
+		Name:       "Indonesia AirAsia",
+		Logo:       "https://pics.avs.io/200/200/QZ.png",
+		LowCost:    true,
+		Group:      "Capital A",
+		OnTimeRate: 0.85,
+	})
+
+	assert.Equal(t, AirlineDTO{
+		Code:       "QZ",
+		Name:       "Indonesia AirAsia",
+		Logo:       "https://pics.avs.io/200/200/QZ.png",
+		LowCost:    true,
+		Group:      "Capital A",
+		OnTimeRate: 0.85,
+	}, dto)
+}
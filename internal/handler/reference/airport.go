@@ -0,0 +1,102 @@
+package reference
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/herdiagusthio/flight-search-system/internal/handler/httputil"
+	"github.com/herdiagusthio/flight-search-system/pkg/airport"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// DefaultAirportLimit is the number of airports returned when limit is not given.
+	DefaultAirportLimit = 10
+	// MaxAirportLimit is the maximum number of airports returned per request.
+	MaxAirportLimit = 50
+)
+
+// AirportDTO represents an airport in autocomplete results.
+type AirportDTO struct {
+	Code     string `json:"code" example:"CGK"`                                  // IATA airport code
+	Name     string `json:"name" example:"Soekarno-Hatta International Airport"` // Airport name
+	City     string `json:"city" example:"Jakarta"`                              // City served
+	Country  string `json:"country" example:"ID"`                                // ISO 3166-1 alpha-2 country code
+	Timezone string `json:"timezone" example:"Asia/Jakarta"`                     // IANA timezone
+}
+
+// AirportSearchResponse represents the airport autocomplete response.
+type AirportSearchResponse struct {
+	Query    string       `json:"query" example:"jak"` // The search query
+	Airports []AirportDTO `json:"airports"`            // Matching airports, best match first
+}
+
+// HandleSearchAirports returns airports matching a typeahead query.
+// @Summary		Airport autocomplete
+// @Description	Search airports by IATA code, city name or airport name using prefix and fuzzy matching
+// @Description	Results are ranked by match strength with major airports first
+// @Tags		reference
+// @Produce		json
+// @Param		q		query		string	true	"Search text (IATA code, city or airport name)"	example(jak)
+// @Param		limit	query		int		false	"Maximum number of results (default 10, max 50)"
+// @Success		200		{object}	AirportSearchResponse	"Matching airports"
+// @Failure		400		{object}	httputil.ErrorDetail	"Missing query or invalid limit"
+// @Router		/api/v1/airports [get]
+func (h *ReferenceHandler) HandleSearchAirports(c echo.Context) error {
+	query := strings.TrimSpace(c.QueryParam("q"))
+	if query == "" {
+		return httputil.ValidationErrorWithMessage(c, "q is required")
+	}
+
+	limit, err := parseLimit(c.QueryParam("limit"))
+	if err != nil {
+		h.logger.Warn().
+			Err(err).
+			Str("method", "HandleSearchAirports").
+			Msg("Invalid limit")
+		return httputil.ValidationErrorWithMessage(c, err.Error())
+	}
+
+	matches := airport.Search(query, limit)
+
+	resp := AirportSearchResponse{
+		Query:    query,
+		Airports: make([]AirportDTO, 0, len(matches)),
+	}
+	for _, a := range matches {
+		resp.Airports = append(resp.Airports, ToAirportDTO(a))
+	}
+
+	h.logger.Debug().
+		Str("method", "HandleSearchAirports").
+		Str("query", query).
+		Int("results", len(resp.Airports)).
+		Msg("Airport search completed")
+
+	return httputil.OK(c, resp)
+}
+
+// ToAirportDTO converts an airport reference entry to AirportDTO.
+func ToAirportDTO(a airport.Airport) AirportDTO {
+	return AirportDTO{
+		Code:     a.Code,
+		Name:     a.Name,
+		City:     a.City,
+		Country:  a.Country,
+		Timezone: a.Timezone,
+	}
+}
+
+// parseLimit parses the limit query parameter.
+// Returns DefaultAirportLimit if it is empty.
+func parseLimit(value string) (int, error) {
+	if value == "" {
+		return DefaultAirportLimit, nil
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 1 || limit > MaxAirportLimit {
+		return 0, fmt.Errorf("limit must be an integer between 1 and %d", MaxAirportLimit)
+	}
+	return limit, nil
+}
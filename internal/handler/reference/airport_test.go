@@ -0,0 +1,159 @@
+package reference
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/herdiagusthio/flight-search-system/pkg/airport"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReferenceHandler(t *testing.T) {
+	logger := zerolog.Nop()
+
+	handler := NewReferenceHandler(&logger)
+
+	assert.NotNil(t, handler)
+	assert.Equal(t, &logger, handler.logger)
+}
+
+func TestHandleSearchAirports(t *testing.T) {
+	logger := zerolog.Nop()
+	handler := NewReferenceHandler(&logger)
+
+	tests := []struct {
+		name           string
+		url            string
+		expectedStatus int
+		validate       func(t *testing.T, body []byte)
+	}{
+		{
+			name:           "city prefix",
+			url:            "/api/v1/airports?q=jak",
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, body []byte) {
+				var resp AirportSearchResponse
+				require.NoError(t, json.Unmarshal(body, &resp))
+				assert.Equal(t, "jak", resp.Query)
+				require.NotEmpty(t, resp.Airports)
+				assert.Equal(t, AirportDTO{
+					Code:     "CGK",
+					Name:     "Soekarno-Hatta International Airport",
+					City:     "Jakarta",
+					Country:  "ID",
+					Timezone: "Asia/Jakarta",
+				}, resp.Airports[0])
+			},
+		},
+		{
+			name:           "respects limit",
+			url:            "/api/v1/airports?q=a&limit=2",
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, body []byte) {
+				var resp AirportSearchResponse
+				require.NoError(t, json.Unmarshal(body, &resp))
+				assert.Len(t, resp.Airports, 2)
+			},
+		},
+		{
+			name:           "no matches returns empty list",
+			url:            "/api/v1/airports?q=zzzzzz",
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, body []byte) {
+				assert.JSONEq(t, `{"query":"zzzzzz","airports":[]}`, string(body))
+			},
+		},
+		{
+			name:           "missing query",
+			url:            "/api/v1/airports",
+			expectedStatus: http.StatusBadRequest,
+			validate: func(t *testing.T, body []byte) {
+				assert.JSONEq(t, `{"code":"validation_error","message":"q is required"}`, string(body))
+			},
+		},
+		{
+			name:           "invalid limit",
+			url:            "/api/v1/airports?q=jak&limit=abc",
+			expectedStatus: http.StatusBadRequest,
+			validate: func(t *testing.T, body []byte) {
+				assert.Contains(t, string(body), "limit must be an integer between 1 and 50")
+			},
+		},
+		{
+			name:           "limit above maximum",
+			url:            "/api/v1/airports?q=jak&limit=51",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			err := handler.HandleSearchAirports(c)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.validate != nil {
+				tt.validate(t, rec.Body.Bytes())
+			}
+		})
+	}
+}
+
+func TestParseLimit(t *testing.T) {
+	tests := []struct {
+		value       string
+		expected    int
+		expectError bool
+	}{
+		{"", DefaultAirportLimit, false},
+		{"1", 1, false},
+		{"50", 50, false},
+		{"0", 0, true},
+		{"-5", 0, true},
+		{"51", 0, true},
+		{"ten", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			limit, err := parseLimit(tt.value)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, limit)
+			}
+		})
+	}
+}
+
+func TestToAirportDTO(t *testing.T) {
+	dto := ToAirportDTO(airport.Airport{
+		Code:      "SIN",
+		Name:      "Singapore Changi Airport",
+		City:      "Singapore",
+		Country:   "SG",
+		Timezone:  "Asia/Singapore",
+		Latitude:  1.3644,
+		Longitude: 103.9915,
+		Major:     true,
+	})
+
+	assert.Equal(t, AirportDTO{
+		Code:     "SIN",
+		Name:     "Singapore Changi Airport",
+		City:     "Singapore",
+		Country:  "SG",
+		Timezone: "Asia/Singapore",
+	}, dto)
+}
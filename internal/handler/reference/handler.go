@@ -0,0 +1,17 @@
+package reference
+
+import (
+	"github.com/rs/zerolog"
+)
+
+// ReferenceHandler handles HTTP requests for reference data such as airports and airlines.
+type ReferenceHandler struct {
+	logger *zerolog.Logger
+}
+
+// NewReferenceHandler creates a new ReferenceHandler instance.
+func NewReferenceHandler(logger *zerolog.Logger) *ReferenceHandler {
+	return &ReferenceHandler{
+		logger: logger,
+	}
+}
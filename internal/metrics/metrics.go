@@ -0,0 +1,130 @@
+// Package metrics defines the Prometheus metrics of the service. The metrics are
+// registered with the default registry and exposed on /metrics.
+package metrics
+
+import (
+	"context"
+	"errors"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "flight_search"
+
+// Provider error types used as the type label of ProviderErrors.
+const (
+	ErrorTypeTimeout     = "timeout"
+	ErrorTypeCanceled    = "canceled"
+	ErrorTypeUnavailable = "unavailable"
+	ErrorTypeRateLimited = "rate_limited"
+	ErrorTypePanic       = "panic"
+	ErrorTypeOther       = "other"
+)
+
+// Cache lookup results used as the result label of CacheLookups.
+const (
+	CacheHit  = "hit"
+	CacheMiss = "miss"
+)
+
+var (
+	// HTTPRequests counts HTTP requests by method, route and status code.
+	HTTPRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests by method, route and status code.",
+	}, []string{"method", "route", "status"})
+
+	// HTTPRequestDuration observes HTTP request latency by method, route and status code.
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency by method, route and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	// ProviderCalls counts provider search calls, including retries.
+	ProviderCalls = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "provider_calls_total",
+		Help:      "Provider search calls, including retries.",
+	}, []string{"provider"})
+
+	// ProviderCallDuration observes the latency of provider search calls.
+	ProviderCallDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "provider_call_duration_seconds",
+		Help:      "Latency of provider search calls.",
+		Buckets:   []float64{0.025, 0.05, 0.1, 0.25, 0.5, 1, 2, 5},
+	}, []string{"provider"})
+
+	// ProviderErrors counts failed provider search calls by error type. Providers
+	// skipped for being over their rate limit are counted as rate_limited.
+	ProviderErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "provider_errors_total",
+		Help:      "Failed provider search calls by error type.",
+	}, []string{"provider", "type"})
+
+	// ProviderRetries counts provider search calls that were retries.
+	ProviderRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "provider_retries_total",
+		Help:      "Provider search calls that were retries of a failed call.",
+	}, []string{"provider"})
+
+	// ProviderTimeouts counts provider search calls that timed out.
+	ProviderTimeouts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "provider_timeouts_total",
+		Help:      "Provider search calls that timed out.",
+	}, []string{"provider"})
+
+	// ProviderFlights counts the flights returned by successful provider search calls.
+	ProviderFlights = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "provider_flights_returned_total",
+		Help:      "Flights returned by successful provider search calls.",
+	}, []string{"provider"})
+
+	// NormalizationSkipped counts provider flights skipped as invalid during normalization.
+	NormalizationSkipped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "provider_normalization_skipped_total",
+		Help:      "Provider flights skipped as invalid during normalization.",
+	}, []string{"provider"})
+
+	// CacheLookups counts result cache lookups by provider and result (hit or miss).
+	// The hit ratio is hits divided by all lookups.
+	CacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "result_cache_lookups_total",
+		Help:      "Result cache lookups by provider and result (hit or miss).",
+	}, []string{"provider", "result"})
+
+	// ResultSetSize observes the number of results returned by searches, by endpoint.
+	ResultSetSize = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "result_set_size",
+		Help:      "Number of results returned by searches, by endpoint.",
+		Buckets:   []float64{0, 1, 5, 10, 25, 50, 100, 250},
+	}, []string{"endpoint"})
+)
+
+// ProviderErrorType classifies a provider error for the type label of ProviderErrors.
+func ProviderErrorType(err error) string {
+	switch {
+	case errors.Is(err, domain.ErrProviderTimeout), errors.Is(err, context.DeadlineExceeded):
+		return ErrorTypeTimeout
+	case errors.Is(err, context.Canceled):
+		return ErrorTypeCanceled
+	case errors.Is(err, domain.ErrProviderUnavailable):
+		return ErrorTypeUnavailable
+	case errors.Is(err, domain.ErrProviderRateLimited):
+		return ErrorTypeRateLimited
+	default:
+		return ErrorTypeOther
+	}
+}
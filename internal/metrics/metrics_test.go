@@ -0,0 +1,56 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProviderErrorType(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "provider timeout",
+			err:  domain.NewProviderTimeoutError("garuda_indonesia"),
+			want: ErrorTypeTimeout,
+		},
+		{
+			name: "deadline exceeded",
+			err:  fmt.Errorf("search: %w", context.DeadlineExceeded),
+			want: ErrorTypeTimeout,
+		},
+		{
+			name: "canceled",
+			err:  context.Canceled,
+			want: ErrorTypeCanceled,
+		},
+		{
+			name: "unavailable",
+			err:  domain.NewProviderUnavailableError("lion_air"),
+			want: ErrorTypeUnavailable,
+		},
+		{
+			name: "rate limited by the provider",
+			err:  domain.NewRetryableProviderError("airasia", domain.ErrProviderRateLimited),
+			want: ErrorTypeRateLimited,
+		},
+		{
+			name: "other",
+			err:  errors.New("unexpected response"),
+			want: ErrorTypeOther,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ProviderErrorType(tt.err))
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Package exchangerate provides domain.ExchangeRateProvider implementations.
+package exchangerate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+)
+
+// StaticRates is the structure of a static exchange rate file.
+// Each rate is the value of one unit of the currency in the base currency.
+type StaticRates struct {
+	Base      string             `json:"base"`
+	UpdatedAt string             `json:"updated_at"`
+	Rates     map[string]float64 `json:"rates"`
+}
+
+// StaticProvider implements domain.ExchangeRateProvider using rates read from a JSON file.
+// The file is read once, on the first conversion between two different currencies.
+type StaticProvider struct {
+	// path is the path to the JSON rate file.
+	path string
+
+	loadOnce sync.Once
+	rates    map[string]float64
+	loadErr  error
+}
+
+// NewStaticProvider creates a StaticProvider reading rates from the JSON file at path.
+func NewStaticProvider(path string) *StaticProvider {
+	return &StaticProvider{path: path}
+}
+
+// Rate returns how many units of to one unit of from is worth.
+// Converting a currency to itself always returns 1 without reading the rate file.
+// Implements domain.ExchangeRateProvider.
+func (p *StaticProvider) Rate(ctx context.Context, from, to string) (float64, error) {
+	from = strings.ToUpper(strings.TrimSpace(from))
+	to = strings.ToUpper(strings.TrimSpace(to))
+	if from == to {
+		return 1, nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	p.loadOnce.Do(p.load)
+	if p.loadErr != nil {
+		return 0, p.loadErr
+	}
+
+	fromRate, ok := p.rates[from]
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", domain.ErrUnsupportedCurrency, from)
+	}
+	toRate, ok := p.rates[to]
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", domain.ErrUnsupportedCurrency, to)
+	}
+	return fromRate / toRate, nil
+}
+
+// load reads and validates the rate file.
+func (p *StaticProvider) load() {
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		p.loadErr = fmt.Errorf("failed to read exchange rates: %w", err)
+		return
+	}
+
+	var file StaticRates
+	if err := json.Unmarshal(data, &file); err != nil {
+		p.loadErr = fmt.Errorf("failed to parse exchange rates: %w", err)
+		return
+	}
+
+	p.rates = make(map[string]float64, len(file.Rates)+1)
+	for code, rate := range file.Rates {
+		if rate <= 0 {
+			p.loadErr = fmt.Errorf("invalid exchange rate for %s: %v", code, rate)
+			return
+		}
+		p.rates[strings.ToUpper(code)] = rate
+	}
+	if file.Base != "" {
+		p.rates[strings.ToUpper(file.Base)] = 1
+	}
+}
@@ -0,0 +1,105 @@
+package exchangerate
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeRatesFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "rates.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestStaticProviderRate(t *testing.T) {
+	path := writeRatesFile(t, `{"base": "IDR", "rates": {"USD": 16000, "SGD": 12000}}`)
+	provider := NewStaticProvider(path)
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		expected float64
+	}{
+		{"same currency", "USD", "USD", 1},
+		{"to base", "USD", "IDR", 16000},
+		{"from base", "IDR", "USD", 1.0 / 16000},
+		{"cross rate", "SGD", "USD", 0.75},
+		{"case-insensitive", "usd", " idr ", 16000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := provider.Rate(ctx, tt.from, tt.to)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expected, rate, 1e-12)
+		})
+	}
+}
+
+func TestStaticProviderRate_UnsupportedCurrency(t *testing.T) {
+	path := writeRatesFile(t, `{"base": "IDR", "rates": {"USD": 16000}}`)
+	provider := NewStaticProvider(path)
+
+	_, err := provider.Rate(context.Background(), "IDR", "XYZ")
+
+	assert.True(t, errors.Is(err, domain.ErrUnsupportedCurrency))
+}
+
+func TestStaticProviderRate_FileErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		path          string
+		errorContains string
+	}{
+		{"missing file", filepath.Join(t.TempDir(), "missing.json"), "failed to read exchange rates"},
+		{"invalid JSON", writeRatesFile(t, `{not json`), "failed to parse exchange rates"},
+		{"non-positive rate", writeRatesFile(t, `{"base": "IDR", "rates": {"USD": 0}}`), "invalid exchange rate for USD"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := NewStaticProvider(tt.path)
+
+			_, err := provider.Rate(context.Background(), "USD", "IDR")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errorContains)
+
+			// Same-currency conversion never needs the file
+			rate, err := provider.Rate(context.Background(), "IDR", "IDR")
+			require.NoError(t, err)
+			assert.Equal(t, 1.0, rate)
+		})
+	}
+}
+
+func TestStaticProviderRate_ContextCancelled(t *testing.T) {
+	path := writeRatesFile(t, `{"base": "IDR", "rates": {"USD": 16000}}`)
+	provider := NewStaticProvider(path)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := provider.Rate(ctx, "USD", "IDR")
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestStaticProvider_BundledRatesFile(t *testing.T) {
+	path := filepath.Join("..", "..", "..", "external", "exchange-rate", "rates.json")
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		t.Skip("Bundled rates file not found")
+	}
+
+	rate, err := NewStaticProvider(path).Rate(context.Background(), "USD", "IDR")
+	require.NoError(t, err)
+	assert.Greater(t, rate, 1.0)
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/internal/tracing"
 )
 
 const (
@@ -17,6 +18,12 @@ const (
 	airlineCode  = "QZ"
 )
 
+// coverage lists the airports served by AirAsia, which only offers economy.
+var coverage = domain.RouteCoverage{
+	Airports: []string{"CGK", "DPS", "SUB", "KNO", "LOP", "KUL", "SIN", "BKK"},
+	Classes:  []string{"economy"},
+}
+
 type Adapter struct {
 	mockDataPath   string
 	skipSimulation bool
@@ -33,6 +40,12 @@ func (a *Adapter) Name() string {
 	return ProviderName
 }
 
+// Coverage returns the airports and cabin classes served by AirAsia.
+// Implements domain.CoverageProvider.
+func (a *Adapter) Coverage() domain.RouteCoverage {
+	return coverage
+}
+
 // Search queries the provider for available flights matching the criteria.
 // It reads from mock JSON data and returns normalized flight entities.
 // Simulates real-world conditions: Fast but occasionally fails (90% success rate, 50-150ms delay).
@@ -94,7 +107,7 @@ func (a *Adapter) Search(ctx context.Context, criteria domain.SearchCriteria) ([
 		return []domain.Flight{}, nil
 	}
 
-	flights := normalize(response.Flights)
+	flights := tracing.Normalize(ctx, ProviderName, response.Flights, normalize)
 	return filterFlights(flights, criteria), nil
 }
 
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/internal/metrics"
+	"github.com/herdiagusthio/flight-search-system/pkg/airport"
 	"github.com/herdiagusthio/flight-search-system/pkg/util"
 	"github.com/rs/zerolog/log"
 )
@@ -36,6 +38,7 @@ func normalize(flights []AirAsiaFlight) []domain.Flight {
 	}
 
 	if skippedCount > 0 {
+		metrics.NormalizationSkipped.WithLabelValues(ProviderName).Add(float64(skippedCount))
 		log.Info().
 			Str("provider", ProviderName).
 			Int("skipped", skippedCount).
@@ -66,7 +69,7 @@ func normalizeSingle(f AirAsiaFlight) (domain.Flight, bool) {
 	// Parse baggage descriptions from the note
 	carryOnDesc, checkedDesc := formatBaggageDescriptions(f.BaggageNote, cabinKg, checkedKg)
 
-	return domain.Flight{
+	flight := domain.Flight{
 		ID:           flightID,
 		FlightNumber: f.FlightCode,
 		Airline: domain.AirlineInfo{
@@ -89,6 +92,8 @@ func normalizeSingle(f AirAsiaFlight) (domain.Flight, bool) {
 			Amount:   f.PriceIDR,
 			Currency: "IDR",
 			Formatted: util.FormatIDR(f.PriceIDR),
+			// AirAsia only reports the total fare, so the breakdown components stay unknown
+			Breakdown: domain.FareBreakdown{},
 		},
 		Baggage: domain.BaggageInfo{
 			CabinKg:     cabinKg,
@@ -102,7 +107,25 @@ func normalizeSingle(f AirAsiaFlight) (domain.Flight, bool) {
 		AvailableSeats: f.Seats,
 		Aircraft:       "", // AirAsia mock data doesn't include aircraft info
 		Amenities:      []string{}, // AirAsia mock data doesn't include amenities
-	}, true
+		Layovers:       buildLayovers(f.Stops, departureTime, arrivalTime),
+	}
+	flight.ApplyReferenceData()
+
+	return flight, true
+}
+
+// buildLayovers converts AirAsia stops to domain layovers.
+// AirAsia only reports wait times, so the itinerary window bounds the overnight check.
+func buildLayovers(stops []AirAsiaStop, departure, arrival time.Time) []domain.Layover {
+	if len(stops) == 0 {
+		return nil
+	}
+
+	layovers := make([]domain.Layover, 0, len(stops))
+	for _, s := range stops {
+		layovers = append(layovers, domain.NewLayoverFromDuration(s.Airport, s.WaitTimeMinutes, departure, arrival, airport.LocationOr(s.Airport, departure.Location())))
+	}
+	return layovers
 }
 
 // generateFlightID creates a unique identifier for a flight.
@@ -160,8 +183,11 @@ func parseDateTime(datetime, airportCode string) (time.Time, error) {
 	}
 
 	// Fallback: parse without timezone and use airport's timezone
-	timezone := util.GetTimezoneByAirport(airportCode)
-	t, err = util.ParseInTimezone("2006-01-02T15:04:05", datetime, timezone)
+	loc, err := airport.Location(airportCode)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unable to parse datetime %q without timezone: %w", datetime, err)
+	}
+	t, err = time.ParseInLocation("2006-01-02T15:04:05", datetime, loc)
 	if err != nil {
 		return time.Time{}, fmt.Errorf("unable to parse datetime %q for airport %s: %w", datetime, airportCode, err)
 	}
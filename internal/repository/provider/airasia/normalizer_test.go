@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/herdiagusthio/flight-search-system/domain"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -38,6 +39,10 @@ func TestNormalize(t *testing.T) {
 	assert.Equal(t, "economy", result[0].Class)
 	assert.Equal(t, 0, result[0].Stops)
 	assert.Equal(t, ProviderName, result[0].Provider)
+	assert.Equal(t, "QZ", result[0].Airline.Code)
+	assert.Equal(t, "Indonesia AirAsia", result[0].Airline.Name)
+	assert.NotEmpty(t, result[0].Airline.Logo)
+	assert.True(t, result[0].Airline.LowCost)
 }
 
 func TestNormalizeSingle(t *testing.T) {
@@ -439,13 +444,14 @@ func TestFormatBaggageDescriptions(t *testing.T) {
 
 func TestNormalizeSingleWithNewFields(t *testing.T) {
 	tests := []struct {
-		name                 string
-		flight               AirAsiaFlight
-		expectSeats          int
-		expectAircraft       string
-		expectAmenities      []string
-		expectCarryOnDesc    string
-		expectCheckedDesc    string
+		name              string
+		flight            AirAsiaFlight
+		expectSeats       int
+		expectAircraft    string
+		expectAmenities   []string
+		expectCarryOnDesc string
+		expectCheckedDesc string
+		expectLayovers    []domain.Layover
 	}{
 		{
 			name: "flight with seats and baggage description",
@@ -469,6 +475,27 @@ func TestNormalizeSingleWithNewFields(t *testing.T) {
 			expectCarryOnDesc: "Cabin baggage only",
 			expectCheckedDesc: "Additional fee",
 		},
+		{
+			name: "flight with stop",
+			flight: AirAsiaFlight{
+				FlightCode:    "QZ7250",
+				Airline:       "AirAsia",
+				FromAirport:   "CGK",
+				ToAirport:     "DPS",
+				DepartTime:    "2025-12-15T15:15:00+07:00",
+				ArriveTime:    "2025-12-15T20:35:00+08:00",
+				DurationHours: 4.33,
+				PriceIDR:      485000,
+				CabinClass:    "economy",
+				DirectFlight:  false,
+				Stops:         []AirAsiaStop{{Airport: "SOC", WaitTimeMinutes: 95}},
+				BaggageNote:   "Cabin baggage only, checked bags additional fee",
+			},
+			expectAmenities:   []string{},
+			expectCarryOnDesc: "Cabin baggage only",
+			expectCheckedDesc: "Additional fee",
+			expectLayovers:    []domain.Layover{{AirportCode: "SOC", DurationMinutes: 95, Overnight: false}},
+		},
 	}
 
 	for _, tt := range tests {
@@ -480,7 +507,7 @@ func TestNormalizeSingleWithNewFields(t *testing.T) {
 			assert.Equal(t, tt.expectAmenities, result.Amenities)
 			assert.Equal(t, tt.expectCarryOnDesc, result.Baggage.CarryOnDesc)
 			assert.Equal(t, tt.expectCheckedDesc, result.Baggage.CheckedDesc)
+			assert.Equal(t, tt.expectLayovers, result.Layovers)
 		})
 	}
 }
-
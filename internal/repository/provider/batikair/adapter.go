@@ -9,11 +9,26 @@ import (
 	"time"
 
 	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/internal/tracing"
 )
 
 const(
 	ProviderName = "batik_air"
 )
+
+// coverage lists the routes and cabin classes served by Batik Air.
+var coverage = domain.RouteCoverage{
+	Routes: []domain.Route{
+		{Origin: "CGK", Destination: "DPS"},
+		{Origin: "CGK", Destination: "SUB"},
+		{Origin: "CGK", Destination: "UPG"},
+		{Origin: "CGK", Destination: "KNO"},
+		{Origin: "SUB", Destination: "DPS"},
+		{Origin: "CGK", Destination: "KUL"},
+	},
+	Classes: []string{"economy", "business"},
+}
+
 // Adapter implements the domain.FlightProvider interface for Batik Air.
 // It reads from mock JSON data and normalizes it to the unified Flight domain model.
 type Adapter struct {
@@ -38,6 +53,12 @@ func (a *Adapter) Name() string {
 	return ProviderName
 }
 
+// Coverage returns the routes and cabin classes served by Batik Air.
+// Implements domain.CoverageProvider.
+func (a *Adapter) Coverage() domain.RouteCoverage {
+	return coverage
+}
+
 // Search queries the provider for available flights matching the criteria.
 // It reads from mock JSON data and returns normalized flight entities.
 // Simulates real-world conditions: Slower response (200-400ms delay).
@@ -91,7 +112,7 @@ func (a *Adapter) Search(ctx context.Context, criteria domain.SearchCriteria) ([
 		return []domain.Flight{}, nil
 	}
 
-	flights := normalize(response.Results)
+	flights := tracing.Normalize(ctx, ProviderName, response.Results, normalize)
 	return filterFlights(flights, criteria), nil
 }
 
@@ -109,3 +109,28 @@ func TestFilterFlights(t *testing.T) {
 		})
 	}
 }
+
+func TestAdapterCoverage(t *testing.T) {
+	mockDataPath := filepath.Join("..", "..", "..", "..", "external", "response-mock", "batik_air_search_response.json")
+
+	if _, err := os.Stat(mockDataPath); os.IsNotExist(err) {
+		t.Skip("Mock data file not found")
+	}
+
+	adapter := NewAdapter(mockDataPath, true)
+	flights, err := adapter.Search(context.Background(), domain.SearchCriteria{})
+	require.NoError(t, err)
+	require.NotEmpty(t, flights)
+
+	coverage := adapter.Coverage()
+	for _, f := range flights {
+		criteria := domain.SearchCriteria{
+			Origin:      f.Departure.AirportCode,
+			Destination: f.Arrival.AirportCode,
+			Class:       f.Class,
+		}
+		assert.True(t, coverage.Covers(criteria), "flight %s must be covered", f.ID)
+	}
+
+	assert.False(t, coverage.Covers(domain.SearchCriteria{Origin: "SUB", Destination: "LOP", Class: "economy"}), "route not served")
+}
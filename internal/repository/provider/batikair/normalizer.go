@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/internal/metrics"
+	"github.com/herdiagusthio/flight-search-system/pkg/airport"
 	"github.com/herdiagusthio/flight-search-system/pkg/util"
 	"github.com/rs/zerolog/log"
 )
@@ -39,6 +41,7 @@ func normalize(batikAirFlights []BatikAirFlight) []domain.Flight {
 	}
 
 	if skippedCount > 0 {
+		metrics.NormalizationSkipped.WithLabelValues(ProviderName).Add(float64(skippedCount))
 		log.Info().
 			Str("provider", ProviderName).
 			Int("skipped", skippedCount).
@@ -49,6 +52,24 @@ func normalize(batikAirFlights []BatikAirFlight) []domain.Flight {
 	return result
 }
 
+// fareBreakdown itemizes a Batik Air fare, which reports the base price and taxes.
+// Fees and surcharges are known to be zero only when base and taxes add up to the total;
+// otherwise they stay unknown. A missing base price leaves every component unknown.
+func fareBreakdown(fare BatikAirFare, totalPrice float64) domain.FareBreakdown {
+	if fare.BasePrice <= 0 {
+		return domain.FareBreakdown{}
+	}
+
+	base, taxes := fare.BasePrice, fare.Taxes
+	breakdown := domain.FareBreakdown{BaseFare: &base, Taxes: &taxes}
+	if base+taxes == totalPrice {
+		var fees, surcharges float64
+		breakdown.Fees = &fees
+		breakdown.Surcharges = &surcharges
+	}
+	return breakdown
+}
+
 // normalizeFlight converts a single Batik Air flight to a domain Flight entity.
 func normalizeFlight(f BatikAirFlight) (domain.Flight, error) {
 	// Parse departure time with timezone fallback
@@ -78,7 +99,7 @@ func normalizeFlight(f BatikAirFlight) (domain.Flight, error) {
 		totalPrice = f.Fare.BasePrice + f.Fare.Taxes
 	}
 
-	return domain.Flight{
+	flight := domain.Flight{
 		ID:           f.FlightNumber,
 		FlightNumber: f.FlightNumber,
 		Airline: domain.AirlineInfo{
@@ -100,7 +121,8 @@ func normalizeFlight(f BatikAirFlight) (domain.Flight, error) {
 		Price: domain.PriceInfo{
 			Amount:    totalPrice,
 			Currency:  f.Fare.CurrencyCode,
-			Formatted: util.FormatIDR(totalPrice),
+			Formatted: util.FormatCurrency(totalPrice, f.Fare.CurrencyCode),
+			Breakdown: fareBreakdown(f.Fare, totalPrice),
 		},
 		Baggage: domain.BaggageInfo{
 			CabinKg:   cabinKg,
@@ -112,7 +134,34 @@ func normalizeFlight(f BatikAirFlight) (domain.Flight, error) {
 		AvailableSeats: f.SeatsAvailable,
 		Aircraft:       f.AircraftModel,
 		Amenities:      f.OnboardServices,
-	}, nil
+		Layovers:       buildLayovers(f.Connections, departureTime, arrivalTime),
+	}
+	flight.ApplyReferenceData()
+
+	return flight, nil
+}
+
+// buildLayovers converts Batik Air connections to domain layovers.
+// Batik Air only reports stop durations, so the itinerary window bounds the overnight check.
+// Connections with an unparseable duration are kept with a zero duration.
+func buildLayovers(connections []BatikAirConnection, departure, arrival time.Time) []domain.Layover {
+	if len(connections) == 0 {
+		return nil
+	}
+
+	layovers := make([]domain.Layover, 0, len(connections))
+	for _, c := range connections {
+		minutes, err := parseDurationString(c.StopDuration)
+		if err != nil {
+			log.Debug().
+				Str("provider", ProviderName).
+				Str("stop_airport", c.StopAirport).
+				Err(err).
+				Msg("Failed to parse connection stop duration")
+		}
+		layovers = append(layovers, domain.NewLayoverFromDuration(c.StopAirport, minutes, departure, arrival, airport.LocationOr(c.StopAirport, departure.Location())))
+	}
+	return layovers
 }
 
 // parseDateTime parses an ISO 8601 datetime string to time.Time.
@@ -132,8 +181,11 @@ func parseDateTime(datetime, airportCode string) (time.Time, error) {
 	}
 
 	// Fallback: parse without timezone and use airport's timezone
-	timezone := util.GetTimezoneByAirport(airportCode)
-	t, err = util.ParseInTimezone("2006-01-02T15:04:05", datetime, timezone)
+	loc, err := airport.Location(airportCode)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unable to parse datetime %q without timezone: %w", datetime, err)
+	}
+	t, err = time.ParseInLocation("2006-01-02T15:04:05", datetime, loc)
 	if err != nil {
 		return time.Time{}, fmt.Errorf("unable to parse datetime %q for airport %s: %w", datetime, airportCode, err)
 	}
@@ -2,7 +2,9 @@ package batikair
 
 import (
 	"testing"
+	"time"
 
+	"github.com/herdiagusthio/flight-search-system/domain"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -245,6 +247,51 @@ func TestNormalizeFlightPriceFallback(t *testing.T) {
 	assert.Equal(t, "Rp 900.000", result.Price.Formatted)
 }
 
+func TestFareBreakdown(t *testing.T) {
+	tests := []struct {
+		name          string
+		fare          BatikAirFare
+		totalPrice    float64
+		expectBase    *float64
+		expectTaxes   *float64
+		expectUnknown []string
+	}{
+		{
+			name:          "base and taxes add up to total",
+			fare:          BatikAirFare{BasePrice: 980000, Taxes: 120000, TotalPrice: 1100000},
+			totalPrice:    1100000,
+			expectBase:    ptrFloat64(980000),
+			expectTaxes:   ptrFloat64(120000),
+			expectUnknown: nil,
+		},
+		{
+			name:          "total includes unitemized charges",
+			fare:          BatikAirFare{BasePrice: 980000, Taxes: 120000, TotalPrice: 1150000},
+			totalPrice:    1150000,
+			expectBase:    ptrFloat64(980000),
+			expectTaxes:   ptrFloat64(120000),
+			expectUnknown: []string{domain.FareComponentFees, domain.FareComponentSurcharges},
+		},
+		{
+			name:          "missing base price",
+			fare:          BatikAirFare{TotalPrice: 1100000},
+			totalPrice:    1100000,
+			expectUnknown: []string{domain.FareComponentBase, domain.FareComponentTaxes, domain.FareComponentFees, domain.FareComponentSurcharges},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			breakdown := fareBreakdown(tt.fare, tt.totalPrice)
+			assert.Equal(t, tt.expectBase, breakdown.BaseFare)
+			assert.Equal(t, tt.expectTaxes, breakdown.Taxes)
+			assert.Equal(t, tt.expectUnknown, breakdown.UnknownComponents())
+		})
+	}
+}
+
+func ptrFloat64(v float64) *float64 { return &v }
+
 func TestNormalizeWithMultipleFlights(t *testing.T) {
 	flights := []BatikAirFlight{
 		{
@@ -282,6 +329,7 @@ func TestNormalizeFlightWithNewFields(t *testing.T) {
 		expectSeats     int
 		expectAircraft  string
 		expectAmenities []string
+		expectLayovers  []domain.Layover
 	}{
 		{
 			name: "flight with all new fields",
@@ -320,6 +368,23 @@ func TestNormalizeFlightWithNewFields(t *testing.T) {
 			expectAircraft:  "",
 			expectAmenities: nil,
 		},
+		{
+			name: "flight with connection",
+			flight: BatikAirFlight{
+				FlightNumber:      "ID7042",
+				AirlineName:       "Batik Air",
+				AirlineIATA:       "ID",
+				Origin:            "CGK",
+				Destination:       "DPS",
+				DepartureDateTime: "2025-12-15T18:45:00+0700",
+				ArrivalDateTime:   "2025-12-15T23:50:00+0800",
+				TravelTime:        "3h 5m",
+				NumberOfStops:     1,
+				Connections:       []BatikAirConnection{{StopAirport: "UPG", StopDuration: "55m"}},
+				Fare:              BatikAirFare{TotalPrice: 950000, CurrencyCode: "IDR", Class: "Y"},
+			},
+			expectLayovers: []domain.Layover{{AirportCode: "UPG", DurationMinutes: 55, Overnight: false}},
+		},
 	}
 
 	for _, tt := range tests {
@@ -329,6 +394,7 @@ func TestNormalizeFlightWithNewFields(t *testing.T) {
 			assert.Equal(t, tt.expectSeats, result.AvailableSeats)
 			assert.Equal(t, tt.expectAircraft, result.Aircraft)
 			assert.Equal(t, tt.expectAmenities, result.Amenities)
+			assert.Equal(t, tt.expectLayovers, result.Layovers)
 		})
 	}
 }
@@ -361,3 +427,23 @@ func TestNormalizeWithMixedValidAndInvalid(t *testing.T) {
 	assert.Equal(t, "ID-VALID", result[0].FlightNumber)
 }
 
+func TestBuildLayovers(t *testing.T) {
+	departure := time.Date(2025, 12, 15, 10, 0, 0, 0, time.UTC)
+	arrival := departure.Add(4 * time.Hour)
+
+	tests := []struct {
+		name        string
+		connections []BatikAirConnection
+		expected    []domain.Layover
+	}{
+		{"no connections", nil, nil},
+		{"duration parsed", []BatikAirConnection{{StopAirport: "UPG", StopDuration: "1h 10m"}}, []domain.Layover{{AirportCode: "UPG", DurationMinutes: 70}}},
+		{"invalid duration", []BatikAirConnection{{StopAirport: "UPG", StopDuration: "soon"}}, []domain.Layover{{AirportCode: "UPG", DurationMinutes: 0}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, buildLayovers(tt.connections, departure, arrival))
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package citilink
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var mockDataPath = filepath.Join("..", "..", "..", "..", "external", "response-mock", "citilink_search_response.json")
+
+func TestNewAdapter(t *testing.T) {
+	adapter := NewAdapter("test/path.json", true)
+	assert.NotNil(t, adapter)
+	assert.Equal(t, "test/path.json", adapter.mockDataPath)
+	assert.True(t, adapter.skipSimulation)
+}
+
+func TestAdapterName(t *testing.T) {
+	adapter := NewAdapter("", true)
+	assert.Equal(t, ProviderName, adapter.Name())
+	assert.Equal(t, "citilink", adapter.Name())
+}
+
+func TestAdapterCoverage(t *testing.T) {
+	adapter := NewAdapter("", true)
+
+	assert.True(t, adapter.Coverage().Covers(domain.SearchCriteria{Origin: "CGK", Destination: "DPS", Class: "economy"}))
+	assert.False(t, adapter.Coverage().Covers(domain.SearchCriteria{Origin: "CGK", Destination: "DPS", Class: "business"}))
+}
+
+func TestAdapterSearch(t *testing.T) {
+	tests := []struct {
+		name        string
+		criteria    domain.SearchCriteria
+		wantFlights []string
+	}{
+		{
+			name:        "search all flights",
+			criteria:    domain.SearchCriteria{},
+			wantFlights: []string{"QG680", "QG684", "QG690", "QG712"},
+		},
+		{
+			name:        "search route and date",
+			criteria:    domain.SearchCriteria{Origin: "CGK", Destination: "DPS", DepartureDate: "2025-12-15"},
+			wantFlights: []string{"QG680", "QG684", "QG690"},
+		},
+		{
+			name:        "search other route",
+			criteria:    domain.SearchCriteria{Origin: "CGK", Destination: "SUB"},
+			wantFlights: []string{"QG712"},
+		},
+		{
+			name:        "search other date",
+			criteria:    domain.SearchCriteria{DepartureDate: "2025-12-16"},
+			wantFlights: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adapter := NewAdapter(mockDataPath, true)
+
+			flights, err := adapter.Search(context.Background(), tt.criteria)
+
+			require.NoError(t, err)
+			numbers := make([]string, 0, len(flights))
+			for _, f := range flights {
+				numbers = append(numbers, f.FlightNumber)
+			}
+			assert.Equal(t, tt.wantFlights, numbers)
+		})
+	}
+}
+
+func TestAdapterSearch_MockData(t *testing.T) {
+	adapter := NewAdapter(mockDataPath, true)
+
+	flights, err := adapter.Search(context.Background(), domain.SearchCriteria{})
+
+	require.NoError(t, err)
+	require.Len(t, flights, 4)
+
+	first := flights[0]
+	assert.Equal(t, "2025-12-14T22:40:00Z", first.Departure.DateTime.UTC().Format("2006-01-02T15:04:05Z07:00"))
+	assert.Equal(t, "Asia/Jakarta", first.Departure.Timezone)
+	assert.Equal(t, "Asia/Makassar", first.Arrival.Timezone)
+	assert.Equal(t, domain.BaggageInfo{CabinKg: 7, CheckedKg: 20}, first.Baggage)
+	assert.Equal(t, []string{"snack", "power_outlet"}, first.Amenities)
+
+	connecting := flights[2]
+	assert.Equal(t, 1, connecting.Stops)
+	require.Len(t, connecting.Layovers, 1)
+	assert.Equal(t, "SUB", connecting.Layovers[0].AirportCode)
+	assert.Equal(t, 80, connecting.Layovers[0].DurationMinutes)
+}
+
+func TestAdapterSearchWithInvalidPath(t *testing.T) {
+	adapter := NewAdapter("nonexistent/path.json", true)
+
+	_, err := adapter.Search(context.Background(), domain.SearchCriteria{})
+
+	var providerErr *domain.ProviderError
+	require.ErrorAs(t, err, &providerErr)
+	assert.Equal(t, ProviderName, providerErr.Provider)
+	assert.True(t, providerErr.Retryable)
+}
+
+func TestAdapterSearchWithInvalidJSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "invalid.json")
+	require.NoError(t, os.WriteFile(path, []byte("invalid json"), 0o600))
+	adapter := NewAdapter(path, true)
+
+	_, err := adapter.Search(context.Background(), domain.SearchCriteria{})
+
+	var providerErr *domain.ProviderError
+	require.ErrorAs(t, err, &providerErr)
+	assert.False(t, providerErr.Retryable)
+}
+
+func TestAdapterSearchWithContextCancellation(t *testing.T) {
+	adapter := NewAdapter(mockDataPath, false)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := adapter.Search(ctx, domain.SearchCriteria{})
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestAdapterSearchWithEmptyJourneys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "empty.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"code": 200, "result": {"journeys": []}}`), 0o600))
+	adapter := NewAdapter(path, true)
+
+	flights, err := adapter.Search(context.Background(), domain.SearchCriteria{})
+
+	assert.NoError(t, err)
+	assert.Empty(t, flights)
+}
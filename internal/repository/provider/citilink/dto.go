@@ -0,0 +1,65 @@
+package citilink
+
+// CitilinkResponse represents the root response structure from Citilink API.
+type CitilinkResponse struct {
+	Code    int            `json:"code"`
+	Message string         `json:"message"`
+	Result  CitilinkResult `json:"result"`
+}
+
+// CitilinkResult contains the flight data.
+type CitilinkResult struct {
+	Journeys []CitilinkJourney `json:"journeys"`
+}
+
+// CitilinkJourney represents a single flight from the Citilink API.
+type CitilinkJourney struct {
+	FlightNo        string            `json:"flight_no"`
+	Carrier         CitilinkCarrier   `json:"carrier"`
+	Origin          CitilinkStation   `json:"origin"`
+	Destination     CitilinkStation   `json:"destination"`
+	STD             string            `json:"std"`
+	STA             string            `json:"sta"`
+	DurationMinutes int               `json:"duration_minutes"`
+	Transits        []CitilinkTransit `json:"transits,omitempty"`
+	Fare            CitilinkFare      `json:"fare"`
+	SeatsAvailable  int               `json:"seats_available"`
+	Aircraft        string            `json:"aircraft"`
+	Baggage         CitilinkBaggage   `json:"baggage"`
+	Facilities      []string          `json:"facilities,omitempty"`
+}
+
+// CitilinkCarrier contains carrier information.
+type CitilinkCarrier struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+// CitilinkStation represents a departure or arrival airport.
+type CitilinkStation struct {
+	Code     string `json:"code"`
+	Terminal string `json:"terminal,omitempty"`
+}
+
+// CitilinkTransit represents a stop on a connecting flight.
+type CitilinkTransit struct {
+	Airport         string `json:"airport"`
+	DurationMinutes int    `json:"duration_minutes"`
+}
+
+// CitilinkFare contains pricing information.
+type CitilinkFare struct {
+	BasicFare  float64 `json:"basic_fare"`
+	Tax        float64 `json:"tax"`
+	AdminFee   float64 `json:"admin_fee"`
+	TotalFare  float64 `json:"total_fare"`
+	Currency   string  `json:"currency"`
+	CabinClass string  `json:"cabin_class"`
+}
+
+// CitilinkBaggage contains baggage allowance information.
+// Values are weights in kg.
+type CitilinkBaggage struct {
+	CabinKg   int `json:"cabin_kg"`
+	CheckedKg int `json:"checked_kg"`
+}
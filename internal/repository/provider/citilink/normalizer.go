@@ -0,0 +1,194 @@
+package citilink
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/internal/metrics"
+	"github.com/herdiagusthio/flight-search-system/pkg/airport"
+	"github.com/herdiagusthio/flight-search-system/pkg/util"
+	"github.com/rs/zerolog/log"
+)
+
+// dateTimeLayout is the layout of Citilink schedule times: day first, in the airport's local time.
+const dateTimeLayout = "02/01/2006 15:04"
+
+func normalize(journeys []CitilinkJourney) []domain.Flight {
+	result := make([]domain.Flight, 0, len(journeys))
+	skippedCount := 0
+
+	for _, j := range journeys {
+		normalized, err := normalizeFlight(j)
+		if err != nil {
+			log.Debug().
+				Str("provider", ProviderName).
+				Str("flight_number", j.FlightNo).
+				Err(err).
+				Msg("Failed to normalize flight")
+			skippedCount++
+			continue
+		}
+
+		if err := normalized.Validate(); err != nil {
+			log.Warn().
+				Str("provider", ProviderName).
+				Str("flight_number", normalized.FlightNumber).
+				Err(err).
+				Msg("Flight validation failed")
+			skippedCount++
+			continue
+		}
+
+		result = append(result, normalized)
+	}
+
+	if skippedCount > 0 {
+		metrics.NormalizationSkipped.WithLabelValues(ProviderName).Add(float64(skippedCount))
+		log.Info().
+			Str("provider", ProviderName).
+			Int("skipped", skippedCount).
+			Int("total", len(journeys)).
+			Msg("Skipped invalid flights during normalization")
+	}
+
+	return result
+}
+
+// normalizeFlight converts a single Citilink journey to a domain Flight entity.
+func normalizeFlight(j CitilinkJourney) (domain.Flight, error) {
+	// Schedule times carry no offset, so they are read in the airport's timezone
+	departureTime, err := parseDateTime(j.STD, j.Origin.Code)
+	if err != nil {
+		return domain.Flight{}, fmt.Errorf("failed to parse departure time: %w", err)
+	}
+
+	arrivalTime, err := parseDateTime(j.STA, j.Destination.Code)
+	if err != nil {
+		return domain.Flight{}, fmt.Errorf("failed to parse arrival time: %w", err)
+	}
+
+	// Use the reported duration if available, otherwise derive it from the schedule
+	durationMinutes := j.DurationMinutes
+	if durationMinutes <= 0 {
+		durationMinutes = int(arrivalTime.Sub(departureTime).Minutes())
+	}
+
+	// Use total_fare if available, otherwise calculate from its components
+	totalFare := j.Fare.TotalFare
+	if totalFare == 0 {
+		totalFare = j.Fare.BasicFare + j.Fare.Tax + j.Fare.AdminFee
+	}
+
+	flight := domain.Flight{
+		ID:           j.FlightNo,
+		FlightNumber: j.FlightNo,
+		Airline: domain.AirlineInfo{
+			Code: j.Carrier.Code,
+			Name: j.Carrier.Name,
+		},
+		Departure: domain.FlightPoint{
+			AirportCode: j.Origin.Code,
+			Terminal:    j.Origin.Terminal,
+			DateTime:    departureTime,
+		},
+		Arrival: domain.FlightPoint{
+			AirportCode: j.Destination.Code,
+			Terminal:    j.Destination.Terminal,
+			DateTime:    arrivalTime,
+		},
+		Duration: domain.NewDurationInfo(durationMinutes),
+		Price: domain.PriceInfo{
+			Amount:    totalFare,
+			Currency:  j.Fare.Currency,
+			Formatted: util.FormatCurrency(totalFare, j.Fare.Currency),
+			Breakdown: fareBreakdown(j.Fare, totalFare),
+		},
+		Baggage: domain.BaggageInfo{
+			CabinKg:   j.Baggage.CabinKg,
+			CheckedKg: j.Baggage.CheckedKg,
+		},
+		Class:          normalizeClass(j.Fare.CabinClass),
+		Stops:          len(j.Transits),
+		Provider:       ProviderName,
+		AvailableSeats: j.SeatsAvailable,
+		Aircraft:       j.Aircraft,
+		Amenities:      normalizeFacilities(j.Facilities),
+		Layovers:       buildLayovers(j.Transits, departureTime, arrivalTime),
+	}
+	flight.ApplyReferenceData()
+
+	return flight, nil
+}
+
+// fareBreakdown itemizes a Citilink fare, which reports the basic fare, tax and admin fee.
+// Surcharges are known to be zero only when the components add up to the total;
+// otherwise they stay unknown. A missing basic fare leaves every component unknown.
+func fareBreakdown(fare CitilinkFare, totalFare float64) domain.FareBreakdown {
+	if fare.BasicFare <= 0 {
+		return domain.FareBreakdown{}
+	}
+
+	base, taxes, fees := fare.BasicFare, fare.Tax, fare.AdminFee
+	breakdown := domain.FareBreakdown{BaseFare: &base, Taxes: &taxes, Fees: &fees}
+	if base+taxes+fees == totalFare {
+		var surcharges float64
+		breakdown.Surcharges = &surcharges
+	}
+	return breakdown
+}
+
+// buildLayovers converts Citilink transits to domain layovers.
+// Citilink only reports transit durations, so the itinerary window bounds the overnight check.
+func buildLayovers(transits []CitilinkTransit, departure, arrival time.Time) []domain.Layover {
+	if len(transits) == 0 {
+		return nil
+	}
+
+	layovers := make([]domain.Layover, 0, len(transits))
+	for _, t := range transits {
+		layovers = append(layovers, domain.NewLayoverFromDuration(t.Airport, t.DurationMinutes, departure, arrival, airport.LocationOr(t.Airport, departure.Location())))
+	}
+	return layovers
+}
+
+// parseDateTime parses a Citilink schedule time like "15/12/2025 05:40" in the
+// timezone of the given airport.
+func parseDateTime(dateTime, airportCode string) (time.Time, error) {
+	loc, err := airport.Location(airportCode)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unable to parse datetime %q without timezone: %w", dateTime, err)
+	}
+
+	t, err := time.ParseInLocation(dateTimeLayout, strings.TrimSpace(dateTime), loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unable to parse datetime %q for airport %s: %w", dateTime, airportCode, err)
+	}
+
+	return t, nil
+}
+
+// normalizeFacilities converts Citilink facility names like "Power Outlet" to canonical amenity names.
+func normalizeFacilities(facilities []string) []string {
+	amenities := make([]string, 0, len(facilities))
+	for _, f := range facilities {
+		amenities = append(amenities, domain.NormalizeAmenity(f))
+	}
+	return amenities
+}
+
+// normalizeClass normalizes the class string to lowercase standard values.
+// Citilink only sells economy, so unknown values fall back to it.
+func normalizeClass(class string) string {
+	normalized := strings.ToLower(strings.TrimSpace(class))
+
+	switch normalized {
+	case "business", "c", "j":
+		return "business"
+	case "first", "f":
+		return "first"
+	default:
+		return "economy" // Default to economy if unknown
+	}
+}
@@ -0,0 +1,202 @@
+package citilink
+
+import (
+	"testing"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validJourney() CitilinkJourney {
+	return CitilinkJourney{
+		FlightNo:        "QG680",
+		Carrier:         CitilinkCarrier{Code: "QG", Name: "Citilink"},
+		Origin:          CitilinkStation{Code: "CGK", Terminal: "1C"},
+		Destination:     CitilinkStation{Code: "DPS", Terminal: "D"},
+		STD:             "15/12/2025 05:40",
+		STA:             "15/12/2025 08:35",
+		DurationMinutes: 115,
+		Fare: CitilinkFare{
+			BasicFare:  720000,
+			Tax:        72000,
+			AdminFee:   8000,
+			TotalFare:  800000,
+			Currency:   "IDR",
+			CabinClass: "ECONOMY",
+		},
+		SeatsAvailable: 42,
+		Baggage:        CitilinkBaggage{CabinKg: 7, CheckedKg: 20},
+		Facilities:     []string{"Snack", "Power Outlet"},
+	}
+}
+
+func TestNormalizeFlight(t *testing.T) {
+	flight, err := normalizeFlight(validJourney())
+
+	require.NoError(t, err)
+	assert.Equal(t, "QG680", flight.ID)
+	assert.Equal(t, "QG", flight.Airline.Code)
+	assert.Equal(t, "1C", flight.Departure.Terminal)
+	assert.Equal(t, time.Date(2025, 12, 14, 22, 40, 0, 0, time.UTC), flight.Departure.DateTime.UTC())
+	assert.Equal(t, time.Date(2025, 12, 15, 0, 35, 0, 0, time.UTC), flight.Arrival.DateTime.UTC())
+	assert.Equal(t, 115, flight.Duration.TotalMinutes)
+	assert.Equal(t, float64(800000), flight.Price.Amount)
+	assert.Equal(t, "Rp 800.000", flight.Price.Formatted)
+	assert.Equal(t, domain.BaggageInfo{CabinKg: 7, CheckedKg: 20}, flight.Baggage)
+	assert.Equal(t, "economy", flight.Class)
+	assert.Equal(t, 0, flight.Stops)
+	assert.Equal(t, "citilink", flight.Provider)
+	assert.Equal(t, []string{"snack", "power_outlet"}, flight.Amenities)
+	assert.Nil(t, flight.Layovers)
+}
+
+func TestNormalizeFlight_Variants(t *testing.T) {
+	tests := []struct {
+		name         string
+		modify       func(*CitilinkJourney)
+		expectError  bool
+		wantDuration int
+		wantAmount   float64
+	}{
+		{
+			name:         "valid journey",
+			modify:       func(j *CitilinkJourney) {},
+			wantDuration: 115,
+			wantAmount:   800000,
+		},
+		{
+			name:         "duration derived from schedule",
+			modify:       func(j *CitilinkJourney) { j.DurationMinutes = 0 },
+			wantDuration: 115,
+			wantAmount:   800000,
+		},
+		{
+			name:         "total derived from components",
+			modify:       func(j *CitilinkJourney) { j.Fare.TotalFare = 0 },
+			wantDuration: 115,
+			wantAmount:   800000,
+		},
+		{
+			name:        "ISO departure time",
+			modify:      func(j *CitilinkJourney) { j.STD = "2025-12-15T05:40:00" },
+			expectError: true,
+		},
+		{
+			name:        "month first arrival time",
+			modify:      func(j *CitilinkJourney) { j.STA = "12/15/2025 08:35" },
+			expectError: true,
+		},
+		{
+			name:        "unknown airport",
+			modify:      func(j *CitilinkJourney) { j.Origin.Code = "XXX" },
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := validJourney()
+			tt.modify(&j)
+
+			flight, err := normalizeFlight(j)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantDuration, flight.Duration.TotalMinutes)
+			assert.Equal(t, tt.wantAmount, flight.Price.Amount)
+		})
+	}
+}
+
+func TestFareBreakdown(t *testing.T) {
+	ptr := func(v float64) *float64 { return &v }
+
+	tests := []struct {
+		name  string
+		fare  CitilinkFare
+		total float64
+		want  domain.FareBreakdown
+	}{
+		{
+			name:  "components add up to total",
+			fare:  CitilinkFare{BasicFare: 720000, Tax: 72000, AdminFee: 8000},
+			total: 800000,
+			want:  domain.FareBreakdown{BaseFare: ptr(720000), Taxes: ptr(72000), Fees: ptr(8000), Surcharges: ptr(0)},
+		},
+		{
+			name:  "components short of total",
+			fare:  CitilinkFare{BasicFare: 810000, Tax: 81000, AdminFee: 9000},
+			total: 935000,
+			want:  domain.FareBreakdown{BaseFare: ptr(810000), Taxes: ptr(81000), Fees: ptr(9000)},
+		},
+		{
+			name:  "missing basic fare",
+			fare:  CitilinkFare{Tax: 72000},
+			total: 800000,
+			want:  domain.FareBreakdown{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, fareBreakdown(tt.fare, tt.total))
+		})
+	}
+}
+
+func TestParseDateTime(t *testing.T) {
+	jakarta, _ := time.LoadLocation("Asia/Jakarta")
+
+	got, err := parseDateTime(" 31/12/2025 23:05 ", "CGK")
+
+	require.NoError(t, err)
+	assert.True(t, time.Date(2025, 12, 31, 23, 5, 0, 0, jakarta).Equal(got))
+	assert.Equal(t, "Asia/Jakarta", got.Location().String())
+}
+
+func TestNormalizeClass(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"ECONOMY", "economy"},
+		{"Starter Plus", "economy"},
+		{"business", "business"},
+		{"F", "first"},
+		{"", "economy"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, normalizeClass(tt.input))
+		})
+	}
+}
+
+func TestBuildLayovers(t *testing.T) {
+	departure := time.Date(2025, 12, 15, 7, 0, 0, 0, time.UTC)
+	arrival := departure.Add(270 * time.Minute)
+
+	layovers := buildLayovers([]CitilinkTransit{{Airport: "SUB", DurationMinutes: 80}}, departure, arrival)
+
+	require.Len(t, layovers, 1)
+	assert.Equal(t, "SUB", layovers[0].AirportCode)
+	assert.Equal(t, 80, layovers[0].DurationMinutes)
+	assert.Nil(t, buildLayovers(nil, departure, arrival))
+}
+
+func TestNormalizeWithMixedJourneys(t *testing.T) {
+	invalid := validJourney()
+	invalid.FlightNo = "QG999"
+	invalid.STD = "invalid"
+
+	result := normalize([]CitilinkJourney{validJourney(), invalid})
+
+	require.Len(t, result, 1)
+	assert.Equal(t, "QG680", result[0].FlightNumber)
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/internal/tracing"
 )
 
 const (
@@ -17,6 +18,12 @@ const (
 	DefaultCheckedBaggageKg = 20
 )
 
+// coverage lists the airports and cabin classes served by Garuda Indonesia.
+var coverage = domain.RouteCoverage{
+	Airports: []string{"CGK", "DPS", "SUB", "UPG", "KNO", "LOP", "YIA", "BPN", "SIN"},
+	Classes:  []string{"economy", "business", "first"},
+}
+
 // Adapter implements the domain.FlightProvider interface for Garuda Indonesia.
 // It reads from mock JSON data and normalizes it to the unified Flight domain model.
 type Adapter struct {
@@ -41,6 +48,12 @@ func (a *Adapter) Name() string {
 	return ProviderName
 }
 
+// Coverage returns the airports and cabin classes served by Garuda Indonesia.
+// Implements domain.CoverageProvider.
+func (a *Adapter) Coverage() domain.RouteCoverage {
+	return coverage
+}
+
 // Search queries the provider for available flights matching the criteria.
 // It reads from mock JSON data and returns normalized flight entities.
 // Simulates real-world conditions: Fast response (50-100ms delay).
@@ -94,7 +107,7 @@ func (a *Adapter) Search(ctx context.Context, criteria domain.SearchCriteria) ([
 		return []domain.Flight{}, nil
 	}
 
-	flights := normalize(response.Flights)
+	flights := tracing.Normalize(ctx, ProviderName, response.Flights, normalize)
 	return filterFlights(flights, criteria), nil
 }
 
@@ -71,6 +71,41 @@ func TestAdapterSearch(t *testing.T) {
 	}
 }
 
+func TestAdapterSearch_InternationalRoute(t *testing.T) {
+	mockDataPath := filepath.Join("..", "..", "..", "..", "external", "response-mock", "garuda_indonesia_search_response.json")
+
+	if _, err := os.Stat(mockDataPath); os.IsNotExist(err) {
+		t.Skip("Mock data file not found, skipping integration test")
+	}
+
+	adapter := NewAdapter(mockDataPath, true)
+
+	flights, err := adapter.Search(context.Background(), domain.SearchCriteria{Origin: "CGK", Destination: "SIN"})
+
+	require.NoError(t, err)
+	require.Len(t, flights, 1)
+	assert.True(t, flights[0].International)
+	assert.Equal(t, "SG", flights[0].Arrival.Country)
+	assert.Equal(t, "Asia/Singapore", flights[0].Arrival.Timezone)
+}
+
+func TestAdapterSearch_SegmentsPastDestination(t *testing.T) {
+	mockDataPath := filepath.Join("..", "..", "..", "..", "external", "response-mock", "garuda_indonesia_search_response.json")
+
+	if _, err := os.Stat(mockDataPath); os.IsNotExist(err) {
+		t.Skip("Mock data file not found, skipping integration test")
+	}
+
+	adapter := NewAdapter(mockDataPath, true)
+
+	flights, err := adapter.Search(context.Background(), domain.SearchCriteria{Origin: "CGK", Destination: "SUB"})
+
+	require.NoError(t, err)
+	require.Len(t, flights, 1)
+	assert.Equal(t, "GA315", flights[0].FlightNumber)
+	assert.Empty(t, flights[0].Layovers, "the SUB→DPS continuation is not a layover at SUB")
+}
+
 func TestAdapterSearchWithInvalidPath(t *testing.T) {
 	adapter := NewAdapter("nonexistent/path.json", true)
 	ctx := context.Background()
@@ -174,3 +209,28 @@ func TestFilterFlights(t *testing.T) {
 		})
 	}
 }
+
+func TestAdapterCoverage(t *testing.T) {
+	mockDataPath := filepath.Join("..", "..", "..", "..", "external", "response-mock", "garuda_indonesia_search_response.json")
+
+	if _, err := os.Stat(mockDataPath); os.IsNotExist(err) {
+		t.Skip("Mock data file not found")
+	}
+
+	adapter := NewAdapter(mockDataPath, true)
+	flights, err := adapter.Search(context.Background(), domain.SearchCriteria{})
+	require.NoError(t, err)
+	require.NotEmpty(t, flights)
+
+	coverage := adapter.Coverage()
+	for _, f := range flights {
+		criteria := domain.SearchCriteria{
+			Origin:      f.Departure.AirportCode,
+			Destination: f.Arrival.AirportCode,
+			Class:       f.Class,
+		}
+		assert.True(t, coverage.Covers(criteria), "flight %s must be covered", f.ID)
+	}
+
+	assert.False(t, coverage.Covers(domain.SearchCriteria{Origin: "CGK", Destination: "BKK", Class: "economy"}), "airport not served")
+}
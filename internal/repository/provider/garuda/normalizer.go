@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/internal/metrics"
+	"github.com/herdiagusthio/flight-search-system/pkg/airport"
 	"github.com/herdiagusthio/flight-search-system/pkg/util"
 	"github.com/rs/zerolog/log"
 )
@@ -35,6 +37,7 @@ func normalize(garudaFlights []GarudaFlight) []domain.Flight {
 	}
 
 	if skippedCount > 0 {
+		metrics.NormalizationSkipped.WithLabelValues(ProviderName).Add(float64(skippedCount))
 		log.Info().
 			Str("provider", ProviderName).
 			Int("skipped", skippedCount).
@@ -65,7 +68,7 @@ func normalizeFlight(f GarudaFlight) (domain.Flight, error) {
 		stops = len(f.Segments) - 1
 	}
 
-	return domain.Flight{
+	flight := domain.Flight{
 		ID:           f.FlightID,
 		FlightNumber: f.FlightID, // Use flight_id as flight number since it contains the flight identifier
 		Airline: domain.AirlineInfo{
@@ -74,13 +77,13 @@ func normalizeFlight(f GarudaFlight) (domain.Flight, error) {
 		},
 		Departure: domain.FlightPoint{
 			AirportCode: f.Departure.Airport,
-			AirportName: formatAirportName(f.Departure.Airport, f.Departure.City),
+			City:        f.Departure.City,
 			Terminal:    f.Departure.Terminal,
 			DateTime:    departureTime,
 		},
 		Arrival: domain.FlightPoint{
 			AirportCode: f.Arrival.Airport,
-			AirportName: formatAirportName(f.Arrival.Airport, f.Arrival.City),
+			City:        f.Arrival.City,
 			Terminal:    f.Arrival.Terminal,
 			DateTime:    arrivalTime,
 		},
@@ -88,7 +91,9 @@ func normalizeFlight(f GarudaFlight) (domain.Flight, error) {
 		Price: domain.PriceInfo{
 			Amount:    f.Price.Amount,
 			Currency:  f.Price.Currency,
-			Formatted: util.FormatIDR(f.Price.Amount),
+			Formatted: util.FormatCurrency(f.Price.Amount, f.Price.Currency),
+			// Garuda Indonesia only reports the total fare, so the breakdown components stay unknown
+			Breakdown: domain.FareBreakdown{},
 		},
 		Baggage: domain.BaggageInfo{
 			CabinKg:   f.Baggage.CarryOn * DefaultCabinBaggageKg,
@@ -100,7 +105,41 @@ func normalizeFlight(f GarudaFlight) (domain.Flight, error) {
 		AvailableSeats: f.AvailableSeats,
 		Aircraft:       f.Aircraft,
 		Amenities:      f.Amenities,
-	}, nil
+		Layovers:       buildLayovers(f.Segments, f.Arrival.Airport, departureTime, arrivalTime),
+	}
+	flight.ApplyReferenceData()
+
+	return flight, nil
+}
+
+// buildLayovers derives layovers from consecutive segments up to the one arriving at
+// destination; segments continuing past the flight's destination are not layovers.
+// The layover window is taken from the segment times when they parse,
+// otherwise the segment's layover_minutes is used.
+func buildLayovers(segments []GarudaSegment, destination string, departure, arrival time.Time) []domain.Layover {
+	if len(segments) < 2 {
+		return nil
+	}
+
+	var layovers []domain.Layover
+	for i := 1; i < len(segments); i++ {
+		if segments[i-1].Arrival.Airport == destination {
+			break
+		}
+
+		code := segments[i].Departure.Airport
+		loc := airport.LocationOr(code, departure.Location())
+
+		inbound, errIn := parseDateTime(segments[i-1].Arrival.Time, segments[i-1].Arrival.Airport)
+		onward, errOut := parseDateTime(segments[i].Departure.Time, code)
+		if errIn == nil && errOut == nil && onward.After(inbound) {
+			layovers = append(layovers, domain.NewLayover(code, inbound, onward, loc))
+			continue
+		}
+
+		layovers = append(layovers, domain.NewLayoverFromDuration(code, segments[i].LayoverMinutes, departure, arrival, loc))
+	}
+	return layovers
 }
 
 // parseDateTime parses an ISO 8601 datetime string to time.Time.
@@ -114,8 +153,11 @@ func parseDateTime(dateTime, airportCode string) (time.Time, error) {
 	}
 
 	// Fallback: parse without timezone and use airport's timezone
-	timezone := util.GetTimezoneByAirport(airportCode)
-	t, err = util.ParseInTimezone("2006-01-02T15:04:05", dateTime, timezone)
+	loc, err := airport.Location(airportCode)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unable to parse datetime %q without timezone: %w", dateTime, err)
+	}
+	t, err = time.ParseInLocation("2006-01-02T15:04:05", dateTime, loc)
 	if err != nil {
 		return time.Time{}, fmt.Errorf("unable to parse datetime %q for airport %s: %w", dateTime, airportCode, err)
 	}
@@ -123,14 +165,6 @@ func parseDateTime(dateTime, airportCode string) (time.Time, error) {
 	return t, nil
 }
 
-// formatAirportName creates a formatted airport name from code and city.
-func formatAirportName(code, city string) string {
-	if city == "" {
-		return code
-	}
-	return fmt.Sprintf("%s (%s)", city, code)
-}
-
 // normalizeClass normalizes the class string to lowercase standard values.
 func normalizeClass(class string) string {
 	normalized := strings.ToLower(strings.TrimSpace(class))
@@ -3,6 +3,7 @@ package garuda
 import (
 	"testing"
 
+	"github.com/herdiagusthio/flight-search-system/domain"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -152,17 +153,21 @@ func TestParseDateTime(t *testing.T) {
 	tests := []struct {
 		name        string
 		input       string
+		airport     string
 		expectError bool
 	}{
-		{"RFC3339 format", "2025-12-15T10:30:00+07:00", false},
-		{"without timezone", "2025-12-15T10:30:00", false},
-		{"invalid format", "not-a-date", true},
-		{"empty string", "", true},
+		{"RFC3339 format", "2025-12-15T10:30:00+07:00", "CGK", false},
+		{"without timezone", "2025-12-15T10:30:00", "CGK", false},
+		{"invalid format", "not-a-date", "CGK", true},
+		{"empty string", "", "CGK", true},
+		{"without timezone at unknown airport", "2025-12-15T06:00:00", "XYZ", true},
+		// Times with an explicit offset do not need the airport timezone
+		{"RFC3339 format at unknown airport", "2025-12-15T06:00:00+07:00", "XYZ", false},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := parseDateTime(tt.input, "CGK") // Use CGK as default test airport
+			_, err := parseDateTime(tt.input, tt.airport)
 			if tt.expectError {
 				assert.Error(t, err)
 			} else {
@@ -172,24 +177,6 @@ func TestParseDateTime(t *testing.T) {
 	}
 }
 
-func TestFormatAirportName(t *testing.T) {
-	tests := []struct {
-		code     string
-		city     string
-		expected string
-	}{
-		{"CGK", "Jakarta", "Jakarta (CGK)"},
-		{"DPS", "", "DPS"},
-		{"SUB", "Surabaya", "Surabaya (SUB)"},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.expected, func(t *testing.T) {
-			assert.Equal(t, tt.expected, formatAirportName(tt.code, tt.city))
-		})
-	}
-}
-
 func TestNormalizeClass(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -220,45 +207,45 @@ func TestNormalizeClass(t *testing.T) {
 
 func TestNormalizeFlightWithNewFields(t *testing.T) {
 	tests := []struct {
-		name           string
-		flight         GarudaFlight
-		expectSeats    int
-		expectAircraft string
+		name            string
+		flight          GarudaFlight
+		expectSeats     int
+		expectAircraft  string
 		expectAmenities []string
 	}{
 		{
 			name: "flight with all new fields",
 			flight: GarudaFlight{
-				FlightID:       "GA-NEW",
-				Airline:        "Garuda Indonesia",
-				AirlineCode:    "GA",
-				Departure:      GarudaEndpoint{Airport: "CGK", Time: "2025-12-15T10:00:00+07:00"},
-				Arrival:        GarudaEndpoint{Airport: "DPS", Time: "2025-12-15T12:00:00+08:00"},
+				FlightID:        "GA-NEW",
+				Airline:         "Garuda Indonesia",
+				AirlineCode:     "GA",
+				Departure:       GarudaEndpoint{Airport: "CGK", Time: "2025-12-15T10:00:00+07:00"},
+				Arrival:         GarudaEndpoint{Airport: "DPS", Time: "2025-12-15T12:00:00+08:00"},
 				DurationMinutes: 120,
-				Price:          GarudaPrice{Amount: 1000000, Currency: "IDR"},
-				FareClass:      "Y",
-				AvailableSeats: 45,
-				Aircraft:       "Boeing 737-800",
-				Amenities:      []string{"wifi", "meal", "entertainment"},
+				Price:           GarudaPrice{Amount: 1000000, Currency: "IDR"},
+				FareClass:       "Y",
+				AvailableSeats:  45,
+				Aircraft:        "Boeing 737-800",
+				Amenities:       []string{"wifi", "meal", "entertainment"},
 			},
-			expectSeats:    45,
-			expectAircraft: "Boeing 737-800",
+			expectSeats:     45,
+			expectAircraft:  "Boeing 737-800",
 			expectAmenities: []string{"wifi", "meal", "entertainment"},
 		},
 		{
 			name: "flight without optional fields",
 			flight: GarudaFlight{
-				FlightID:       "GA-BASIC",
-				Airline:        "Garuda Indonesia",
-				AirlineCode:    "GA",
-				Departure:      GarudaEndpoint{Airport: "CGK", Time: "2025-12-15T10:00:00+07:00"},
-				Arrival:        GarudaEndpoint{Airport: "DPS", Time: "2025-12-15T12:00:00+08:00"},
+				FlightID:        "GA-BASIC",
+				Airline:         "Garuda Indonesia",
+				AirlineCode:     "GA",
+				Departure:       GarudaEndpoint{Airport: "CGK", Time: "2025-12-15T10:00:00+07:00"},
+				Arrival:         GarudaEndpoint{Airport: "DPS", Time: "2025-12-15T12:00:00+08:00"},
 				DurationMinutes: 120,
-				Price:          GarudaPrice{Amount: 1000000, Currency: "IDR"},
-				FareClass:      "Y",
+				Price:           GarudaPrice{Amount: 1000000, Currency: "IDR"},
+				FareClass:       "Y",
 			},
-			expectSeats:    0,
-			expectAircraft: "",
+			expectSeats:     0,
+			expectAircraft:  "",
 			expectAmenities: nil,
 		},
 	}
@@ -304,14 +291,14 @@ func TestNormalizeWithValidationFailure(t *testing.T) {
 func TestNormalizeWithMixedFlights(t *testing.T) {
 	flights := []GarudaFlight{
 		{
-			FlightID:    "GA-VALID",
-			Airline:     "Garuda Indonesia",
-			AirlineCode: "GA",
-			Departure:   GarudaEndpoint{Airport: "CGK", Time: "2025-12-15T06:00:00+07:00"},
-			Arrival:     GarudaEndpoint{Airport: "DPS", Time: "2025-12-15T08:00:00+08:00"},
+			FlightID:        "GA-VALID",
+			Airline:         "Garuda Indonesia",
+			AirlineCode:     "GA",
+			Departure:       GarudaEndpoint{Airport: "CGK", Time: "2025-12-15T06:00:00+07:00"},
+			Arrival:         GarudaEndpoint{Airport: "DPS", Time: "2025-12-15T08:00:00+08:00"},
 			DurationMinutes: 120,
-			Price:       GarudaPrice{Amount: 1000000, Currency: "IDR"},
-			FareClass:   "Y",
+			Price:           GarudaPrice{Amount: 1000000, Currency: "IDR"},
+			FareClass:       "Y",
 		},
 		{
 			FlightID:  "GA-INVALID",
@@ -327,3 +314,108 @@ func TestNormalizeWithMixedFlights(t *testing.T) {
 	assert.Equal(t, "GA-VALID", result[0].FlightNumber)
 }
 
+func TestBuildLayovers(t *testing.T) {
+	departure, _ := parseDateTime("2025-12-15T20:00:00+07:00", "CGK")
+	arrival, _ := parseDateTime("2025-12-16T09:00:00+08:00", "DPS")
+
+	tests := []struct {
+		name        string
+		segments    []GarudaSegment
+		destination string // DPS if empty
+		expected    []domain.Layover
+	}{
+		{
+			name:     "single segment has no layovers",
+			segments: []GarudaSegment{{}},
+			expected: nil,
+		},
+		{
+			name: "layover computed from segment times",
+			segments: []GarudaSegment{
+				{
+					Departure: GarudaSegmentPoint{Airport: "CGK", Time: "2025-12-15T20:00:00+07:00"},
+					Arrival:   GarudaSegmentPoint{Airport: "SUB", Time: "2025-12-15T21:30:00+07:00"},
+				},
+				{
+					Departure:      GarudaSegmentPoint{Airport: "SUB", Time: "2025-12-15T23:00:00+07:00"},
+					Arrival:        GarudaSegmentPoint{Airport: "DPS", Time: "2025-12-16T01:00:00+08:00"},
+					LayoverMinutes: 90,
+				},
+			},
+			expected: []domain.Layover{{AirportCode: "SUB", DurationMinutes: 90, Overnight: false}},
+		},
+		{
+			name: "layover spanning local midnight is overnight",
+			segments: []GarudaSegment{
+				{Arrival: GarudaSegmentPoint{Airport: "SUB", Time: "2025-12-15T23:30:00+07:00"}},
+				{Departure: GarudaSegmentPoint{Airport: "SUB", Time: "2025-12-16T06:00:00+07:00"}},
+			},
+			expected: []domain.Layover{{AirportCode: "SUB", DurationMinutes: 390, Overnight: true}},
+		},
+		{
+			name: "falls back to layover minutes when times are missing",
+			segments: []GarudaSegment{
+				{Arrival: GarudaSegmentPoint{Airport: "SUB"}},
+				{Departure: GarudaSegmentPoint{Airport: "SUB"}, LayoverMinutes: 105},
+			},
+			// Itinerary window crosses midnight, so the layover is conservatively overnight
+			expected: []domain.Layover{{AirportCode: "SUB", DurationMinutes: 105, Overnight: true}},
+		},
+		{
+			// GA315 from the mock response flies CGK→SUB with a SUB→DPS continuation
+			name: "segments past the destination are not layovers",
+			segments: []GarudaSegment{
+				{
+					FlightNumber: "GA315",
+					Departure:    GarudaSegmentPoint{Airport: "CGK", Time: "2025-12-15T14:00:00+07:00"},
+					Arrival:      GarudaSegmentPoint{Airport: "SUB", Time: "2025-12-15T15:30:00+07:00"},
+				},
+				{
+					FlightNumber:   "GA332",
+					Departure:      GarudaSegmentPoint{Airport: "SUB", Time: "2025-12-15T17:15:00+07:00"},
+					Arrival:        GarudaSegmentPoint{Airport: "DPS", Time: "2025-12-15T18:45:00+08:00"},
+					LayoverMinutes: 105,
+				},
+			},
+			destination: "SUB",
+			expected:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			destination := tt.destination
+			if destination == "" {
+				destination = "DPS"
+			}
+			assert.Equal(t, tt.expected, buildLayovers(tt.segments, destination, departure, arrival))
+		})
+	}
+}
+
+func TestNormalizeFlight_AirportReference(t *testing.T) {
+	flight := GarudaFlight{
+		FlightID:        "GA400",
+		Airline:         "Garuda Indonesia",
+		AirlineCode:     "GA",
+		Departure:       GarudaEndpoint{Airport: "CGK", City: "Jakarta", Time: "2025-12-15T06:00:00"},
+		Arrival:         GarudaEndpoint{Airport: "SIN", City: "Singapore", Time: "2025-12-15T08:50:00"},
+		DurationMinutes: 110,
+		Price:           GarudaPrice{Amount: 1250000, Currency: "IDR"},
+		FareClass:       "economy",
+	}
+
+	result, err := normalizeFlight(flight)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Soekarno-Hatta International Airport", result.Departure.AirportName)
+	assert.Equal(t, "Jakarta", result.Departure.City)
+	assert.Equal(t, "Asia/Jakarta", result.Departure.Timezone)
+
+	// Naive times are parsed in each airport's own timezone
+	assert.Equal(t, "Singapore Changi Airport", result.Arrival.AirportName)
+	assert.Equal(t, "SG", result.Arrival.Country)
+	assert.Equal(t, "Asia/Singapore", result.Arrival.Timezone)
+	_, offset := result.Arrival.DateTime.Zone()
+	assert.Equal(t, 8*3600, offset)
+}
@@ -0,0 +1,156 @@
+package generic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/internal/tracing"
+)
+
+// Adapter implements the domain.FlightProvider interface for any provider described
+// by a Mapping. It reads from mock JSON data and normalizes it to the unified Flight
+// domain model as declared by the mapping.
+type Adapter struct {
+	// mapping declares the provider's response format.
+	mapping Mapping
+	// mockDataPath is the path to the mock JSON data file.
+	mockDataPath string
+	// skipSimulation disables delay and failure simulation for deterministic testing.
+	skipSimulation bool
+}
+
+// NewAdapter creates a new adapter for the provider described by the mapping.
+// The mockDataPath parameter specifies the path to the mock JSON data file.
+func NewAdapter(mapping Mapping, mockDataPath string, skipSimulation bool) *Adapter {
+	return &Adapter{
+		mapping:        mapping,
+		mockDataPath:   mockDataPath,
+		skipSimulation: skipSimulation,
+	}
+}
+
+// Name returns the provider name declared by the mapping.
+// Implements domain.FlightProvider.
+func (a *Adapter) Name() string {
+	return a.mapping.Provider
+}
+
+// Coverage returns the route coverage declared by the mapping.
+// Implements domain.CoverageProvider.
+func (a *Adapter) Coverage() domain.RouteCoverage {
+	return a.mapping.Coverage
+}
+
+// Search queries the provider for available flights matching the criteria.
+// It reads from mock JSON data and returns normalized flight entities.
+// Simulates the latency and failure rate declared by the mapping.
+// Implements domain.FlightProvider.
+func (a *Adapter) Search(ctx context.Context, criteria domain.SearchCriteria) ([]domain.Flight, error) {
+	provider := a.mapping.Provider
+	sim := a.mapping.Simulation
+
+	if !a.skipSimulation {
+		delay := time.Duration(sim.MinDelayMs+rand.Intn(sim.MaxDelayMs-sim.MinDelayMs+1)) * time.Millisecond
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return nil, &domain.ProviderError{
+				Provider:  provider,
+				Err:       ctx.Err(),
+				Retryable: false,
+			}
+		}
+
+		if rand.Float64() < sim.FailureRate {
+			return nil, &domain.ProviderError{
+				Provider:  provider,
+				Err:       errors.New("simulated API timeout or temporary unavailability"),
+				Retryable: true,
+			}
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, &domain.ProviderError{
+			Provider:  provider,
+			Err:       ctx.Err(),
+			Retryable: false,
+		}
+	default:
+	}
+
+	data, err := os.ReadFile(a.mockDataPath)
+	if err != nil {
+		return nil, &domain.ProviderError{
+			Provider:  provider,
+			Err:       fmt.Errorf("failed to read mock data: %w", err),
+			Retryable: true,
+		}
+	}
+
+	var response any
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, &domain.ProviderError{
+			Provider:  provider,
+			Err:       fmt.Errorf("failed to parse JSON: %w", err),
+			Retryable: false,
+		}
+	}
+
+	flights, ok := lookup(response, a.mapping.Flights)
+	if !ok {
+		return []domain.Flight{}, nil
+	}
+	items, ok := flights.([]any)
+	if !ok {
+		return nil, &domain.ProviderError{
+			Provider:  provider,
+			Err:       fmt.Errorf("flights at %q is not an array", a.mapping.Flights),
+			Retryable: false,
+		}
+	}
+
+	if len(items) == 0 {
+		return []domain.Flight{}, nil
+	}
+
+	normalized := tracing.Normalize(ctx, provider, items, func(items []any) []domain.Flight {
+		return normalize(a.mapping, items)
+	})
+	return filterFlights(normalized, criteria), nil
+}
+
+func filterFlights(flights []domain.Flight, criteria domain.SearchCriteria) []domain.Flight {
+	result := make([]domain.Flight, 0, len(flights))
+
+	for _, f := range flights {
+		if criteria.Origin != "" && f.Departure.AirportCode != criteria.Origin {
+			continue
+		}
+		if criteria.Destination != "" && f.Arrival.AirportCode != criteria.Destination {
+			continue
+		}
+		if criteria.DepartureDate != "" {
+			flightDate := f.Departure.DateTime.Format("2006-01-02")
+			if flightDate != criteria.DepartureDate {
+				continue
+			}
+		}
+		if criteria.Class != "" && f.Class != criteria.Class {
+			continue
+		}
+		result = append(result, f)
+	}
+
+	return result
+}
@@ -0,0 +1,129 @@
+package generic
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/internal/repository/provider/lionair"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	lionAirMappingPath  = filepath.Join("..", "..", "..", "..", "external", "provider-mapping", "lion_air.yaml")
+	lionAirMockDataPath = filepath.Join("..", "..", "..", "..", "external", "response-mock", "lion_air_search_response.json")
+)
+
+func loadLionAirMapping(t *testing.T) Mapping {
+	t.Helper()
+	m, err := LoadMapping(lionAirMappingPath)
+	require.NoError(t, err)
+	return m
+}
+
+// writeMockData writes a mock response to a temporary file and returns its path.
+func writeMockData(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "response.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestAdapterReproducesLionAir(t *testing.T) {
+	m := loadLionAirMapping(t)
+	generic := NewAdapter(m, lionAirMockDataPath, true)
+	handWritten := lionair.NewAdapter(lionAirMockDataPath, true)
+
+	criteria := []domain.SearchCriteria{
+		{},
+		{Origin: "CGK", Destination: "DPS", DepartureDate: "2025-12-15"},
+		{Origin: "CGK", Destination: "DPS", DepartureDate: "2025-12-15", Class: "economy"},
+		{Origin: "CGK", Destination: "SUB"},
+	}
+
+	for i, c := range criteria {
+		want, err := handWritten.Search(context.Background(), c)
+		require.NoError(t, err)
+		if i == 0 {
+			require.NotEmpty(t, want)
+		}
+
+		got, err := generic.Search(context.Background(), c)
+		require.NoError(t, err)
+
+		assert.Equal(t, want, got, "criteria %+v", c)
+	}
+
+	assert.Equal(t, handWritten.Name(), generic.Name())
+	assert.Equal(t, handWritten.Coverage(), generic.Coverage())
+}
+
+func TestAdapterSearchWithInvalidPath(t *testing.T) {
+	adapter := NewAdapter(loadLionAirMapping(t), "nonexistent.json", true)
+
+	_, err := adapter.Search(context.Background(), domain.SearchCriteria{})
+
+	var providerErr *domain.ProviderError
+	require.ErrorAs(t, err, &providerErr)
+	assert.Equal(t, "lion_air", providerErr.Provider)
+	assert.True(t, providerErr.Retryable)
+}
+
+func TestAdapterSearchWithInvalidResponse(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+		wantLen int
+	}{
+		{name: "invalid JSON", content: "invalid", wantErr: true},
+		{name: "flights not an array", content: `{"data": {"available_flights": {}}}`, wantErr: true},
+		{name: "missing flights", content: `{"success": true, "data": {}}`},
+		{name: "empty flights", content: `{"success": true, "data": {"available_flights": []}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adapter := NewAdapter(loadLionAirMapping(t), writeMockData(t, tt.content), true)
+
+			flights, err := adapter.Search(context.Background(), domain.SearchCriteria{})
+
+			if tt.wantErr {
+				var providerErr *domain.ProviderError
+				require.ErrorAs(t, err, &providerErr)
+				assert.False(t, providerErr.Retryable)
+				return
+			}
+			require.NoError(t, err)
+			assert.Empty(t, flights)
+		})
+	}
+}
+
+func TestAdapterSearchWithContextTimeout(t *testing.T) {
+	m := loadLionAirMapping(t)
+	adapter := NewAdapter(m, lionAirMockDataPath, false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	_, err := adapter.Search(ctx, domain.SearchCriteria{})
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestAdapterSimulatedFailure(t *testing.T) {
+	m := loadLionAirMapping(t)
+	m.Simulation = SimulationMapping{FailureRate: 1}
+	adapter := NewAdapter(m, lionAirMockDataPath, false)
+
+	_, err := adapter.Search(context.Background(), domain.SearchCriteria{})
+
+	var providerErr *domain.ProviderError
+	require.ErrorAs(t, err, &providerErr)
+	assert.True(t, providerErr.Retryable)
+}
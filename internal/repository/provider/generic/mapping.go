@@ -0,0 +1,344 @@
+// Package generic implements a flight provider adapter configured by a mapping file
+// instead of hand-written DTOs and normalizers. The mapping declares where each
+// domain.Flight field is found in the provider's JSON response and how times,
+// durations, baggage, classes and stops are interpreted.
+package generic
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/pkg/util"
+	"gopkg.in/yaml.v3"
+)
+
+// Duration units.
+const (
+	UnitMinutes = "minutes"
+	UnitHours   = "hours"
+	UnitText    = "text" // Such as "1h 45m"
+)
+
+// Baggage units of bare numbers.
+const (
+	UnitKg     = "kg"
+	UnitPieces = "pieces"
+)
+
+// Default weights of a baggage piece.
+const (
+	DefaultCabinPieceKg   = 7
+	DefaultCheckedPieceKg = 20
+)
+
+// DefaultTimeFormats are tried when a mapping declares no time formats: RFC 3339
+// with and without colon in the offset, then without offset.
+var DefaultTimeFormats = []string{
+	"2006-01-02T15:04:05Z07:00",
+	"2006-01-02T15:04:05-0700",
+	"2006-01-02T15:04:05",
+}
+
+// canonicalClasses are the cabin classes flights are normalized to.
+var canonicalClasses = map[string]bool{
+	"economy":  true,
+	"business": true,
+	"first":    true,
+}
+
+// Mapping declares how a provider's JSON response maps onto domain.Flight.
+//
+// Field values are paths into a flight object of the response, such as
+// "route.from.code" or "segments[0].departure.time" (see lookup), or templates
+// combining paths and literal text, such as "{carrier.iata}-{id}". An empty
+// path leaves the field unset.
+type Mapping struct {
+	Provider string `json:"provider"`  // Provider name, unique among providers
+	MockData string `json:"mock_data"` // Mock response file served by the adapter
+	Flights  string `json:"flights"`   // Path from the response root to the array of flights
+
+	ID           string `json:"id"`
+	FlightNumber string `json:"flight_number"`
+	AirlineCode  string `json:"airline_code"` // Defaults to the first two characters of the flight number
+	AirlineName  string `json:"airline_name"`
+	Aircraft     string `json:"aircraft"`
+	Seats        string `json:"seats"`
+
+	Departure PointMapping    `json:"departure"`
+	Arrival   PointMapping    `json:"arrival"`
+	Times     TimeMapping     `json:"times"`
+	Duration  DurationMapping `json:"duration"`
+	Price     PriceMapping    `json:"price"`
+	Baggage   BaggageMapping  `json:"baggage"`
+	Class     ClassMapping    `json:"class"`
+	Stops     StopMapping     `json:"stops"`
+	Layovers  LayoverMapping  `json:"layovers"`
+	Amenities AmenityMapping  `json:"amenities"`
+
+	Coverage   domain.RouteCoverage `json:"coverage"`   // Routes, airports and classes served; unrestricted if empty
+	Simulation SimulationMapping    `json:"simulation"` // Simulated latency and failures of the mock provider
+}
+
+// PointMapping declares the fields of a departure or arrival point.
+type PointMapping struct {
+	Airport     string `json:"airport"`
+	AirportName string `json:"airport_name"`
+	City        string `json:"city"`
+	Terminal    string `json:"terminal"`
+	Time        string `json:"time"`
+	Timezone    string `json:"timezone"` // IANA timezone of Time, if the provider reports it separately
+}
+
+// TimeMapping declares how departure and arrival times are parsed.
+//
+// Times with an offset keep it. Times without an offset are read in the point's
+// timezone field if it resolves, otherwise in the airport's timezone if
+// AirportTimezone is set, otherwise in DefaultTimezone. Flights whose times
+// cannot be placed in a timezone are skipped.
+type TimeMapping struct {
+	Formats         []string `json:"formats"`          // Go time layouts tried in order; DefaultTimeFormats if empty
+	AirportTimezone bool     `json:"airport_timezone"` // Fall back to the airport's timezone
+	DefaultTimezone string   `json:"default_timezone"` // Last fallback, such as "UTC"
+}
+
+// DurationMapping declares where a duration is found and its unit.
+type DurationMapping struct {
+	Path string `json:"path"` // Flight durations are computed from the times if empty
+	Unit string `json:"unit"` // UnitMinutes (default), UnitHours or UnitText
+}
+
+// PriceMapping declares the total fare and its breakdown.
+// If the mapped components add up to the total, unmapped components are known
+// to be zero; otherwise they stay unknown. A missing total is the sum of the
+// mapped components.
+type PriceMapping struct {
+	Amount          string `json:"amount"`
+	Currency        string `json:"currency"`
+	DefaultCurrency string `json:"default_currency"` // Used if Currency is unmapped or empty
+	BaseFare        string `json:"base_fare"`
+	Taxes           string `json:"taxes"`
+	Fees            string `json:"fees"`
+	Surcharges      string `json:"surcharges"`
+}
+
+// BaggageMapping declares the cabin and checked baggage allowances.
+// Strings such as "7 kg" or "2 pcs" carry their own unit; bare numbers are in Unit.
+// Unparseable allowances are zero.
+type BaggageMapping struct {
+	Cabin          string `json:"cabin"`
+	Checked        string `json:"checked"`
+	Unit           string `json:"unit"`             // UnitKg (default) or UnitPieces
+	CabinPieceKg   int    `json:"cabin_piece_kg"`   // DefaultCabinPieceKg if zero
+	CheckedPieceKg int    `json:"checked_piece_kg"` // DefaultCheckedPieceKg if zero
+}
+
+// ClassMapping declares the cabin class and how provider values are normalized.
+type ClassMapping struct {
+	Path    string            `json:"path"`
+	Aliases map[string]string `json:"aliases"` // Lowercase provider values mapped to canonical classes
+	Default string            `json:"default"` // Class of unknown values, "economy" if empty
+}
+
+// StopMapping declares how the number of stops is derived: zero if the direct
+// flag is true, otherwise the stop count if positive, otherwise the number of
+// layovers.
+type StopMapping struct {
+	Direct string `json:"direct"`
+	Count  string `json:"count"`
+}
+
+// LayoverMapping declares the array of layovers and their fields, relative to a layover.
+type LayoverMapping struct {
+	Path     string          `json:"path"`
+	Airport  string          `json:"airport"`
+	Duration DurationMapping `json:"duration"`
+}
+
+// AmenityMapping declares the amenities of a flight: the names listed at List,
+// followed by the amenities whose flag is true.
+type AmenityMapping struct {
+	List  string        `json:"list"`
+	Flags []AmenityFlag `json:"flags"`
+}
+
+// AmenityFlag maps a boolean field to an amenity.
+type AmenityFlag struct {
+	Amenity string `json:"amenity"`
+	Path    string `json:"path"`
+}
+
+// SimulationMapping declares the simulated behavior of the mock provider.
+type SimulationMapping struct {
+	MinDelayMs  int     `json:"min_delay_ms"`
+	MaxDelayMs  int     `json:"max_delay_ms"`
+	FailureRate float64 `json:"failure_rate"` // Share of searches failing with a retryable error
+}
+
+// LoadMapping reads a mapping file, in YAML if its extension is .yaml or .yml and
+// in JSON otherwise, and validates it. Unknown fields are rejected.
+func LoadMapping(path string) (Mapping, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Mapping{}, fmt.Errorf("failed to read mapping: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		// Decode YAML generically and re-encode it, so that the json tags apply to both formats
+		var doc any
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return Mapping{}, fmt.Errorf("failed to parse YAML mapping: %w", err)
+		}
+		if data, err = json.Marshal(doc); err != nil {
+			return Mapping{}, fmt.Errorf("failed to convert YAML mapping: %w", err)
+		}
+	}
+
+	var m Mapping
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&m); err != nil {
+		return Mapping{}, fmt.Errorf("failed to parse mapping: %w", err)
+	}
+
+	if err := m.Validate(); err != nil {
+		return Mapping{}, err
+	}
+	return m, nil
+}
+
+// Validate checks that the mapping declares the required fields and that its paths,
+// units, timezones and classes are valid.
+func (m Mapping) Validate() error {
+	var errs []error
+	require := func(name, expr string) {
+		if expr == "" {
+			errs = append(errs, fmt.Errorf("%s is required", name))
+		}
+	}
+	check := func(name, expr string) {
+		if err := checkExpression(expr); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+
+	require("provider", m.Provider)
+	require("flights", m.Flights)
+	require("departure.airport", m.Departure.Airport)
+	require("departure.time", m.Departure.Time)
+	require("arrival.airport", m.Arrival.Airport)
+	require("arrival.time", m.Arrival.Time)
+	if m.Price.Amount == "" && m.Price.BaseFare == "" {
+		errs = append(errs, errors.New("price.amount or price.base_fare is required"))
+	}
+	if m.Price.Currency == "" && m.Price.DefaultCurrency == "" {
+		errs = append(errs, errors.New("price.currency or price.default_currency is required"))
+	}
+
+	for _, field := range []struct{ name, expr string }{
+		{"flights", m.Flights},
+		{"id", m.ID},
+		{"flight_number", m.FlightNumber},
+		{"airline_code", m.AirlineCode},
+		{"airline_name", m.AirlineName},
+		{"aircraft", m.Aircraft},
+		{"seats", m.Seats},
+		{"departure.airport", m.Departure.Airport},
+		{"departure.airport_name", m.Departure.AirportName},
+		{"departure.city", m.Departure.City},
+		{"departure.terminal", m.Departure.Terminal},
+		{"departure.time", m.Departure.Time},
+		{"departure.timezone", m.Departure.Timezone},
+		{"arrival.airport", m.Arrival.Airport},
+		{"arrival.airport_name", m.Arrival.AirportName},
+		{"arrival.city", m.Arrival.City},
+		{"arrival.terminal", m.Arrival.Terminal},
+		{"arrival.time", m.Arrival.Time},
+		{"arrival.timezone", m.Arrival.Timezone},
+		{"duration.path", m.Duration.Path},
+		{"price.amount", m.Price.Amount},
+		{"price.currency", m.Price.Currency},
+		{"price.base_fare", m.Price.BaseFare},
+		{"price.taxes", m.Price.Taxes},
+		{"price.fees", m.Price.Fees},
+		{"price.surcharges", m.Price.Surcharges},
+		{"baggage.cabin", m.Baggage.Cabin},
+		{"baggage.checked", m.Baggage.Checked},
+		{"class.path", m.Class.Path},
+		{"stops.direct", m.Stops.Direct},
+		{"stops.count", m.Stops.Count},
+		{"layovers.path", m.Layovers.Path},
+		{"layovers.airport", m.Layovers.Airport},
+		{"layovers.duration.path", m.Layovers.Duration.Path},
+		{"amenities.list", m.Amenities.List},
+	} {
+		check(field.name, field.expr)
+	}
+	for i, flag := range m.Amenities.Flags {
+		if flag.Amenity == "" {
+			errs = append(errs, fmt.Errorf("amenities.flags[%d].amenity is required", i))
+		}
+		require(fmt.Sprintf("amenities.flags[%d].path", i), flag.Path)
+		check(fmt.Sprintf("amenities.flags[%d].path", i), flag.Path)
+	}
+
+	if m.Layovers.Path != "" && (m.Layovers.Airport == "" || m.Layovers.Duration.Path == "") {
+		errs = append(errs, errors.New("layovers.airport and layovers.duration.path are required with layovers.path"))
+	}
+	for _, d := range []struct {
+		name string
+		unit string
+	}{{"duration.unit", m.Duration.Unit}, {"layovers.duration.unit", m.Layovers.Duration.Unit}} {
+		if d.unit != "" && d.unit != UnitMinutes && d.unit != UnitHours && d.unit != UnitText {
+			errs = append(errs, fmt.Errorf("%s must be one of %s, %s, %s; got %q", d.name, UnitMinutes, UnitHours, UnitText, d.unit))
+		}
+	}
+	if u := m.Baggage.Unit; u != "" && u != UnitKg && u != UnitPieces {
+		errs = append(errs, fmt.Errorf("baggage.unit must be %s or %s; got %q", UnitKg, UnitPieces, u))
+	}
+	if m.Baggage.CabinPieceKg < 0 || m.Baggage.CheckedPieceKg < 0 {
+		errs = append(errs, errors.New("baggage piece weights must not be negative"))
+	}
+
+	if tz := m.Times.DefaultTimezone; tz != "" {
+		if _, err := util.GetLocation(tz); err != nil {
+			errs = append(errs, fmt.Errorf("times.default_timezone: %w", err))
+		}
+	}
+
+	if d := m.Class.Default; d != "" && !canonicalClasses[d] {
+		errs = append(errs, fmt.Errorf("class.default must be economy, business or first; got %q", d))
+	}
+	for alias, class := range m.Class.Aliases {
+		if !canonicalClasses[class] {
+			errs = append(errs, fmt.Errorf("class.aliases[%s] must be economy, business or first; got %q", alias, class))
+		}
+	}
+
+	s := m.Simulation
+	if s.MinDelayMs < 0 || s.MaxDelayMs < s.MinDelayMs {
+		errs = append(errs, fmt.Errorf("simulation delays must satisfy 0 <= min_delay_ms <= max_delay_ms; got %d and %d", s.MinDelayMs, s.MaxDelayMs))
+	}
+	if s.FailureRate < 0 || s.FailureRate > 1 {
+		errs = append(errs, fmt.Errorf("simulation.failure_rate must be between 0 and 1; got %g", s.FailureRate))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid mapping for provider %q: %w", m.Provider, errors.Join(errs...))
+	}
+	return nil
+}
+
+// timeFormats returns the time layouts to try, in order.
+func (t TimeMapping) timeFormats() []string {
+	if len(t.Formats) == 0 {
+		return DefaultTimeFormats
+	}
+	return t.Formats
+}
@@ -0,0 +1,158 @@
+package generic
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// validMapping returns a minimal valid mapping.
+func validMapping() Mapping {
+	return Mapping{
+		Provider:  "test_air",
+		Flights:   "flights",
+		Departure: PointMapping{Airport: "from", Time: "depart"},
+		Arrival:   PointMapping{Airport: "to", Time: "arrive"},
+		Price:     PriceMapping{Amount: "price", DefaultCurrency: "IDR"},
+	}
+}
+
+func TestLoadMapping(t *testing.T) {
+	t.Run("lion air YAML", func(t *testing.T) {
+		m := loadLionAirMapping(t)
+
+		assert.Equal(t, "lion_air", m.Provider)
+		assert.Equal(t, "data.available_flights", m.Flights)
+		assert.Equal(t, "schedule.departure_timezone", m.Departure.Timezone)
+		assert.Equal(t, []string{"2006-01-02T15:04:05", "2006-01-02 15:04:05"}, m.Times.Formats)
+		assert.Equal(t, "business", m.Class.Aliases["biz"])
+		assert.Equal(t, []AmenityFlag{{"wifi", "services.wifi_available"}, {"meal", "services.meals_included"}}, m.Amenities.Flags)
+		assert.Equal(t, []string{"economy", "business"}, m.Coverage.Classes)
+		assert.Equal(t, SimulationMapping{MinDelayMs: 100, MaxDelayMs: 200}, m.Simulation)
+	})
+
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		want    Mapping
+		wantErr string
+	}{
+		{
+			name: "JSON",
+			file: "mapping.json",
+			content: `{
+				"provider": "test_air", "flights": "flights",
+				"departure": {"airport": "from", "time": "depart"},
+				"arrival": {"airport": "to", "time": "arrive"},
+				"price": {"amount": "price", "default_currency": "IDR"},
+				"coverage": {"routes": [{"origin": "CGK", "destination": "DPS"}]}
+			}`,
+			want: func() Mapping {
+				m := validMapping()
+				m.Coverage = domain.RouteCoverage{Routes: []domain.Route{{Origin: "CGK", Destination: "DPS"}}}
+				return m
+			}(),
+		},
+		{
+			name: "YAML",
+			file: "mapping.yml",
+			content: `
+provider: test_air
+flights: flights
+departure: {airport: from, time: depart}
+arrival: {airport: to, time: arrive}
+price: {amount: price, default_currency: IDR}
+`,
+			want: validMapping(),
+		},
+		{
+			name:    "unknown field",
+			file:    "mapping.json",
+			content: `{"provider": "test_air", "flight": "flights"}`,
+			wantErr: `unknown field "flight"`,
+		},
+		{
+			name:    "invalid YAML",
+			file:    "mapping.yaml",
+			content: "provider: [",
+			wantErr: "failed to parse YAML mapping",
+		},
+		{
+			name:    "invalid mapping",
+			file:    "mapping.json",
+			content: `{"provider": "test_air"}`,
+			wantErr: "flights is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+
+			m, err := LoadMapping(path)
+
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, m)
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := LoadMapping("nonexistent.yaml")
+		assert.ErrorContains(t, err, "failed to read mapping")
+	})
+}
+
+func TestMappingValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*Mapping)
+		wantErr string
+	}{
+		{name: "valid mapping"},
+		{name: "valid template", modify: func(m *Mapping) { m.ID = "{carrier}-{number}" }},
+		{name: "base fare instead of amount", modify: func(m *Mapping) { m.Price.Amount = ""; m.Price.BaseFare = "fare.base" }},
+		{name: "missing provider", modify: func(m *Mapping) { m.Provider = "" }, wantErr: "provider is required"},
+		{name: "missing departure time", modify: func(m *Mapping) { m.Departure.Time = "" }, wantErr: "departure.time is required"},
+		{name: "missing price", modify: func(m *Mapping) { m.Price.Amount = "" }, wantErr: "price.amount or price.base_fare is required"},
+		{name: "missing currency", modify: func(m *Mapping) { m.Price.DefaultCurrency = "" }, wantErr: "price.currency or price.default_currency is required"},
+		{name: "invalid path", modify: func(m *Mapping) { m.Seats = "seats[x]" }, wantErr: `seats: invalid index "x"`},
+		{name: "unclosed placeholder", modify: func(m *Mapping) { m.ID = "{carrier-{number}" }, wantErr: "id:"},
+		{name: "invalid duration unit", modify: func(m *Mapping) { m.Duration.Unit = "days" }, wantErr: "duration.unit must be one of"},
+		{name: "invalid baggage unit", modify: func(m *Mapping) { m.Baggage.Unit = "lbs" }, wantErr: "baggage.unit must be kg or pieces"},
+		{name: "layovers without airport", modify: func(m *Mapping) { m.Layovers.Path = "stops" }, wantErr: "layovers.airport and layovers.duration.path are required"},
+		{name: "unknown default timezone", modify: func(m *Mapping) { m.Times.DefaultTimezone = "Mars/Olympus" }, wantErr: "times.default_timezone"},
+		{name: "invalid class alias", modify: func(m *Mapping) { m.Class.Aliases = map[string]string{"w": "premium"} }, wantErr: "class.aliases[w]"},
+		{name: "amenity flag without name", modify: func(m *Mapping) { m.Amenities.Flags = []AmenityFlag{{Path: "wifi"}} }, wantErr: "amenities.flags[0].amenity is required"},
+		{name: "inverted delays", modify: func(m *Mapping) { m.Simulation.MinDelayMs = 100 }, wantErr: "simulation delays"},
+		{name: "failure rate above 1", modify: func(m *Mapping) { m.Simulation.FailureRate = 1.5 }, wantErr: "simulation.failure_rate"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := validMapping()
+			if tt.modify != nil {
+				tt.modify(&m)
+			}
+
+			err := m.Validate()
+
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
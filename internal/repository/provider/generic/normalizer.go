@@ -0,0 +1,378 @@
+package generic
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/internal/metrics"
+	"github.com/herdiagusthio/flight-search-system/pkg/airport"
+	"github.com/herdiagusthio/flight-search-system/pkg/util"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	durationTextRegex = regexp.MustCompile(`^(?:(\d+)\s*h)?\s*(?:(\d+)\s*m)?$`)
+	baggageRegex      = regexp.MustCompile(`^(\d+(?:\.\d+)?)\s*(kgs?|pcs?|pieces?)?$`)
+)
+
+func normalize(m Mapping, flights []any) []domain.Flight {
+	result := make([]domain.Flight, 0, len(flights))
+	skippedCount := 0
+
+	for _, f := range flights {
+		normalized, err := normalizeFlight(m, f)
+		if err != nil {
+			log.Debug().
+				Str("provider", m.Provider).
+				Err(err).
+				Msg("Failed to normalize flight")
+			skippedCount++
+			continue
+		}
+
+		if err := normalized.Validate(); err != nil {
+			log.Warn().
+				Str("provider", m.Provider).
+				Str("flight_number", normalized.FlightNumber).
+				Err(err).
+				Msg("Flight validation failed")
+			skippedCount++
+			continue
+		}
+
+		result = append(result, normalized)
+	}
+
+	if skippedCount > 0 {
+		metrics.NormalizationSkipped.WithLabelValues(m.Provider).Add(float64(skippedCount))
+		log.Info().
+			Str("provider", m.Provider).
+			Int("skipped", skippedCount).
+			Int("total", len(flights)).
+			Msg("Skipped invalid flights during normalization")
+	}
+
+	return result
+}
+
+// normalizeFlight converts a single flight object of the response to a domain Flight
+// entity as declared by the mapping.
+func normalizeFlight(m Mapping, f any) (domain.Flight, error) {
+	departureTime, err := parsePointTime(m, f, m.Departure)
+	if err != nil {
+		return domain.Flight{}, fmt.Errorf("failed to parse departure time: %w", err)
+	}
+
+	arrivalTime, err := parsePointTime(m, f, m.Arrival)
+	if err != nil {
+		return domain.Flight{}, fmt.Errorf("failed to parse arrival time: %w", err)
+	}
+
+	durationMinutes := int(arrivalTime.Sub(departureTime).Minutes())
+	if m.Duration.Path != "" {
+		if durationMinutes, err = durationAt(f, m.Duration); err != nil {
+			return domain.Flight{}, fmt.Errorf("failed to parse duration: %w", err)
+		}
+	}
+
+	flightNumber := stringAt(f, m.FlightNumber)
+	airlineCode := stringAt(f, m.AirlineCode)
+	if airlineCode == "" && len(flightNumber) >= 2 {
+		airlineCode = strings.ToUpper(flightNumber[:2])
+	}
+
+	layovers := buildLayovers(m, f, departureTime, arrivalTime)
+
+	flight := domain.Flight{
+		ID:           stringAt(f, m.ID),
+		FlightNumber: flightNumber,
+		Airline: domain.AirlineInfo{
+			Code: airlineCode,
+			Name: stringAt(f, m.AirlineName),
+		},
+		Departure:      buildPoint(f, m.Departure, departureTime),
+		Arrival:        buildPoint(f, m.Arrival, arrivalTime),
+		Duration:       domain.NewDurationInfo(durationMinutes),
+		Price:          buildPrice(f, m.Price),
+		Baggage:        buildBaggage(f, m.Baggage),
+		Class:          normalizeClass(stringAt(f, m.Class.Path), m.Class),
+		Stops:          countStops(f, m.Stops, len(layovers)),
+		Provider:       m.Provider,
+		AvailableSeats: intAt(f, m.Seats),
+		Aircraft:       stringAt(f, m.Aircraft),
+		Amenities:      buildAmenities(f, m.Amenities),
+		Layovers:       layovers,
+	}
+	flight.ApplyReferenceData()
+
+	return flight, nil
+}
+
+// parsePointTime parses the time of a departure or arrival point with the mapping's
+// time formats and timezone rules.
+func parsePointTime(m Mapping, f any, point PointMapping) (time.Time, error) {
+	value := stringAt(f, point.Time)
+	if value == "" {
+		return time.Time{}, fmt.Errorf("missing time at %q", point.Time)
+	}
+
+	for _, layout := range m.Times.timeFormats() {
+		if hasOffset(layout) {
+			if t, err := time.Parse(layout, value); err == nil {
+				return t, nil
+			}
+			continue
+		}
+		if _, err := time.Parse(layout, value); err != nil {
+			continue
+		}
+
+		loc, err := pointLocation(m.Times, stringAt(f, point.Timezone), stringAt(f, point.Airport))
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unable to place datetime %q in a timezone: %w", value, err)
+		}
+		return time.ParseInLocation(layout, value, loc)
+	}
+
+	return time.Time{}, fmt.Errorf("unable to parse datetime %q", value)
+}
+
+// hasOffset reports whether a Go time layout includes a zone offset or abbreviation.
+func hasOffset(layout string) bool {
+	return strings.Contains(layout, "Z07") || strings.Contains(layout, "-07") || strings.Contains(layout, "MST")
+}
+
+// pointLocation returns the timezone of a time without offset: the reported timezone
+// if it loads, then the airport's timezone if enabled, then the default timezone.
+func pointLocation(t TimeMapping, timezone, airportCode string) (*time.Location, error) {
+	if timezone != "" {
+		if loc, err := util.GetLocation(timezone); err == nil {
+			return loc, nil
+		}
+	}
+	if t.AirportTimezone {
+		if loc, err := airport.Location(airportCode); err == nil {
+			return loc, nil
+		}
+	}
+	if t.DefaultTimezone != "" {
+		return util.GetLocation(t.DefaultTimezone)
+	}
+	return nil, fmt.Errorf("no timezone for airport %q", airportCode)
+}
+
+// buildPoint builds a departure or arrival point. The reported timezone is kept only if it loads.
+func buildPoint(f any, point PointMapping, dateTime time.Time) domain.FlightPoint {
+	timezone := stringAt(f, point.Timezone)
+	if timezone != "" {
+		if _, err := util.GetLocation(timezone); err != nil {
+			timezone = ""
+		}
+	}
+
+	return domain.FlightPoint{
+		AirportCode: stringAt(f, point.Airport),
+		AirportName: stringAt(f, point.AirportName),
+		City:        stringAt(f, point.City),
+		Terminal:    stringAt(f, point.Terminal),
+		DateTime:    dateTime,
+		Timezone:    timezone,
+	}
+}
+
+// durationAt returns the duration at the mapped path in minutes.
+func durationAt(f any, d DurationMapping) (int, error) {
+	switch d.Unit {
+	case UnitText:
+		return parseDurationText(stringAt(f, d.Path))
+	case UnitHours:
+		hours, ok := numberAt(f, d.Path)
+		if !ok {
+			return 0, fmt.Errorf("missing duration at %q", d.Path)
+		}
+		return int(math.Round(hours * 60)), nil
+	default:
+		minutes, ok := numberAt(f, d.Path)
+		if !ok {
+			return 0, fmt.Errorf("missing duration at %q", d.Path)
+		}
+		return int(math.Round(minutes)), nil
+	}
+}
+
+// parseDurationText parses a duration such as "2h 15m", "1h" or "45m" to minutes.
+func parseDurationText(text string) (int, error) {
+	matches := durationTextRegex.FindStringSubmatch(strings.ToLower(strings.TrimSpace(text)))
+	if matches == nil || (matches[1] == "" && matches[2] == "") {
+		return 0, fmt.Errorf("invalid duration format: %q", text)
+	}
+
+	hours, _ := strconv.Atoi(matches[1])
+	minutes, _ := strconv.Atoi(matches[2])
+	return hours*60 + minutes, nil
+}
+
+// buildPrice builds the price and its breakdown.
+func buildPrice(f any, p PriceMapping) domain.PriceInfo {
+	currency := stringAt(f, p.Currency)
+	if currency == "" {
+		currency = p.DefaultCurrency
+	}
+
+	var breakdown domain.FareBreakdown
+	var known float64
+	var mapped, found int
+	for _, c := range []struct {
+		path   string
+		amount **float64
+	}{
+		{p.BaseFare, &breakdown.BaseFare},
+		{p.Taxes, &breakdown.Taxes},
+		{p.Fees, &breakdown.Fees},
+		{p.Surcharges, &breakdown.Surcharges},
+	} {
+		if c.path == "" {
+			continue
+		}
+		mapped++
+		if v, ok := numberAt(f, c.path); ok {
+			*c.amount = &v
+			known += v
+			found++
+		}
+	}
+
+	amount, ok := numberAt(f, p.Amount)
+	if !ok || amount <= 0 {
+		amount = known
+	}
+
+	// Components the provider does not report are zero if the reported ones make up the total
+	if found > 0 && found == mapped && known == amount {
+		for _, c := range []**float64{&breakdown.BaseFare, &breakdown.Taxes, &breakdown.Fees, &breakdown.Surcharges} {
+			if *c == nil {
+				var zero float64
+				*c = &zero
+			}
+		}
+	}
+
+	return domain.PriceInfo{
+		Amount:    amount,
+		Currency:  currency,
+		Formatted: util.FormatCurrency(amount, currency),
+		Breakdown: breakdown,
+	}
+}
+
+// buildBaggage builds the baggage allowance.
+func buildBaggage(f any, b BaggageMapping) domain.BaggageInfo {
+	cabinPieceKg, checkedPieceKg := b.CabinPieceKg, b.CheckedPieceKg
+	if cabinPieceKg == 0 {
+		cabinPieceKg = DefaultCabinPieceKg
+	}
+	if checkedPieceKg == 0 {
+		checkedPieceKg = DefaultCheckedPieceKg
+	}
+
+	return domain.BaggageInfo{
+		CabinKg:   baggageKg(f, b.Cabin, b.Unit, cabinPieceKg),
+		CheckedKg: baggageKg(f, b.Checked, b.Unit, checkedPieceKg),
+	}
+}
+
+// baggageKg returns the allowance at path in kg. Strings such as "7 kg" or "2 pcs"
+// carry their own unit; bare numbers are in unit. Returns 0 if unparseable.
+func baggageKg(f any, path, unit string, pieceKg int) int {
+	if path == "" {
+		return 0
+	}
+
+	matches := baggageRegex.FindStringSubmatch(strings.ToLower(strings.TrimSpace(stringAt(f, path))))
+	if matches == nil {
+		return 0
+	}
+	value, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil {
+		return 0
+	}
+
+	pieces := unit == UnitPieces
+	if matches[2] != "" {
+		pieces = !strings.HasPrefix(matches[2], "kg")
+	}
+	if pieces {
+		value *= float64(pieceKg)
+	}
+	return int(math.Round(value))
+}
+
+// normalizeClass normalizes a provider class to a canonical class: aliases first,
+// then canonical values as they are, then the default class.
+func normalizeClass(class string, c ClassMapping) string {
+	normalized := strings.ToLower(strings.TrimSpace(class))
+
+	if alias, ok := c.Aliases[normalized]; ok {
+		return alias
+	}
+	if canonicalClasses[normalized] {
+		return normalized
+	}
+	if c.Default != "" {
+		return c.Default
+	}
+	return "economy"
+}
+
+// countStops derives the number of stops: zero for direct flights, otherwise the
+// stop count if positive, otherwise the number of layovers.
+func countStops(f any, s StopMapping, layoverCount int) int {
+	if direct, ok := boolAt(f, s.Direct); ok && direct {
+		return 0
+	}
+	if count := intAt(f, s.Count); count > 0 {
+		return count
+	}
+	return layoverCount
+}
+
+// buildLayovers builds the layovers of a flight. Providers only report layover
+// durations, so the itinerary window bounds the overnight check.
+func buildLayovers(m Mapping, f any, departure, arrival time.Time) []domain.Layover {
+	items := arrayAt(f, m.Layovers.Path)
+	if len(items) == 0 {
+		return nil
+	}
+
+	layovers := make([]domain.Layover, 0, len(items))
+	for _, item := range items {
+		code := stringAt(item, m.Layovers.Airport)
+		minutes, err := durationAt(item, m.Layovers.Duration)
+		if err != nil {
+			minutes = 0
+		}
+		layovers = append(layovers, domain.NewLayoverFromDuration(code, minutes, departure, arrival, airport.LocationOr(code, departure.Location())))
+	}
+	return layovers
+}
+
+// buildAmenities lists the amenities named at the list path followed by the flagged ones.
+func buildAmenities(f any, a AmenityMapping) []string {
+	amenities := []string{}
+	for _, item := range arrayAt(f, a.List) {
+		if name := toString(item); name != "" {
+			amenities = append(amenities, name)
+		}
+	}
+	for _, flag := range a.Flags {
+		if set, ok := boolAt(f, flag.Path); ok && set {
+			amenities = append(amenities, flag.Amenity)
+		}
+	}
+	return amenities
+}
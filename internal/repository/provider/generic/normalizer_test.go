@@ -0,0 +1,343 @@
+package generic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func floatPtr(v float64) *float64 { return &v }
+
+func TestNormalizeFlight_AirAsiaStyle(t *testing.T) {
+	m := validMapping()
+	m.ID = "test_air-{code}-{from}-{to}"
+	m.FlightNumber = "code"
+	m.AirlineName = "airline"
+	m.Seats = "seats"
+	m.Duration = DurationMapping{Path: "hours", Unit: UnitHours}
+	m.Stops = StopMapping{Direct: "direct"}
+	m.Layovers = LayoverMapping{Path: "stops", Airport: "airport", Duration: DurationMapping{Path: "wait"}}
+
+	f := decode(t, `{
+		"code": "QZ7250", "airline": "AirAsia", "from": "CGK", "to": "DPS",
+		"depart": "2025-12-15T15:15:00+07:00", "arrive": "2025-12-15T20:35:00+08:00",
+		"hours": 4.33, "direct": false, "stops": [{"airport": "SOC", "wait": 95}],
+		"price": 485000, "seats": 88
+	}`)
+
+	flight, err := normalizeFlight(m, f)
+
+	require.NoError(t, err)
+	assert.Equal(t, "test_air-QZ7250-CGK-DPS", flight.ID)
+	assert.Equal(t, "QZ", flight.Airline.Code, "airline code from the flight number")
+	assert.Equal(t, time.Date(2025, 12, 15, 8, 15, 0, 0, time.UTC), flight.Departure.DateTime.UTC())
+	assert.Equal(t, 260, flight.Duration.TotalMinutes)
+	assert.Equal(t, domain.PriceInfo{Amount: 485000, Currency: "IDR", Formatted: "Rp 485.000"}, flight.Price)
+	assert.Equal(t, 1, flight.Stops)
+	require.Len(t, flight.Layovers, 1)
+	assert.Equal(t, "SOC", flight.Layovers[0].AirportCode)
+	assert.Equal(t, 95, flight.Layovers[0].DurationMinutes)
+	assert.Equal(t, 88, flight.AvailableSeats)
+	assert.Equal(t, "economy", flight.Class)
+	assert.Equal(t, "test_air", flight.Provider)
+	assert.Equal(t, []string{}, flight.Amenities)
+}
+
+func TestNormalizeFlight_Times(t *testing.T) {
+	jakarta, _ := time.LoadLocation("Asia/Jakarta")
+	makassar, _ := time.LoadLocation("Asia/Makassar")
+
+	tests := []struct {
+		name    string
+		times   TimeMapping
+		depart  string
+		tz      string
+		from    string
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name:   "offset kept",
+			depart: "2025-12-15T06:00:00+08:00",
+			from:   "CGK",
+			want:   time.Date(2025, 12, 14, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "offset without colon",
+			depart: "2025-12-15T06:00:00+0700",
+			from:   "CGK",
+			want:   time.Date(2025, 12, 14, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "reported timezone",
+			depart: "2025-12-15T06:00:00",
+			tz:     "Asia/Makassar",
+			from:   "CGK",
+			want:   time.Date(2025, 12, 15, 6, 0, 0, 0, makassar),
+		},
+		{
+			name:   "airport timezone",
+			times:  TimeMapping{AirportTimezone: true},
+			depart: "2025-12-15T06:00:00",
+			tz:     "Invalid/Zone",
+			from:   "CGK",
+			want:   time.Date(2025, 12, 15, 6, 0, 0, 0, jakarta),
+		},
+		{
+			name:   "default timezone for unknown airport",
+			times:  TimeMapping{AirportTimezone: true, DefaultTimezone: "UTC"},
+			depart: "2025-12-15T06:00:00",
+			from:   "XXX",
+			want:   time.Date(2025, 12, 15, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "no timezone",
+			depart:  "2025-12-15T06:00:00",
+			from:    "CGK",
+			wantErr: true,
+		},
+		{
+			name:   "custom format",
+			times:  TimeMapping{Formats: []string{"02/01/2006 15:04", time.RFC3339}, AirportTimezone: true},
+			depart: "15/12/2025 06:00",
+			from:   "CGK",
+			want:   time.Date(2025, 12, 15, 6, 0, 0, 0, jakarta),
+		},
+		{
+			name:    "unparseable",
+			depart:  "15 December 2025",
+			from:    "CGK",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := validMapping()
+			m.Times = tt.times
+			m.Departure.Timezone = "tz"
+			f := map[string]any{
+				"from": tt.from, "to": "DPS", "depart": tt.depart, "tz": tt.tz,
+				"arrive": "2025-12-16T12:00:00+08:00", "price": 500000.0,
+			}
+
+			flight, err := normalizeFlight(m, f)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(flight.Departure.DateTime), "got %v, want %v", flight.Departure.DateTime, tt.want)
+		})
+	}
+}
+
+func TestParseDurationText(t *testing.T) {
+	tests := []struct {
+		text    string
+		want    int
+		wantErr bool
+	}{
+		{text: "1h 45m", want: 105},
+		{text: "2h", want: 120},
+		{text: "45m", want: 45},
+		{text: "3H15M", want: 195},
+		{text: "", wantErr: true},
+		{text: "1 hour", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := parseDurationText(tt.text)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBuildPrice(t *testing.T) {
+	tests := []struct {
+		name    string
+		mapping PriceMapping
+		fare    string
+		want    domain.PriceInfo
+	}{
+		{
+			name:    "total only",
+			mapping: PriceMapping{Amount: "total", Currency: "currency"},
+			fare:    `{"total": 1250000, "currency": "IDR"}`,
+			want:    domain.PriceInfo{Amount: 1250000, Currency: "IDR", Formatted: "Rp 1.250.000"},
+		},
+		{
+			name:    "components make up the total",
+			mapping: PriceMapping{Amount: "total", DefaultCurrency: "IDR", BaseFare: "base", Taxes: "taxes"},
+			fare:    `{"total": 1100000, "base": 1000000, "taxes": 100000}`,
+			want: domain.PriceInfo{Amount: 1100000, Currency: "IDR", Formatted: "Rp 1.100.000", Breakdown: domain.FareBreakdown{
+				BaseFare: floatPtr(1000000), Taxes: floatPtr(100000), Fees: floatPtr(0), Surcharges: floatPtr(0),
+			}},
+		},
+		{
+			name:    "components short of the total",
+			mapping: PriceMapping{Amount: "total", DefaultCurrency: "IDR", BaseFare: "base", Taxes: "taxes"},
+			fare:    `{"total": 1150000, "base": 1000000, "taxes": 100000}`,
+			want: domain.PriceInfo{Amount: 1150000, Currency: "IDR", Formatted: "Rp 1.150.000", Breakdown: domain.FareBreakdown{
+				BaseFare: floatPtr(1000000), Taxes: floatPtr(100000),
+			}},
+		},
+		{
+			name:    "missing total",
+			mapping: PriceMapping{Amount: "total", Currency: "currency", DefaultCurrency: "IDR", BaseFare: "base", Taxes: "taxes"},
+			fare:    `{"base": 1000000, "taxes": 100000}`,
+			want: domain.PriceInfo{Amount: 1100000, Currency: "IDR", Formatted: "Rp 1.100.000", Breakdown: domain.FareBreakdown{
+				BaseFare: floatPtr(1000000), Taxes: floatPtr(100000), Fees: floatPtr(0), Surcharges: floatPtr(0),
+			}},
+		},
+		{
+			name:    "missing component",
+			mapping: PriceMapping{Amount: "total", DefaultCurrency: "IDR", BaseFare: "base", Taxes: "taxes"},
+			fare:    `{"total": 1000000, "base": 1000000}`,
+			want: domain.PriceInfo{Amount: 1000000, Currency: "IDR", Formatted: "Rp 1.000.000", Breakdown: domain.FareBreakdown{
+				BaseFare: floatPtr(1000000),
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, buildPrice(decode(t, tt.fare), tt.mapping))
+		})
+	}
+}
+
+func TestBuildBaggage(t *testing.T) {
+	tests := []struct {
+		name    string
+		mapping BaggageMapping
+		baggage string
+		want    domain.BaggageInfo
+	}{
+		{
+			name:    "strings in kg",
+			mapping: BaggageMapping{Cabin: "cabin", Checked: "hold"},
+			baggage: `{"cabin": "7 kg", "hold": "20KG"}`,
+			want:    domain.BaggageInfo{CabinKg: 7, CheckedKg: 20},
+		},
+		{
+			name:    "bare numbers in kg",
+			mapping: BaggageMapping{Cabin: "cabin", Checked: "hold"},
+			baggage: `{"cabin": 7, "hold": 15}`,
+			want:    domain.BaggageInfo{CabinKg: 7, CheckedKg: 15},
+		},
+		{
+			name:    "bare numbers in pieces",
+			mapping: BaggageMapping{Cabin: "cabin", Checked: "hold", Unit: UnitPieces},
+			baggage: `{"cabin": 1, "hold": 2}`,
+			want:    domain.BaggageInfo{CabinKg: 7, CheckedKg: 40},
+		},
+		{
+			name:    "custom piece weights",
+			mapping: BaggageMapping{Cabin: "cabin", Checked: "hold", Unit: UnitPieces, CabinPieceKg: 10, CheckedPieceKg: 23},
+			baggage: `{"cabin": 1, "hold": 1}`,
+			want:    domain.BaggageInfo{CabinKg: 10, CheckedKg: 23},
+		},
+		{
+			name:    "strings carry their unit",
+			mapping: BaggageMapping{Cabin: "cabin", Checked: "hold", Unit: UnitKg},
+			baggage: `{"cabin": "1 pc", "hold": "2 pieces"}`,
+			want:    domain.BaggageInfo{CabinKg: 7, CheckedKg: 40},
+		},
+		{
+			name:    "unparseable and missing",
+			mapping: BaggageMapping{Cabin: "cabin", Checked: "hold"},
+			baggage: `{"cabin": "Cabin baggage only"}`,
+			want:    domain.BaggageInfo{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, buildBaggage(decode(t, tt.baggage), tt.mapping))
+		})
+	}
+}
+
+func TestNormalizeClass(t *testing.T) {
+	c := ClassMapping{Aliases: map[string]string{"y": "economy", "c": "business"}, Default: "economy"}
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"Y", "economy"},
+		{" c ", "business"},
+		{"FIRST", "first"},
+		{"premium", "economy"},
+		{"", "economy"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.want, normalizeClass(tt.input, c))
+		})
+	}
+
+	assert.Equal(t, "business", normalizeClass("unknown", ClassMapping{Default: "business"}))
+	assert.Equal(t, "economy", normalizeClass("unknown", ClassMapping{}))
+}
+
+func TestCountStops(t *testing.T) {
+	s := StopMapping{Direct: "direct", Count: "stops"}
+
+	tests := []struct {
+		name     string
+		flight   string
+		layovers int
+		want     int
+	}{
+		{"direct", `{"direct": true, "stops": 1}`, 1, 0},
+		{"stop count", `{"direct": false, "stops": 2}`, 1, 2},
+		{"layovers without count", `{"direct": false}`, 1, 1},
+		{"no information", `{}`, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, countStops(decode(t, tt.flight), s, tt.layovers))
+		})
+	}
+}
+
+func TestBuildAmenities(t *testing.T) {
+	a := AmenityMapping{
+		List:  "services",
+		Flags: []AmenityFlag{{Amenity: "wifi", Path: "wifi"}, {Amenity: "meal", Path: "meal"}},
+	}
+
+	got := buildAmenities(decode(t, `{"services": ["entertainment", "usb"], "wifi": false, "meal": true}`), a)
+
+	assert.Equal(t, []string{"entertainment", "usb", "meal"}, got)
+}
+
+func TestNormalizeSkipsInvalidFlights(t *testing.T) {
+	m := validMapping()
+	m.FlightNumber = "code"
+	flights := []any{
+		decode(t, `{"code": "JT100", "from": "CGK", "to": "DPS", "depart": "2025-12-15T06:00:00+07:00", "arrive": "2025-12-15T08:50:00+08:00", "price": 500000}`),
+		decode(t, `{"code": "JT101", "from": "CGK", "to": "DPS", "depart": "invalid", "arrive": "2025-12-15T08:50:00+08:00", "price": 500000}`),
+		decode(t, `{"code": "JT102", "from": "CGK", "depart": "2025-12-15T06:00:00+07:00", "arrive": "2025-12-15T08:50:00+08:00", "price": 500000}`),
+	}
+
+	result := normalize(m, flights)
+
+	require.Len(t, result, 1)
+	assert.Equal(t, 110, result[0].Duration.TotalMinutes, "duration computed from the times")
+}
@@ -0,0 +1,207 @@
+package generic
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// step is one element of a path: an object key, or an array index if key is empty.
+type step struct {
+	key   string
+	index int
+}
+
+// parsePath parses a JSONPath-like path of dot-separated object keys and bracketed
+// array indexes, such as "data.flights[0].departure.time". A leading "$" or "$."
+// denotes the root and is optional.
+func parsePath(path string) ([]step, error) {
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+	if path == "" {
+		return nil, nil
+	}
+
+	var steps []step
+	for _, part := range strings.Split(path, ".") {
+		key, rest, hasIndex := strings.Cut(part, "[")
+		if key == "" && !hasIndex {
+			return nil, fmt.Errorf("empty key in path %q", path)
+		}
+		if key != "" {
+			steps = append(steps, step{key: key})
+		}
+		for hasIndex {
+			index, after, ok := strings.Cut(rest, "]")
+			if !ok {
+				return nil, fmt.Errorf("unclosed bracket in path %q", path)
+			}
+			i, err := strconv.Atoi(index)
+			if err != nil || i < 0 {
+				return nil, fmt.Errorf("invalid index %q in path %q", index, path)
+			}
+			steps = append(steps, step{index: i})
+			if after == "" {
+				break
+			}
+			if !strings.HasPrefix(after, "[") {
+				return nil, fmt.Errorf("unexpected %q after index in path %q", after, path)
+			}
+			rest = after[1:]
+		}
+	}
+	return steps, nil
+}
+
+// lookup returns the value at path in a decoded JSON document.
+// Returns false if the path is empty or invalid, or does not exist in the document.
+func lookup(doc any, path string) (any, bool) {
+	if path == "" {
+		return nil, false
+	}
+	steps, err := parsePath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	v := doc
+	for _, s := range steps {
+		switch node := v.(type) {
+		case map[string]any:
+			next, found := node[s.key]
+			if s.key == "" || !found || next == nil {
+				return nil, false
+			}
+			v = next
+		case []any:
+			if s.key != "" || s.index >= len(node) {
+				return nil, false
+			}
+			v = node[s.index]
+		default:
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+// isTemplate reports whether an expression is a template rather than a path.
+func isTemplate(expr string) bool {
+	return strings.Contains(expr, "{")
+}
+
+// checkExpression validates a path or template expression.
+func checkExpression(expr string) error {
+	if !isTemplate(expr) {
+		_, err := parsePath(expr)
+		return err
+	}
+
+	rest := expr
+	for {
+		start := strings.Index(rest, "{")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(rest[start:], "}")
+		if end < 0 {
+			return fmt.Errorf("unclosed placeholder in template %q", expr)
+		}
+		placeholder := rest[start+1 : start+end]
+		if placeholder == "" || strings.Contains(placeholder, "{") {
+			return fmt.Errorf("invalid placeholder in template %q", expr)
+		}
+		if _, err := parsePath(placeholder); err != nil {
+			return err
+		}
+		rest = rest[start+end+1:]
+	}
+	return nil
+}
+
+// stringAt returns the string at a path, or the template expanded with the values at
+// its placeholders. Numbers and booleans are formatted; missing values are empty.
+func stringAt(doc any, expr string) string {
+	if !isTemplate(expr) {
+		v, _ := lookup(doc, expr)
+		return toString(v)
+	}
+
+	var b strings.Builder
+	rest := expr
+	for {
+		start := strings.Index(rest, "{")
+		end := strings.Index(rest, "}")
+		if start < 0 || end < start {
+			b.WriteString(rest)
+			return b.String()
+		}
+		b.WriteString(rest[:start])
+		v, _ := lookup(doc, rest[start+1:end])
+		b.WriteString(toString(v))
+		rest = rest[end+1:]
+	}
+}
+
+// numberAt returns the number at a path. Numeric strings are parsed.
+// Returns false if the value is missing or not a number.
+func numberAt(doc any, path string) (float64, bool) {
+	v, ok := lookup(doc, path)
+	if !ok {
+		return 0, false
+	}
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(n), 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// intAt returns the number at a path rounded to an integer, or 0 if missing.
+func intAt(doc any, path string) int {
+	n, _ := numberAt(doc, path)
+	return int(math.Round(n))
+}
+
+// boolAt returns the boolean at a path. Strings such as "true" are parsed.
+// Returns false if the value is missing or not a boolean.
+func boolAt(doc any, path string) (value, ok bool) {
+	v, found := lookup(doc, path)
+	if !found {
+		return false, false
+	}
+	switch b := v.(type) {
+	case bool:
+		return b, true
+	case string:
+		parsed, err := strconv.ParseBool(strings.TrimSpace(b))
+		return parsed, err == nil
+	default:
+		return false, false
+	}
+}
+
+// arrayAt returns the array at a path, or nil if missing or not an array.
+func arrayAt(doc any, path string) []any {
+	v, _ := lookup(doc, path)
+	items, _ := v.([]any)
+	return items
+}
+
+// toString formats a decoded JSON scalar. Objects, arrays and nil are empty.
+func toString(v any) string {
+	switch s := v.(type) {
+	case string:
+		return s
+	case float64:
+		return strconv.FormatFloat(s, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(s)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,138 @@
+package generic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decode(t *testing.T, s string) any {
+	t.Helper()
+	var doc any
+	require.NoError(t, json.Unmarshal([]byte(s), &doc))
+	return doc
+}
+
+func TestParsePath(t *testing.T) {
+	tests := []struct {
+		path    string
+		want    []step
+		wantErr bool
+	}{
+		{path: "", want: nil},
+		{path: "$", want: nil},
+		{path: "a", want: []step{{key: "a"}}},
+		{path: "$.a.b", want: []step{{key: "a"}, {key: "b"}}},
+		{path: "a[0].b", want: []step{{key: "a"}, {index: 0}, {key: "b"}}},
+		{path: "a[1][2]", want: []step{{key: "a"}, {index: 1}, {index: 2}}},
+		{path: "[3]", want: []step{{index: 3}}},
+		{path: "a..b", wantErr: true},
+		{path: "a[", wantErr: true},
+		{path: "a[-1]", wantErr: true},
+		{path: "a[0]b", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := parsePath(tt.path)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	doc := decode(t, `{
+		"id": "JT740",
+		"route": {"from": {"code": "CGK"}},
+		"legs": [{"airport": "SUB"}, {"airport": "DPS"}],
+		"price": 650000,
+		"direct": true,
+		"seats": "12",
+		"note": null
+	}`)
+
+	tests := []struct {
+		name   string
+		path   string
+		want   any
+		wantOK bool
+	}{
+		{"top-level key", "id", "JT740", true},
+		{"nested key", "route.from.code", "CGK", true},
+		{"array index", "legs[1].airport", "DPS", true},
+		{"number", "price", 650000.0, true},
+		{"root prefix", "$.direct", true, true},
+		{"missing key", "route.to.code", nil, false},
+		{"index out of range", "legs[2].airport", nil, false},
+		{"key on array", "legs.airport", nil, false},
+		{"key on scalar", "id.code", nil, false},
+		{"null value", "note", nil, false},
+		{"empty path", "", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := lookup(doc, tt.path)
+
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	n, ok := numberAt(doc, "seats")
+	assert.True(t, ok, "numeric strings are numbers")
+	assert.Equal(t, 12.0, n)
+	assert.Equal(t, 650000, intAt(doc, "price"))
+	assert.Len(t, arrayAt(doc, "legs"), 2)
+	assert.Nil(t, arrayAt(doc, "id"))
+}
+
+func TestStringAt(t *testing.T) {
+	doc := decode(t, `{"code": "QZ", "number": 520, "route": {"from": "CGK", "to": "DPS"}, "direct": false}`)
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"code", "QZ"},
+		{"number", "520"},
+		{"direct", "false"},
+		{"route", ""},
+		{"missing", ""},
+		{"{code}{number}", "QZ520"},
+		{"airasia-{code}{number}-{route.from}-{route.to}", "airasia-QZ520-CGK-DPS"},
+		{"{missing}-{code}", "-QZ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			assert.Equal(t, tt.want, stringAt(doc, tt.expr))
+		})
+	}
+}
+
+func TestBoolAt(t *testing.T) {
+	doc := decode(t, `{"wifi": true, "meal": "false", "seats": 3}`)
+
+	v, ok := boolAt(doc, "wifi")
+	assert.True(t, ok)
+	assert.True(t, v)
+
+	v, ok = boolAt(doc, "meal")
+	assert.True(t, ok)
+	assert.False(t, v)
+
+	_, ok = boolAt(doc, "seats")
+	assert.False(t, ok)
+
+	_, ok = boolAt(doc, "missing")
+	assert.False(t, ok)
+}
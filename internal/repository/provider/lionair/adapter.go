@@ -9,12 +9,19 @@ import (
 	"time"
 
 	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/internal/tracing"
 )
 
 const (
 	ProviderName = "lion_air"
 )
 
+// coverage lists the airports and cabin classes served by Lion Air.
+var coverage = domain.RouteCoverage{
+	Airports: []string{"CGK", "DPS", "SUB", "LOP", "UPG", "KNO", "BPN", "YIA"},
+	Classes:  []string{"economy", "business"},
+}
+
 // Adapter implements the domain.FlightProvider interface for Lion Air.
 // It reads from mock JSON data and normalizes it to the unified Flight domain model.
 type Adapter struct {
@@ -39,6 +46,12 @@ func (a *Adapter) Name() string {
 	return ProviderName
 }
 
+// Coverage returns the airports and cabin classes served by Lion Air.
+// Implements domain.CoverageProvider.
+func (a *Adapter) Coverage() domain.RouteCoverage {
+	return coverage
+}
+
 // Search queries the provider for available flights matching the criteria.
 // It reads from mock JSON data and returns normalized flight entities.
 // Simulates real-world conditions: Medium response (100-200ms delay).
@@ -92,7 +105,7 @@ func (a *Adapter) Search(ctx context.Context, criteria domain.SearchCriteria) ([
 		return []domain.Flight{}, nil
 	}
 
-	flights := normalize(response.Data.AvailableFlights)
+	flights := tracing.Normalize(ctx, ProviderName, response.Data.AvailableFlights, normalize)
 	return filterFlights(flights, criteria), nil
 }
 
@@ -147,3 +147,28 @@ func TestAdapterSearchFiltering(t *testing.T) {
 		})
 	}
 }
+
+func TestAdapterCoverage(t *testing.T) {
+	mockDataPath := filepath.Join("..", "..", "..", "..", "external", "response-mock", "lion_air_search_response.json")
+
+	if _, err := os.Stat(mockDataPath); os.IsNotExist(err) {
+		t.Skip("Mock data file not found")
+	}
+
+	adapter := NewAdapter(mockDataPath, true)
+	flights, err := adapter.Search(context.Background(), domain.SearchCriteria{})
+	require.NoError(t, err)
+	require.NotEmpty(t, flights)
+
+	coverage := adapter.Coverage()
+	for _, f := range flights {
+		criteria := domain.SearchCriteria{
+			Origin:      f.Departure.AirportCode,
+			Destination: f.Arrival.AirportCode,
+			Class:       f.Class,
+		}
+		assert.True(t, coverage.Covers(criteria), "flight %s must be covered", f.ID)
+	}
+
+	assert.False(t, coverage.Covers(domain.SearchCriteria{Origin: "CGK", Destination: "DPS", Class: "first"}), "class not offered")
+}
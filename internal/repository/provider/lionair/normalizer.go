@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/internal/metrics"
+	"github.com/herdiagusthio/flight-search-system/pkg/airport"
 	"github.com/herdiagusthio/flight-search-system/pkg/util"
 	"github.com/rs/zerolog/log"
 )
@@ -36,6 +38,7 @@ func normalize(lionAirFlights []LionAirFlight) []domain.Flight {
 	}
 
 	if skippedCount > 0 {
+		metrics.NormalizationSkipped.WithLabelValues(ProviderName).Add(float64(skippedCount))
 		log.Info().
 			Str("provider", ProviderName).
 			Int("skipped", skippedCount).
@@ -85,7 +88,7 @@ func normalizeFlight(f LionAirFlight) (domain.Flight, error) {
 		amenities = []string{}
 	}
 
-	return domain.Flight{
+	flight := domain.Flight{
 		ID:           f.ID,
 		FlightNumber: f.ID,
 		Airline: domain.AirlineInfo{
@@ -95,20 +98,24 @@ func normalizeFlight(f LionAirFlight) (domain.Flight, error) {
 		Departure: domain.FlightPoint{
 			AirportCode: f.Route.From.Code,
 			AirportName: f.Route.From.Name,
+			City:        f.Route.From.City,
 			DateTime:    departureTime,
-			Timezone:    f.Schedule.DepartureTimezone,
+			Timezone:    resolveTimezone(f.Schedule.DepartureTimezone),
 		},
 		Arrival: domain.FlightPoint{
 			AirportCode: f.Route.To.Code,
 			AirportName: f.Route.To.Name,
+			City:        f.Route.To.City,
 			DateTime:    arrivalTime,
-			Timezone:    f.Schedule.ArrivalTimezone,
+			Timezone:    resolveTimezone(f.Schedule.ArrivalTimezone),
 		},
 		Duration: domain.NewDurationInfo(f.FlightTime),
 		Price: domain.PriceInfo{
 			Amount:    f.Pricing.Total,
 			Currency:  f.Pricing.Currency,
-			Formatted: util.FormatIDR(f.Pricing.Total),
+			Formatted: util.FormatCurrency(f.Pricing.Total, f.Pricing.Currency),
+			// Lion Air only reports the total fare, so the breakdown components stay unknown
+			Breakdown: domain.FareBreakdown{},
 		},
 		Baggage: domain.BaggageInfo{
 			CabinKg:   cabinKg,
@@ -120,7 +127,25 @@ func normalizeFlight(f LionAirFlight) (domain.Flight, error) {
 		AvailableSeats: f.SeatsLeft,
 		Aircraft:       f.PlaneType,
 		Amenities:      amenities,
-	}, nil
+		Layovers:       buildLayovers(f.Layovers, departureTime, arrivalTime),
+	}
+	flight.ApplyReferenceData()
+
+	return flight, nil
+}
+
+// buildLayovers converts Lion Air layovers to domain layovers.
+// Lion Air only reports layover durations, so the itinerary window bounds the overnight check.
+func buildLayovers(lionAirLayovers []LionAirLayover, departure, arrival time.Time) []domain.Layover {
+	if len(lionAirLayovers) == 0 {
+		return nil
+	}
+
+	layovers := make([]domain.Layover, 0, len(lionAirLayovers))
+	for _, l := range lionAirLayovers {
+		layovers = append(layovers, domain.NewLayoverFromDuration(l.Airport, l.DurationMinutes, departure, arrival, airport.LocationOr(l.Airport, departure.Location())))
+	}
+	return layovers
 }
 
 // parseDateTimeWithTimezone parses a datetime string with a separate timezone.
@@ -144,8 +169,7 @@ func parseDateTimeWithTimezone(datetime, timezone, airportCode string) (time.Tim
 	if err != nil {
 		// If explicit timezone fails or is empty, try looking up by airport code
 		if airportCode != "" {
-			inferredTz := util.GetTimezoneByAirport(airportCode)
-			loc, err = util.GetLocation(inferredTz)
+			loc, err = airport.Location(airportCode)
 		}
 		
 		// If still failed or no airport code, fallback to UTC
@@ -162,6 +186,18 @@ func parseDateTimeWithTimezone(datetime, timezone, airportCode string) (time.Tim
 	), nil
 }
 
+// resolveTimezone returns timezone if it can be loaded, otherwise an empty string.
+// The airport reference dataset takes precedence when the airport is known.
+func resolveTimezone(timezone string) string {
+	if timezone == "" {
+		return ""
+	}
+	if _, err := util.GetLocation(timezone); err != nil {
+		return ""
+	}
+	return timezone
+}
+
 // parseBaggageWeight extracts the weight in kg from a baggage string like "7 kg".
 func parseBaggageWeight(baggageStr string) int {
 	// Remove "kg" suffix and trim spaces
@@ -3,6 +3,7 @@ package lionair
 import (
 	"testing"
 
+	"github.com/herdiagusthio/flight-search-system/domain"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -281,6 +282,10 @@ func TestNormalizeFlightWithLayovers(t *testing.T) {
 	result, err := normalizeFlight(flight)
 	assert.NoError(t, err)
 	assert.Equal(t, 2, result.Stops) // Should use length of Layovers
+	assert.Equal(t, []domain.Layover{
+		{AirportCode: "KUL", DurationMinutes: 60},
+		{AirportCode: "BTH", DurationMinutes: 45},
+	}, result.Layovers)
 }
 
 func TestNormalizeWithMultipleFlights(t *testing.T) {
@@ -491,3 +496,19 @@ func TestNormalizeFlightWithNewFields(t *testing.T) {
 	assert.Equal(t, []string{"wifi", "meal"}, result.Amenities)
 }
 
+func TestResolveTimezone(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"Asia/Jakarta", "Asia/Jakarta"},
+		{"Invalid/Zone", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, resolveTimezone(tt.input))
+		})
+	}
+}
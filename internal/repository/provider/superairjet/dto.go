@@ -0,0 +1,67 @@
+package superairjet
+
+// SuperAirJetResponse represents the root response structure from Super Air Jet API.
+type SuperAirJetResponse struct {
+	Status string          `json:"status"`
+	Data   SuperAirJetData `json:"data"`
+}
+
+// SuperAirJetData contains the flight data.
+type SuperAirJetData struct {
+	Flights []SuperAirJetFlight `json:"flights"`
+}
+
+// SuperAirJetFlight represents a single flight from the Super Air Jet API.
+type SuperAirJetFlight struct {
+	FlightCode string               `json:"flight_code"`
+	Airline    SuperAirJetAirline   `json:"airline"`
+	Departure  SuperAirJetPoint     `json:"departure"`
+	Arrival    SuperAirJetPoint     `json:"arrival"`
+	Duration   string               `json:"duration"`
+	Transit    []SuperAirJetTransit `json:"transit,omitempty"`
+	Price      SuperAirJetPrice     `json:"price"`
+	Class      string               `json:"class"`
+	Seats      int                  `json:"seats"`
+	Equipment  string               `json:"equipment"`
+	Baggage    SuperAirJetBaggage   `json:"baggage"`
+}
+
+// SuperAirJetAirline contains airline information.
+type SuperAirJetAirline struct {
+	IATA string `json:"iata"`
+	Name string `json:"name"`
+}
+
+// SuperAirJetPoint represents a departure or arrival point.
+type SuperAirJetPoint struct {
+	Airport  string `json:"airport"`
+	Terminal string `json:"terminal,omitempty"`
+	Date     string `json:"date"`
+	Time     string `json:"time"`
+}
+
+// SuperAirJetTransit represents a stop on a connecting flight.
+type SuperAirJetTransit struct {
+	Airport   string              `json:"airport"`
+	Arrival   SuperAirJetSchedule `json:"arrival"`
+	Departure SuperAirJetSchedule `json:"departure"`
+}
+
+// SuperAirJetSchedule is a local date and time, reported as separate fields.
+type SuperAirJetSchedule struct {
+	Date string `json:"date"`
+	Time string `json:"time"`
+}
+
+// SuperAirJetPrice contains pricing information.
+type SuperAirJetPrice struct {
+	Amount   float64 `json:"amount"`
+	Currency string  `json:"currency"`
+}
+
+// SuperAirJetBaggage contains baggage allowance information.
+// Values are in number of pieces, not weight.
+type SuperAirJetBaggage struct {
+	CabinPieces   int `json:"cabin_pieces"`
+	CheckedPieces int `json:"checked_pieces"`
+}
@@ -0,0 +1,196 @@
+package superairjet
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/internal/metrics"
+	"github.com/herdiagusthio/flight-search-system/pkg/airport"
+	"github.com/herdiagusthio/flight-search-system/pkg/util"
+	"github.com/rs/zerolog/log"
+)
+
+// dateTimeLayout is the layout of a Super Air Jet date and time joined by a space,
+// such as "15-Dec-2025 04:55", in the airport's local time.
+const dateTimeLayout = "02-Jan-2006 15:04"
+
+// durationRegex matches ISO 8601 durations in hours and minutes, such as "PT1H55M".
+var durationRegex = regexp.MustCompile(`^PT(?:(\d+)H)?(?:(\d+)M)?$`)
+
+func normalize(superAirJetFlights []SuperAirJetFlight) []domain.Flight {
+	result := make([]domain.Flight, 0, len(superAirJetFlights))
+	skippedCount := 0
+
+	for _, f := range superAirJetFlights {
+		normalized, err := normalizeFlight(f)
+		if err != nil {
+			log.Debug().
+				Str("provider", ProviderName).
+				Str("flight_number", f.FlightCode).
+				Err(err).
+				Msg("Failed to normalize flight")
+			skippedCount++
+			continue
+		}
+
+		if err := normalized.Validate(); err != nil {
+			log.Warn().
+				Str("provider", ProviderName).
+				Str("flight_number", normalized.FlightNumber).
+				Err(err).
+				Msg("Flight validation failed")
+			skippedCount++
+			continue
+		}
+
+		result = append(result, normalized)
+	}
+
+	if skippedCount > 0 {
+		metrics.NormalizationSkipped.WithLabelValues(ProviderName).Add(float64(skippedCount))
+		log.Info().
+			Str("provider", ProviderName).
+			Int("skipped", skippedCount).
+			Int("total", len(superAirJetFlights)).
+			Msg("Skipped invalid flights during normalization")
+	}
+
+	return result
+}
+
+// normalizeFlight converts a single Super Air Jet flight to a domain Flight entity.
+func normalizeFlight(f SuperAirJetFlight) (domain.Flight, error) {
+	// Dates and times are reported separately, in the airport's timezone
+	departureTime, err := parseDateTime(f.Departure.Date, f.Departure.Time, f.Departure.Airport)
+	if err != nil {
+		return domain.Flight{}, fmt.Errorf("failed to parse departure time: %w", err)
+	}
+
+	arrivalTime, err := parseDateTime(f.Arrival.Date, f.Arrival.Time, f.Arrival.Airport)
+	if err != nil {
+		return domain.Flight{}, fmt.Errorf("failed to parse arrival time: %w", err)
+	}
+
+	// Parse the ISO 8601 duration, falling back to the schedule if it is malformed
+	durationMinutes, err := parseDuration(f.Duration)
+	if err != nil {
+		log.Debug().
+			Str("provider", ProviderName).
+			Str("flight_number", f.FlightCode).
+			Err(err).
+			Msg("Failed to parse duration, using schedule")
+		durationMinutes = int(arrivalTime.Sub(departureTime).Minutes())
+	}
+
+	flight := domain.Flight{
+		ID:           f.FlightCode,
+		FlightNumber: f.FlightCode,
+		Airline: domain.AirlineInfo{
+			Code: f.Airline.IATA,
+			Name: f.Airline.Name,
+		},
+		Departure: domain.FlightPoint{
+			AirportCode: f.Departure.Airport,
+			Terminal:    f.Departure.Terminal,
+			DateTime:    departureTime,
+		},
+		Arrival: domain.FlightPoint{
+			AirportCode: f.Arrival.Airport,
+			Terminal:    f.Arrival.Terminal,
+			DateTime:    arrivalTime,
+		},
+		Duration: domain.NewDurationInfo(durationMinutes),
+		Price: domain.PriceInfo{
+			Amount:    f.Price.Amount,
+			Currency:  f.Price.Currency,
+			Formatted: util.FormatCurrency(f.Price.Amount, f.Price.Currency),
+			// Super Air Jet only reports the total fare, so the breakdown components stay unknown
+			Breakdown: domain.FareBreakdown{},
+		},
+		Baggage: domain.BaggageInfo{
+			CabinKg:   f.Baggage.CabinPieces * DefaultCabinBaggageKg,
+			CheckedKg: f.Baggage.CheckedPieces * DefaultCheckedBaggageKg,
+		},
+		Class:          normalizeClass(f.Class),
+		Stops:          len(f.Transit),
+		Provider:       ProviderName,
+		AvailableSeats: f.Seats,
+		Aircraft:       f.Equipment,
+		Amenities:      []string{},
+		Layovers:       buildLayovers(f.Transit, departureTime, arrivalTime),
+	}
+	flight.ApplyReferenceData()
+
+	return flight, nil
+}
+
+// buildLayovers converts Super Air Jet transits to domain layovers.
+// The layover window is taken from the transit times when they parse,
+// otherwise the layover is kept with a zero duration.
+func buildLayovers(transits []SuperAirJetTransit, departure, arrival time.Time) []domain.Layover {
+	if len(transits) == 0 {
+		return nil
+	}
+
+	layovers := make([]domain.Layover, 0, len(transits))
+	for _, t := range transits {
+		loc := airport.LocationOr(t.Airport, departure.Location())
+
+		inbound, errIn := parseDateTime(t.Arrival.Date, t.Arrival.Time, t.Airport)
+		onward, errOut := parseDateTime(t.Departure.Date, t.Departure.Time, t.Airport)
+		if errIn == nil && errOut == nil && onward.After(inbound) {
+			layovers = append(layovers, domain.NewLayover(t.Airport, inbound, onward, loc))
+			continue
+		}
+
+		layovers = append(layovers, domain.NewLayoverFromDuration(t.Airport, 0, departure, arrival, loc))
+	}
+	return layovers
+}
+
+// parseDateTime parses a Super Air Jet date like "15-Dec-2025" and time like "04:55"
+// in the timezone of the given airport.
+func parseDateTime(date, clock, airportCode string) (time.Time, error) {
+	dateTime := strings.TrimSpace(date) + " " + strings.TrimSpace(clock)
+
+	loc, err := airport.Location(airportCode)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unable to parse datetime %q without timezone: %w", dateTime, err)
+	}
+
+	t, err := time.ParseInLocation(dateTimeLayout, dateTime, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unable to parse datetime %q for airport %s: %w", dateTime, airportCode, err)
+	}
+
+	return t, nil
+}
+
+// parseDuration parses an ISO 8601 duration like "PT1H55M" to total minutes.
+// Handles formats: "PT1H55M", "PT2H", "PT45M"
+func parseDuration(duration string) (int, error) {
+	matches := durationRegex.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(duration)))
+	if matches == nil || (matches[1] == "" && matches[2] == "") {
+		return 0, fmt.Errorf("invalid duration format: %q", duration)
+	}
+
+	var hours, minutes int
+	if matches[1] != "" {
+		hours, _ = strconv.Atoi(matches[1])
+	}
+	if matches[2] != "" {
+		minutes, _ = strconv.Atoi(matches[2])
+	}
+
+	return hours*60 + minutes, nil
+}
+
+// normalizeClass maps a Super Air Jet booking class code to a cabin class. Super Air
+// Jet only sells economy, so every booking class, like Y or M, is an economy fare.
+func normalizeClass(string) string {
+	return "economy"
+}
@@ -0,0 +1,224 @@
+package superairjet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validFlight() SuperAirJetFlight {
+	return SuperAirJetFlight{
+		FlightCode: "IU730",
+		Airline:    SuperAirJetAirline{IATA: "IU", Name: "Super Air Jet"},
+		Departure:  SuperAirJetPoint{Airport: "CGK", Terminal: "1A", Date: "15-Dec-2025", Time: "04:55"},
+		Arrival:    SuperAirJetPoint{Airport: "DPS", Terminal: "D", Date: "15-Dec-2025", Time: "07:50"},
+		Duration:   "PT1H55M",
+		Price:      SuperAirJetPrice{Amount: 590000, Currency: "IDR"},
+		Class:      "Y",
+		Seats:      120,
+		Equipment:  "Airbus A320-200",
+		Baggage:    SuperAirJetBaggage{CabinPieces: 1, CheckedPieces: 1},
+	}
+}
+
+func TestNormalizeFlight(t *testing.T) {
+	flight, err := normalizeFlight(validFlight())
+
+	require.NoError(t, err)
+	assert.Equal(t, "IU730", flight.ID)
+	assert.Equal(t, "IU", flight.Airline.Code)
+	assert.Equal(t, "1A", flight.Departure.Terminal)
+	assert.Equal(t, time.Date(2025, 12, 14, 21, 55, 0, 0, time.UTC), flight.Departure.DateTime.UTC())
+	assert.Equal(t, time.Date(2025, 12, 14, 23, 50, 0, 0, time.UTC), flight.Arrival.DateTime.UTC())
+	assert.Equal(t, 115, flight.Duration.TotalMinutes)
+	assert.Equal(t, "Rp 590.000", flight.Price.Formatted)
+	assert.Equal(t, domain.FareBreakdown{}, flight.Price.Breakdown)
+	assert.Equal(t, domain.BaggageInfo{CabinKg: 7, CheckedKg: 20}, flight.Baggage)
+	assert.Equal(t, "economy", flight.Class)
+	assert.Equal(t, "super_air_jet", flight.Provider)
+	assert.Equal(t, "Airbus A320-200", flight.Aircraft)
+	assert.Equal(t, []string{}, flight.Amenities)
+}
+
+func TestNormalizeFlight_Variants(t *testing.T) {
+	tests := []struct {
+		name         string
+		modify       func(*SuperAirJetFlight)
+		expectError  bool
+		wantDuration int
+		wantBaggage  domain.BaggageInfo
+	}{
+		{
+			name:         "valid flight",
+			modify:       func(f *SuperAirJetFlight) {},
+			wantDuration: 115,
+			wantBaggage:  domain.BaggageInfo{CabinKg: 7, CheckedKg: 20},
+		},
+		{
+			name:         "two checked pieces",
+			modify:       func(f *SuperAirJetFlight) { f.Baggage.CheckedPieces = 2 },
+			wantDuration: 115,
+			wantBaggage:  domain.BaggageInfo{CabinKg: 7, CheckedKg: 40},
+		},
+		{
+			name:         "no free checked bag",
+			modify:       func(f *SuperAirJetFlight) { f.Baggage.CheckedPieces = 0 },
+			wantDuration: 115,
+			wantBaggage:  domain.BaggageInfo{CabinKg: 7},
+		},
+		{
+			name:         "malformed duration derived from schedule",
+			modify:       func(f *SuperAirJetFlight) { f.Duration = "1h 55m" },
+			wantDuration: 115,
+			wantBaggage:  domain.BaggageInfo{CabinKg: 7, CheckedKg: 20},
+		},
+		{
+			name:        "ISO departure date",
+			modify:      func(f *SuperAirJetFlight) { f.Departure.Date = "2025-12-15" },
+			expectError: true,
+		},
+		{
+			name:        "missing arrival time",
+			modify:      func(f *SuperAirJetFlight) { f.Arrival.Time = "" },
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := validFlight()
+			tt.modify(&f)
+
+			flight, err := normalizeFlight(f)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantDuration, flight.Duration.TotalMinutes)
+			assert.Equal(t, tt.wantBaggage, flight.Baggage)
+		})
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		input       string
+		expected    int
+		expectError bool
+	}{
+		{"PT1H55M", 115, false},
+		{"PT2H", 120, false},
+		{"PT45M", 45, false},
+		{" pt3h5m ", 185, false},
+		{"PT", 0, true},
+		{"1h 55m", 0, true},
+		{"", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseDuration(tt.input)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestParseDateTime(t *testing.T) {
+	makassar, _ := time.LoadLocation("Asia/Makassar")
+
+	got, err := parseDateTime("16-Dec-2025", "01:45", "DPS")
+
+	require.NoError(t, err)
+	assert.True(t, time.Date(2025, 12, 16, 1, 45, 0, 0, makassar).Equal(got))
+
+	_, err = parseDateTime("16-Dec-2025", "01:45", "XXX")
+	assert.Error(t, err)
+}
+
+func TestBuildLayovers(t *testing.T) {
+	departure := time.Date(2025, 12, 15, 10, 0, 0, 0, time.UTC)
+	arrival := departure.Add(235 * time.Minute)
+
+	tests := []struct {
+		name         string
+		transit      SuperAirJetTransit
+		wantDuration int
+	}{
+		{
+			name: "transit times",
+			transit: SuperAirJetTransit{
+				Airport:   "SUB",
+				Arrival:   SuperAirJetSchedule{Date: "15-Dec-2025", Time: "18:30"},
+				Departure: SuperAirJetSchedule{Date: "15-Dec-2025", Time: "19:45"},
+			},
+			wantDuration: 75,
+		},
+		{
+			name: "overnight transit",
+			transit: SuperAirJetTransit{
+				Airport:   "SUB",
+				Arrival:   SuperAirJetSchedule{Date: "15-Dec-2025", Time: "23:30"},
+				Departure: SuperAirJetSchedule{Date: "16-Dec-2025", Time: "05:00"},
+			},
+			wantDuration: 330,
+		},
+		{
+			name:         "missing transit times",
+			transit:      SuperAirJetTransit{Airport: "SUB"},
+			wantDuration: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			layovers := buildLayovers([]SuperAirJetTransit{tt.transit}, departure, arrival)
+
+			require.Len(t, layovers, 1)
+			assert.Equal(t, "SUB", layovers[0].AirportCode)
+			assert.Equal(t, tt.wantDuration, layovers[0].DurationMinutes)
+		})
+	}
+
+	assert.Nil(t, buildLayovers(nil, departure, arrival))
+}
+
+func TestNormalizeClass(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"Y", "economy"},
+		{"M", "economy"},
+		{"C", "economy"},
+		{"business", "economy"},
+		{"", "economy"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, normalizeClass(tt.input))
+		})
+	}
+}
+
+func TestNormalizeWithMixedFlights(t *testing.T) {
+	invalid := validFlight()
+	invalid.FlightCode = "IU999"
+	invalid.Departure.Date = "invalid"
+
+	result := normalize([]SuperAirJetFlight{validFlight(), invalid})
+
+	require.Len(t, result, 1)
+	assert.Equal(t, "IU730", result[0].FlightNumber)
+}
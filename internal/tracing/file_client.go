@@ -0,0 +1,100 @@
+package tracing
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"sync"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// fileClient is an OTLP trace client writing each export request as a line of OTLP
+// JSON, the format read by the OpenTelemetry Collector's otlpjsonfile receiver.
+type fileClient struct {
+	mu sync.Mutex
+	w  io.WriteCloser
+}
+
+// newFileClient creates a client writing to w, which is closed when the client stops.
+func newFileClient(w io.WriteCloser) *fileClient {
+	return &fileClient{w: w}
+}
+
+// Start implements otlptrace.Client.
+func (c *fileClient) Start(context.Context) error {
+	return nil
+}
+
+// Stop implements otlptrace.Client, closing the writer.
+func (c *fileClient) Stop(context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.w.Close()
+}
+
+// UploadTraces implements otlptrace.Client, writing the spans as one line.
+func (c *fileClient) UploadTraces(_ context.Context, spans []*tracepb.ResourceSpans) error {
+	line, err := marshalOTLPJSON(&coltracepb.ExportTraceServiceRequest{ResourceSpans: spans})
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, err = c.w.Write(append(line, '\n'))
+	return err
+}
+
+// otlpIDFields are the fields OTLP JSON encodes as hex rather than the base64 used by
+// the protobuf JSON mapping.
+var otlpIDFields = map[string]bool{"traceId": true, "spanId": true, "parentSpanId": true}
+
+// marshalOTLPJSON encodes an export request as OTLP JSON, which differs from the
+// protobuf JSON mapping in encoding enums as numbers and trace and span IDs as hex.
+func marshalOTLPJSON(req *coltracepb.ExportTraceServiceRequest) ([]byte, error) {
+	data, err := protojson.MarshalOptions{UseEnumNumbers: true}.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if err := hexIDs(doc); err != nil {
+		return nil, err
+	}
+	return json.Marshal(doc)
+}
+
+// hexIDs re-encodes the base64 trace and span IDs in a decoded JSON document as hex.
+func hexIDs(v any) error {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if id, ok := value.(string); ok && otlpIDFields[key] {
+				raw, err := base64.StdEncoding.DecodeString(id)
+				if err != nil {
+					return err
+				}
+				v[key] = hex.EncodeToString(raw)
+				continue
+			}
+			if err := hexIDs(value); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for _, item := range v {
+			if err := hexIDs(item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
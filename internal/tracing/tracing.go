@@ -0,0 +1,105 @@
+// Package tracing sets up OpenTelemetry tracing: the global tracer provider with its
+// exporter, and W3C trace context propagation.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Supported span exporters.
+const (
+	// ExporterNone disables tracing; trace context is still propagated.
+	ExporterNone = "none"
+	// ExporterStdout writes spans to stdout as JSON.
+	ExporterStdout = "stdout"
+	// ExporterOTLPFile appends spans to a file as OTLP JSON, one export request per line.
+	ExporterOTLPFile = "otlp-file"
+)
+
+var tracer = otel.Tracer("github.com/herdiagusthio/flight-search-system/internal/tracing")
+
+// Config configures tracing.
+type Config struct {
+	// Exporter is one of ExporterNone, ExporterStdout or ExporterOTLPFile.
+	Exporter string
+	// File is the file written by ExporterOTLPFile.
+	File string
+	// ServiceName is the service.name resource attribute of the spans.
+	ServiceName string
+	// SampleRatio is the share of new traces sampled; traces propagated from a caller
+	// follow the caller's sampling decision.
+	SampleRatio float64
+}
+
+// Setup installs the W3C trace context propagator and, unless the exporter is
+// ExporterNone, a global tracer provider exporting spans. The returned function flushes
+// pending spans and stops the exporter.
+func Setup(cfg Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	exporter, err := newExporter(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if exporter == nil {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", cfg.ServiceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// newExporter creates the span exporter of the configuration, or nil for ExporterNone.
+func newExporter(cfg Config) (sdktrace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case ExporterNone, "":
+		return nil, nil
+	case ExporterStdout:
+		return stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case ExporterOTLPFile:
+		file, err := os.OpenFile(cfg.File, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("open trace file: %w", err)
+		}
+		return otlptrace.New(context.Background(), newFileClient(file))
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", cfg.Exporter)
+	}
+}
+
+// Normalize normalizes the flights received from a provider in a span recording how
+// many flights were received, normalized and skipped as invalid.
+func Normalize[T any](ctx context.Context, provider string, received []T, normalize func([]T) []domain.Flight) []domain.Flight {
+	_, span := tracer.Start(ctx, "normalize", trace.WithAttributes(
+		attribute.String("provider", provider),
+		attribute.Int("received_count", len(received)),
+	))
+	defer span.End()
+
+	flights := normalize(received)
+	span.SetAttributes(
+		attribute.Int("flight_count", len(flights)),
+		attribute.Int("skipped_count", len(received)-len(flights)),
+	)
+	return flights
+}
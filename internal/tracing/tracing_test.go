@@ -0,0 +1,173 @@
+package tracing
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+)
+
+func TestNewExporter(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantNil bool
+		errMsg  string
+	}{
+		{
+			name:    "none",
+			cfg:     Config{Exporter: ExporterNone},
+			wantNil: true,
+		},
+		{
+			name:    "empty exporter disables tracing",
+			cfg:     Config{},
+			wantNil: true,
+		},
+		{
+			name: "stdout",
+			cfg:  Config{Exporter: ExporterStdout},
+		},
+		{
+			name: "otlp file",
+			cfg:  Config{Exporter: ExporterOTLPFile, File: filepath.Join(dir, "traces.jsonl")},
+		},
+		{
+			name:   "otlp file in missing directory",
+			cfg:    Config{Exporter: ExporterOTLPFile, File: filepath.Join(dir, "missing", "traces.jsonl")},
+			errMsg: "open trace file",
+		},
+		{
+			name:   "unknown exporter",
+			cfg:    Config{Exporter: "jaeger"},
+			errMsg: `unknown trace exporter "jaeger"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter, err := newExporter(tt.cfg)
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			if tt.wantNil {
+				assert.Nil(t, exporter)
+				return
+			}
+			require.NotNil(t, exporter)
+			assert.NoError(t, exporter.Shutdown(context.Background()))
+		})
+	}
+}
+
+func TestSetup_PropagatesW3CTraceContext(t *testing.T) {
+	shutdown, err := Setup(Config{Exporter: ExporterNone})
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+
+	assert.ElementsMatch(t, []string{"traceparent", "tracestate", "baggage"}, otel.GetTextMapPropagator().Fields())
+}
+
+func TestSetup_OTLPFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+	shutdown, err := Setup(Config{
+		Exporter:    ExporterOTLPFile,
+		File:        path,
+		ServiceName: "flight-search-test",
+		SampleRatio: 1,
+	})
+	require.NoError(t, err)
+
+	ctx, span := otel.Tracer("test").Start(context.Background(), "search")
+	flights := Normalize(ctx, "garuda_indonesia", []string{"GA400", "", "GA410"}, func(received []string) []domain.Flight {
+		var flights []domain.Flight
+		for _, number := range received {
+			if number != "" {
+				flights = append(flights, domain.Flight{FlightNumber: number})
+			}
+		}
+		return flights
+	})
+	span.End()
+	assert.Len(t, flights, 2)
+
+	require.NoError(t, shutdown(context.Background()))
+
+	// Each line is an OTLP JSON export request, with hex IDs and numeric enums
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	type otlpValue struct {
+		StringValue string `json:"stringValue"`
+		IntValue    string `json:"intValue"`
+	}
+	type otlpAttribute struct {
+		Key   string    `json:"key"`
+		Value otlpValue `json:"value"`
+	}
+	var req struct {
+		ResourceSpans []struct {
+			Resource struct {
+				Attributes []otlpAttribute `json:"attributes"`
+			} `json:"resource"`
+			ScopeSpans []struct {
+				Spans []struct {
+					TraceID    string          `json:"traceId"`
+					Name       string          `json:"name"`
+					Kind       int             `json:"kind"`
+					Attributes []otlpAttribute `json:"attributes"`
+				} `json:"spans"`
+			} `json:"scopeSpans"`
+		} `json:"resourceSpans"`
+	}
+
+	spans := map[string]map[string]string{}
+	var services []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &req))
+		for _, rs := range req.ResourceSpans {
+			for _, attr := range rs.Resource.Attributes {
+				if attr.Key == "service.name" {
+					services = append(services, attr.Value.StringValue)
+				}
+			}
+			for _, ss := range rs.ScopeSpans {
+				for _, s := range ss.Spans {
+					assert.Equal(t, span.SpanContext().TraceID().String(), s.TraceID)
+					assert.Equal(t, 1, s.Kind, "internal span kind")
+					attrs := map[string]string{}
+					for _, attr := range s.Attributes {
+						attrs[attr.Key] = attr.Value.StringValue + attr.Value.IntValue
+					}
+					spans[s.Name] = attrs
+				}
+			}
+		}
+	}
+	require.NoError(t, scanner.Err())
+
+	assert.Contains(t, services, "flight-search-test")
+	require.Contains(t, spans, "search")
+	require.Contains(t, spans, "normalize")
+	assert.Equal(t, map[string]string{
+		"provider":       "garuda_indonesia",
+		"received_count": "3",
+		"flight_count":   "2",
+		"skipped_count":  "1",
+	}, spans["normalize"])
+}
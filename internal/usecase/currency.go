@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/pkg/util"
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultBaseCurrency is the currency prices are normalized to for filtering and ranking.
+const DefaultBaseCurrency = "IDR"
+
+// CurrencyConverter converts flight prices between currencies using an ExchangeRateProvider.
+type CurrencyConverter struct {
+	rates domain.ExchangeRateProvider
+	base  string
+}
+
+// NewCurrencyConverter creates a CurrencyConverter normalizing prices to base.
+// Uses DefaultBaseCurrency if base is empty. If rates is nil, only same-currency
+// conversions are supported.
+func NewCurrencyConverter(rates domain.ExchangeRateProvider, base string) *CurrencyConverter {
+	base = strings.ToUpper(strings.TrimSpace(base))
+	if base == "" {
+		base = DefaultBaseCurrency
+	}
+	if rates == nil {
+		rates = sameCurrencyRates{}
+	}
+	return &CurrencyConverter{rates: rates, base: base}
+}
+
+// Base returns the base currency.
+func (c *CurrencyConverter) Base() string {
+	return c.base
+}
+
+// Rate returns how many units of to one unit of from is worth.
+// An empty currency is treated as the base currency.
+func (c *CurrencyConverter) Rate(ctx context.Context, from, to string) (float64, error) {
+	from = c.normalizeCode(from)
+	to = c.normalizeCode(to)
+	if from == to {
+		return 1, nil
+	}
+	return c.rates.Rate(ctx, from, to)
+}
+
+// NormalizePrices converts every flight price and fare breakdown to the base currency,
+// keeping the provider's price in OriginalAmount and OriginalCurrency. Flights whose
+// currency cannot be converted are dropped, since they cannot be compared with the others.
+func (c *CurrencyConverter) NormalizePrices(ctx context.Context, flights []domain.Flight) []domain.Flight {
+	result := make([]domain.Flight, 0, len(flights))
+	for _, f := range flights {
+		currency := c.normalizeCode(f.Price.Currency)
+		if currency == c.base {
+			f.Price.Currency = c.base
+			result = append(result, f)
+			continue
+		}
+
+		rate, err := c.rates.Rate(ctx, currency, c.base)
+		if err != nil {
+			log.Warn().
+				Err(err).
+				Str("flight_id", f.ID).
+				Str("provider", f.Provider).
+				Str("currency", currency).
+				Msg("Dropping flight with unconvertible price")
+			continue
+		}
+
+		f.Price.OriginalAmount = f.Price.Amount
+		f.Price.OriginalCurrency = currency
+		f.Price.Amount = f.Price.Amount * rate
+		f.Price.Currency = c.base
+		f.Price.Formatted = util.FormatCurrency(f.Price.Amount, c.base)
+		f.Price.Breakdown = f.Price.Breakdown.Scaled(rate)
+		result = append(result, f)
+	}
+	return result
+}
+
+// ApplyDisplayCurrency converts base-currency prices, their fare breakdowns, ranking
+// explanation price bounds and self-transfer leg prices to the display currency in place
+// and formats them with the display currency's locale conventions.
+func (c *CurrencyConverter) ApplyDisplayCurrency(ctx context.Context, flights []domain.Flight, currency string) error {
+	currency = c.normalizeCode(currency)
+	rate, err := c.Rate(ctx, c.base, currency)
+	if err != nil {
+		return fmt.Errorf("convert to display currency: %w", err)
+	}
+
+	for i := range flights {
+		flights[i].Price = displayPrice(flights[i].Price, rate, currency)
+		if e := flights[i].RankingExplanation; e != nil {
+			if b, ok := e.Bounds[FactorPrice]; ok {
+				e.Bounds[FactorPrice] = domain.RankingBound{Min: b.Min * rate, Max: b.Max * rate}
+			}
+		}
+		if st := flights[i].SelfTransfer; st != nil {
+			// Copy the self-transfer so flights sharing it are not converted twice
+			converted := *st
+			converted.Legs = make([]domain.Flight, len(st.Legs))
+			for j, leg := range st.Legs {
+				leg.Price = displayPrice(leg.Price, rate, currency)
+				converted.Legs[j] = leg
+			}
+			flights[i].SelfTransfer = &converted
+		}
+	}
+	return nil
+}
+
+// displayPrice converts a base-currency price and its fare breakdown to currency at rate.
+func displayPrice(price domain.PriceInfo, rate float64, currency string) domain.PriceInfo {
+	price.Amount = price.Amount * rate
+	price.Currency = currency
+	price.Formatted = util.FormatCurrency(price.Amount, currency)
+	price.Breakdown = price.Breakdown.Scaled(rate)
+	return price
+}
+
+// normalizeCode uppercases a currency code, treating an empty code as the base currency.
+func (c *CurrencyConverter) normalizeCode(code string) string {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return c.base
+	}
+	return code
+}
+
+// sameCurrencyRates is the ExchangeRateProvider used when none is configured.
+// It only supports converting a currency to itself.
+type sameCurrencyRates struct{}
+
+// Rate implements domain.ExchangeRateProvider.
+func (sameCurrencyRates) Rate(_ context.Context, from, to string) (float64, error) {
+	if strings.EqualFold(from, to) {
+		return 1, nil
+	}
+	return 0, fmt.Errorf("%w: no exchange rate source for %s to %s", domain.ErrUnsupportedCurrency, from, to)
+}
@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubRates implements domain.ExchangeRateProvider with rates expressed in IDR per unit.
+type stubRates map[string]float64
+
+func (s stubRates) Rate(_ context.Context, from, to string) (float64, error) {
+	fromRate, ok := s[from]
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", domain.ErrUnsupportedCurrency, from)
+	}
+	toRate, ok := s[to]
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", domain.ErrUnsupportedCurrency, to)
+	}
+	return fromRate / toRate, nil
+}
+
+func testRates() stubRates {
+	return stubRates{"IDR": 1, "USD": 16000, "SGD": 12000}
+}
+
+func TestNewCurrencyConverter_Defaults(t *testing.T) {
+	c := NewCurrencyConverter(nil, "")
+
+	assert.Equal(t, DefaultBaseCurrency, c.Base())
+
+	rate, err := c.Rate(context.Background(), "idr", "")
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, rate)
+
+	_, err = c.Rate(context.Background(), "USD", "IDR")
+	assert.ErrorIs(t, err, domain.ErrUnsupportedCurrency)
+}
+
+func TestCurrencyConverter_NormalizePrices(t *testing.T) {
+	c := NewCurrencyConverter(testRates(), "IDR")
+	flights := []domain.Flight{
+		{ID: "idr", Price: domain.PriceInfo{Amount: 800000, Currency: "IDR"}},
+		{ID: "usd", Price: domain.PriceInfo{Amount: 50, Currency: "usd"}},
+		{ID: "none", Price: domain.PriceInfo{Amount: 900000}},
+		{ID: "unknown", Price: domain.PriceInfo{Amount: 10, Currency: "XYZ"}},
+	}
+
+	result := c.NormalizePrices(context.Background(), flights)
+
+	require.Len(t, result, 3)
+	assert.Equal(t, domain.PriceInfo{Amount: 800000, Currency: "IDR"}, result[0].Price)
+	assert.Equal(t, domain.PriceInfo{
+		Amount:           800000,
+		Currency:         "IDR",
+		Formatted:        "Rp 800.000",
+		OriginalAmount:   50,
+		OriginalCurrency: "USD",
+	}, result[1].Price)
+	assert.Equal(t, "IDR", result[2].Price.Currency)
+
+	// Input flights are not modified
+	assert.Equal(t, "usd", flights[1].Price.Currency)
+}
+
+func TestCurrencyConverter_ApplyDisplayCurrency(t *testing.T) {
+	c := NewCurrencyConverter(testRates(), "IDR")
+	flights := []domain.Flight{
+		{Price: domain.PriceInfo{Amount: 1600000, Currency: "IDR"}},
+		{Price: domain.PriceInfo{Amount: 24000, Currency: "IDR"}},
+	}
+
+	err := c.ApplyDisplayCurrency(context.Background(), flights, "usd")
+
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, flights[0].Price.Amount)
+	assert.Equal(t, "USD", flights[0].Price.Currency)
+	assert.Equal(t, "$100.00", flights[0].Price.Formatted)
+	assert.Equal(t, "$1.50", flights[1].Price.Formatted)
+}
+
+func TestCurrencyConverter_ConvertsFareBreakdown(t *testing.T) {
+	c := NewCurrencyConverter(testRates(), "IDR")
+	base, taxes := 45.0, 5.0
+	flights := []domain.Flight{{Price: domain.PriceInfo{
+		Amount:    50,
+		Currency:  "USD",
+		Breakdown: domain.FareBreakdown{BaseFare: &base, Taxes: &taxes},
+	}}}
+
+	result := c.NormalizePrices(context.Background(), flights)
+	require.Len(t, result, 1)
+	require.NotNil(t, result[0].Price.Breakdown.BaseFare)
+	assert.Equal(t, 720000.0, *result[0].Price.Breakdown.BaseFare)
+	assert.Equal(t, 80000.0, *result[0].Price.Breakdown.Taxes)
+	assert.Nil(t, result[0].Price.Breakdown.Fees)
+
+	result[0].Price.ApplyPassengers(2)
+	require.NoError(t, c.ApplyDisplayCurrency(context.Background(), result, "SGD"))
+	assert.Equal(t, 60.0, *result[0].Price.Breakdown.BaseFare)
+	assert.InDelta(t, 66.67, result[0].Price.Breakdown.PerPassenger, 0.01)
+	assert.InDelta(t, 133.33, result[0].Price.Breakdown.Total, 0.01)
+
+	// Input breakdown amounts are not modified
+	assert.Equal(t, 45.0, base)
+}
+
+func TestCurrencyConverter_ApplyDisplayCurrency_RankingBounds(t *testing.T) {
+	c := NewCurrencyConverter(testRates(), "IDR")
+	flights := []domain.Flight{{
+		Price: domain.PriceInfo{Amount: 1600000, Currency: "IDR"},
+		RankingExplanation: &domain.RankingExplanation{
+			Components: map[string]float64{FactorPrice: 1},
+			Bounds: map[string]domain.RankingBound{
+				FactorPrice: {Min: 800000, Max: 1600000},
+				FactorStops: {Min: 0, Max: 1},
+			},
+		},
+	}}
+
+	require.NoError(t, c.ApplyDisplayCurrency(context.Background(), flights, "USD"))
+
+	e := flights[0].RankingExplanation
+	assert.Equal(t, map[string]domain.RankingBound{
+		FactorPrice: {Min: 50, Max: 100},
+		FactorStops: {Min: 0, Max: 1},
+	}, e.Bounds)
+	assert.Equal(t, map[string]float64{FactorPrice: 1}, e.Components)
+}
+
+func TestCurrencyConverter_ApplyDisplayCurrency_SelfTransferLegs(t *testing.T) {
+	c := NewCurrencyConverter(testRates(), "IDR")
+	selfTransfer := &domain.SelfTransfer{Legs: []domain.Flight{
+		{ID: "leg1", Price: domain.PriceInfo{Amount: 640000, Currency: "IDR"}},
+		{ID: "leg2", Price: domain.PriceInfo{Amount: 960000, Currency: "IDR"}},
+	}}
+	flights := []domain.Flight{{
+		Price:        domain.PriceInfo{Amount: 1600000, Currency: "IDR"},
+		SelfTransfer: selfTransfer,
+	}}
+
+	require.NoError(t, c.ApplyDisplayCurrency(context.Background(), flights, "USD"))
+
+	legs := flights[0].SelfTransfer.Legs
+	assert.Equal(t, 40.0, legs[0].Price.Amount)
+	assert.Equal(t, 60.0, legs[1].Price.Amount)
+	assert.Equal(t, "USD", legs[1].Price.Currency)
+
+	// The shared self-transfer is not modified
+	assert.Equal(t, 640000.0, selfTransfer.Legs[0].Price.Amount)
+}
+
+func TestCurrencyConverter_ApplyDisplayCurrency_Unsupported(t *testing.T) {
+	c := NewCurrencyConverter(testRates(), "IDR")
+	flights := []domain.Flight{{Price: domain.PriceInfo{Amount: 1600000, Currency: "IDR"}}}
+
+	err := c.ApplyDisplayCurrency(context.Background(), flights, "XYZ")
+
+	assert.ErrorIs(t, err, domain.ErrUnsupportedCurrency)
+	assert.Equal(t, 1600000.0, flights[0].Price.Amount)
+}
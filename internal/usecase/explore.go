@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/internal/metrics"
+	"github.com/rs/zerolog/log"
+)
+
+// Default explore settings.
+const (
+	DefaultExploreMaxDays             = 7
+	DefaultExploreProviderConcurrency = 2
+)
+
+// ExploreConfig configures explore searches.
+type ExploreConfig struct {
+	// MaxDays is the longest accepted departure date range, in days.
+	MaxDays int
+	// ProviderConcurrency is the maximum number of concurrent queries per provider, keeping
+	// an explore search, which queries every provider once per date, within provider quotas.
+	ProviderConcurrency int
+}
+
+// DefaultExploreConfig returns the default explore configuration.
+func DefaultExploreConfig() ExploreConfig {
+	return ExploreConfig{
+		MaxDays:             DefaultExploreMaxDays,
+		ProviderConcurrency: DefaultExploreProviderConcurrency,
+	}
+}
+
+// Explore implements FlightSearchUseCase.Explore. Each provider serving the origin is queried once per date
+// for every destination, at most ExploreConfig.ProviderConcurrency dates at a time, and
+// results are served from the result cache when enabled.
+func (uc *flightSearchUseCase) Explore(ctx context.Context, criteria domain.ExploreCriteria) (*domain.ExploreResponse, error) {
+	startTime := time.Now()
+
+	enabled := uc.registry.Enabled()
+	if len(enabled) == 0 {
+		return nil, domain.ErrAllProvidersFailed
+	}
+
+	// Check the span before building the date range, so long ranges are rejected cheaply
+	if days := criteria.Days(); days > uc.explore.MaxDays {
+		return nil, domain.WrapInvalidRequest("departure date range must not exceed %d days, got %d", uc.explore.MaxDays, days)
+	}
+	dates := criteria.Dates()
+
+	ctx, cancel := context.WithTimeout(ctx, uc.globalTimeout)
+	defer cancel()
+
+	// Skip providers not serving the origin or cabin class
+	providers, skippedProviders := coveringProviders(enabled, criteria.SearchCriteria(""))
+
+	// Scatter: query every provider for every date, limiting concurrency per provider
+	resultsChan := make(chan providerResult, len(providers)*len(dates))
+	var wg sync.WaitGroup
+	for _, provider := range providers {
+		slots := make(chan struct{}, uc.explore.ProviderConcurrency)
+		for _, date := range dates {
+			wg.Add(1)
+			go func(p domain.FlightProvider, search domain.SearchCriteria) {
+				defer wg.Done()
+				select {
+				case slots <- struct{}{}:
+					defer func() { <-slots }()
+				case <-ctx.Done():
+					resultsChan <- providerResult{Provider: p.Name(), Error: ctx.Err()}
+					return
+				}
+				uc.queryProvider(ctx, p, search, resultsChan)
+			}(provider, criteria.SearchCriteria(date))
+		}
+	}
+	wg.Wait()
+	close(resultsChan)
+
+	// Gather: a provider succeeds if at least one of its date queries succeeded
+	var allFlights []domain.Flight
+	succeeded := make(map[string]bool, len(providers))
+	rateLimited := make(map[string]bool)
+	cacheHit := len(providers) > 0
+	for result := range resultsChan {
+		cacheHit = cacheHit && result.Cached
+		if result.RateLimited {
+			rateLimited[result.Provider] = true
+			continue
+		}
+		if result.Error != nil {
+			log.Debug().
+				Str("provider", result.Provider).
+				Str("origin", criteria.Origin).
+				Err(result.Error).
+				Msg("Explore query failed")
+			continue
+		}
+		succeeded[result.Provider] = true
+		allFlights = append(allFlights, result.Flights...)
+	}
+
+	if len(providers) > 0 && len(succeeded) == 0 {
+		return nil, domain.ErrAllProvidersFailed
+	}
+
+	// A provider without a successful query is rate limited if any of its queries was
+	var failedProviders, rateLimitedProviders []string
+	for _, p := range providers {
+		switch {
+		case succeeded[p.Name()]:
+		case rateLimited[p.Name()]:
+			rateLimitedProviders = append(rateLimitedProviders, p.Name())
+		default:
+			failedProviders = append(failedProviders, p.Name())
+		}
+	}
+
+	allFlights = uc.currency.NormalizePrices(ctx, allFlights)
+	destinations := cheapestPerDestination(criteria.Origin, allFlights, criteria.Passengers)
+	metrics.ResultSetSize.WithLabelValues("explore").Observe(float64(len(destinations)))
+
+	return &domain.ExploreResponse{
+		Destinations: destinations,
+		Metadata: domain.SearchMetadata{
+			TotalResults:         len(destinations),
+			ProvidersQueried:     len(providers) - len(rateLimitedProviders),
+			ProvidersSucceeded:   len(succeeded),
+			ProvidersFailed:      len(failedProviders),
+			ProvidersSkipped:     len(skippedProviders),
+			ProvidersRateLimited: len(rateLimitedProviders),
+			FailedProviders:      failedProviders,
+			SkippedProviders:     skippedProviders,
+			RateLimitedProviders: rateLimitedProviders,
+			SearchTimeMs:         time.Since(startTime).Milliseconds(),
+			CacheHit:             cacheHit,
+		},
+	}, nil
+}
+
+// cheapestPerDestination returns the cheapest flight from origin to each destination,
+// totaled for the passengers, sorted by price, then destination code.
+func cheapestPerDestination(origin string, flights []domain.Flight, passengers int) []domain.DestinationFare {
+	cheapest := make(map[string]domain.Flight)
+	for _, f := range flights {
+		destination := f.Arrival.AirportCode
+		if f.Departure.AirportCode != origin || destination == origin {
+			continue
+		}
+		if current, ok := cheapest[destination]; !ok || f.Price.Amount < current.Price.Amount {
+			cheapest[destination] = f
+		}
+	}
+
+	destinations := make([]domain.DestinationFare, 0, len(cheapest))
+	for code, f := range cheapest {
+		f.Price.ApplyPassengers(passengers)
+		destinations = append(destinations, domain.DestinationFare{
+			Destination: code,
+			City:        f.Arrival.City,
+			Country:     f.Arrival.Country,
+			Flight:      f,
+		})
+	}
+
+	sort.Slice(destinations, func(i, j int) bool {
+		a, b := destinations[i], destinations[j]
+		if a.Flight.Price.Amount != b.Flight.Price.Amount {
+			return a.Flight.Price.Amount < b.Flight.Price.Amount
+		}
+		return a.Destination < b.Destination
+	})
+
+	return destinations
+}
@@ -0,0 +1,256 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scheduleProvider is a test provider filtering its flights like the real adapters:
+// by origin, by destination if given and by departure date. It records the peak
+// number of concurrent searches.
+type scheduleProvider struct {
+	name    string
+	flights []domain.Flight
+	err     error
+	delay   time.Duration
+
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+	calls       int
+}
+
+func (p *scheduleProvider) Name() string {
+	return p.name
+}
+
+func (p *scheduleProvider) Search(_ context.Context, criteria domain.SearchCriteria) ([]domain.Flight, error) {
+	p.mu.Lock()
+	p.calls++
+	p.inFlight++
+	p.maxInFlight = max(p.maxInFlight, p.inFlight)
+	p.mu.Unlock()
+	defer func() {
+		p.mu.Lock()
+		p.inFlight--
+		p.mu.Unlock()
+	}()
+
+	time.Sleep(p.delay)
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	var flights []domain.Flight
+	for _, f := range p.flights {
+		if f.Departure.AirportCode != criteria.Origin ||
+			(criteria.Destination != "" && f.Arrival.AirportCode != criteria.Destination) ||
+			f.Departure.DateTime.Format("2006-01-02") != criteria.DepartureDate {
+			continue
+		}
+		flights = append(flights, f)
+	}
+	return flights, nil
+}
+
+// exploreFlight builds a flight departing on the given day of December 2025.
+func exploreFlight(id, from, to string, day int, price float64) domain.Flight {
+	departure := time.Date(2025, 12, day, 8, 0, 0, 0, time.UTC)
+	return domain.Flight{
+		ID:        id,
+		Departure: domain.FlightPoint{AirportCode: from, DateTime: departure},
+		Arrival:   domain.FlightPoint{AirportCode: to, City: to + " City", DateTime: departure.Add(2 * time.Hour)},
+		Price:     domain.PriceInfo{Amount: price, Currency: "IDR"},
+	}
+}
+
+func TestExplore(t *testing.T) {
+	garuda := &scheduleProvider{name: "garuda", flights: []domain.Flight{
+		exploreFlight("ga-dps-15", "CGK", "DPS", 15, 1200000),
+		exploreFlight("ga-dps-16", "CGK", "DPS", 16, 900000),
+		exploreFlight("ga-kno-15", "CGK", "KNO", 15, 1500000),
+		exploreFlight("ga-dps-20", "CGK", "DPS", 20, 500000),  // outside the date range
+		exploreFlight("ga-sub-dps", "SUB", "DPS", 15, 300000), // other origin
+	}}
+	lion := &scheduleProvider{name: "lion_air", flights: []domain.Flight{
+		exploreFlight("jt-dps-17", "CGK", "DPS", 17, 950000),
+		exploreFlight("jt-sub-15", "CGK", "SUB", 15, 600000),
+	}}
+	failing := &scheduleProvider{name: "airasia", err: &domain.ProviderError{Provider: "airasia", Err: domain.ErrProviderUnavailable}}
+
+	uc := NewFlightSearchUseCase([]domain.FlightProvider{garuda, lion, failing}, nil)
+	criteria := domain.ExploreCriteria{
+		Origin:            "CGK",
+		DepartureDateFrom: "2025-12-15",
+		DepartureDateTo:   "2025-12-17",
+		Passengers:        2,
+		Class:             "economy",
+	}
+
+	result, err := uc.Explore(context.Background(), criteria)
+
+	require.NoError(t, err)
+	require.Len(t, result.Destinations, 3)
+
+	assert.Equal(t, "SUB", result.Destinations[0].Destination)
+	assert.Equal(t, "SUB City", result.Destinations[0].City)
+	assert.Equal(t, "jt-sub-15", result.Destinations[0].Flight.ID)
+
+	assert.Equal(t, "DPS", result.Destinations[1].Destination)
+	assert.Equal(t, "ga-dps-16", result.Destinations[1].Flight.ID)
+	assert.Equal(t, 1800000.0, result.Destinations[1].Flight.Price.Breakdown.Total, "totaled for passengers")
+
+	assert.Equal(t, "KNO", result.Destinations[2].Destination)
+
+	assert.Equal(t, 3, result.Metadata.TotalResults)
+	assert.Equal(t, 3, result.Metadata.ProvidersQueried)
+	assert.Equal(t, 2, result.Metadata.ProvidersSucceeded)
+	assert.Equal(t, 1, result.Metadata.ProvidersFailed)
+	assert.False(t, result.Metadata.CacheHit)
+	assert.Equal(t, 3, garuda.calls, "once per date")
+}
+
+func TestExplore_SkipsProvidersNotServingOrigin(t *testing.T) {
+	serving := &scheduleProvider{name: "garuda", flights: []domain.Flight{
+		exploreFlight("ga-dps-15", "CGK", "DPS", 15, 1200000),
+	}}
+	notServing := &coveringProvider{
+		countingProvider: countingProvider{mockProvider: mockProvider{name: "batik_air"}},
+		coverage:         domain.RouteCoverage{Routes: []domain.Route{{Origin: "SUB", Destination: "DPS"}}},
+	}
+
+	uc := NewFlightSearchUseCase([]domain.FlightProvider{serving, notServing}, nil)
+	result, err := uc.Explore(context.Background(), domain.ExploreCriteria{
+		Origin:            "CGK",
+		DepartureDateFrom: "2025-12-15",
+		DepartureDateTo:   "2025-12-16",
+		Passengers:        1,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, result.Destinations, 1)
+	assert.Zero(t, notServing.calls)
+	assert.Equal(t, 1, result.Metadata.ProvidersQueried)
+	assert.Equal(t, 1, result.Metadata.ProvidersSkipped)
+	assert.Equal(t, []string{"batik_air"}, result.Metadata.SkippedProviders)
+}
+
+func TestExplore_ReportsRateLimitedProviders(t *testing.T) {
+	garuda := &scheduleProvider{name: "garuda", flights: []domain.Flight{
+		exploreFlight("ga-dps-15", "CGK", "DPS", 15, 1200000),
+	}}
+	lionAir := &scheduleProvider{name: "lion_air", flights: []domain.Flight{
+		exploreFlight("jt-dps-16", "CGK", "DPS", 16, 800000),
+	}}
+	uc := NewFlightSearchUseCase([]domain.FlightProvider{garuda, lionAir}, &Config{
+		RateLimits: RateLimitConfig{
+			Providers: map[string]RateLimit{"lion_air": {RequestsPerSecond: 0.01, Burst: 1}},
+		},
+	})
+	criteria := domain.ExploreCriteria{
+		Origin:            "CGK",
+		DepartureDateFrom: "2025-12-15",
+		DepartureDateTo:   "2025-12-16",
+		Passengers:        1,
+	}
+
+	// One of the date queries gets the token, so the provider still succeeds
+	first, err := uc.Explore(context.Background(), criteria)
+	require.NoError(t, err)
+	assert.Equal(t, 2, first.Metadata.ProvidersSucceeded)
+	assert.Zero(t, first.Metadata.ProvidersRateLimited)
+	assert.Equal(t, 1, lionAir.calls)
+
+	second, err := uc.Explore(context.Background(), criteria)
+	require.NoError(t, err)
+	assert.Equal(t, 1, lionAir.calls, "rate-limited provider should not be queried")
+	assert.Equal(t, 1, second.Metadata.ProvidersQueried)
+	assert.Equal(t, 1, second.Metadata.ProvidersSucceeded)
+	assert.Zero(t, second.Metadata.ProvidersFailed)
+	assert.Equal(t, 1, second.Metadata.ProvidersRateLimited)
+	assert.Equal(t, []string{"lion_air"}, second.Metadata.RateLimitedProviders)
+}
+
+func TestExplore_LimitsProviderConcurrency(t *testing.T) {
+	provider := &scheduleProvider{name: "garuda", delay: 20 * time.Millisecond}
+	uc := NewFlightSearchUseCase([]domain.FlightProvider{provider}, &Config{
+		Explore: ExploreConfig{MaxDays: 7, ProviderConcurrency: 2},
+	})
+
+	_, err := uc.Explore(context.Background(), domain.ExploreCriteria{
+		Origin:            "CGK",
+		DepartureDateFrom: "2025-12-15",
+		DepartureDateTo:   "2025-12-21",
+		Passengers:        1,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 7, provider.calls)
+	assert.LessOrEqual(t, provider.maxInFlight, 2)
+}
+
+func TestExplore_UsesResultCache(t *testing.T) {
+	provider := &scheduleProvider{name: "garuda", flights: []domain.Flight{
+		exploreFlight("ga-dps-15", "CGK", "DPS", 15, 1200000),
+	}}
+	uc := NewFlightSearchUseCase([]domain.FlightProvider{provider}, &Config{ResultCacheTTL: time.Minute})
+	criteria := domain.ExploreCriteria{
+		Origin:            "CGK",
+		DepartureDateFrom: "2025-12-15",
+		DepartureDateTo:   "2025-12-16",
+		Passengers:        1,
+	}
+
+	first, err := uc.Explore(context.Background(), criteria)
+	require.NoError(t, err)
+	assert.False(t, first.Metadata.CacheHit)
+
+	second, err := uc.Explore(context.Background(), criteria)
+	require.NoError(t, err)
+	assert.True(t, second.Metadata.CacheHit)
+	assert.Equal(t, 2, provider.calls)
+	assert.Equal(t, first.Destinations, second.Destinations)
+}
+
+func TestExplore_Errors(t *testing.T) {
+	criteria := domain.ExploreCriteria{
+		Origin:            "CGK",
+		DepartureDateFrom: "2025-12-01",
+		DepartureDateTo:   "2025-12-31",
+		Passengers:        1,
+	}
+
+	t.Run("no providers", func(t *testing.T) {
+		uc := NewFlightSearchUseCase(nil, nil)
+		_, err := uc.Explore(context.Background(), criteria)
+		assert.ErrorIs(t, err, domain.ErrAllProvidersFailed)
+	})
+
+	t.Run("date range too long", func(t *testing.T) {
+		provider := &scheduleProvider{name: "garuda"}
+		uc := NewFlightSearchUseCase([]domain.FlightProvider{provider}, nil)
+
+		_, err := uc.Explore(context.Background(), criteria)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+		assert.Contains(t, err.Error(), "must not exceed 7 days, got 31")
+		assert.Zero(t, provider.calls)
+	})
+
+	t.Run("all providers fail", func(t *testing.T) {
+		provider := &scheduleProvider{name: "garuda", err: &domain.ProviderError{Provider: "garuda", Err: domain.ErrProviderUnavailable}}
+		uc := NewFlightSearchUseCase([]domain.FlightProvider{provider}, nil)
+
+		short := criteria
+		short.DepartureDateTo = "2025-12-02"
+		_, err := uc.Explore(context.Background(), short)
+
+		assert.ErrorIs(t, err, domain.ErrAllProvidersFailed)
+	})
+}
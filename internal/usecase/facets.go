@@ -0,0 +1,238 @@
+package usecase
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+)
+
+// priceHistogramBuckets is the number of equal-width buckets in the price histogram.
+const priceHistogramBuckets = 5
+
+// departureTimeBucket is a named time-of-day window used by the departure time facet.
+type departureTimeBucket struct {
+	value     string
+	label     string
+	startHour int // inclusive
+	endHour   int // exclusive
+}
+
+// departureTimeBuckets are the time-of-day windows, evaluated in the departure airport's local time.
+var departureTimeBuckets = []departureTimeBucket{
+	{value: "early_morning", label: "00:00 - 06:00", startHour: 0, endHour: 6},
+	{value: "morning", label: "06:00 - 12:00", startHour: 6, endHour: 12},
+	{value: "afternoon", label: "12:00 - 18:00", startHour: 12, endHour: 18},
+	{value: "evening", label: "18:00 - 24:00", startHour: 18, endHour: 24},
+}
+
+// ComputeFacets builds filter facets from the unfiltered gathered flights.
+// Facet values and bounds come from all flights; each facet's counts are computed
+// against the flights passing every active filter except the facet's own dimension,
+// so selecting one airline does not zero out the other airline counts.
+func ComputeFacets(flights []domain.Flight, opts *domain.FilterOptions) domain.SearchFacets {
+	return domain.SearchFacets{
+		Airlines: airlineFacet(flights, ApplyFilters(flights, withoutFilter(opts, func(f *domain.FilterOptions) {
+			f.Airlines = nil
+			f.ExcludedAirlines = nil
+		}))),
+		Stops: stopsFacet(flights, ApplyFilters(flights, withoutFilter(opts, func(f *domain.FilterOptions) {
+			f.MaxStops = nil
+		}))),
+		DepartureTimes: departureTimeFacet(ApplyFilters(flights, withoutFilter(opts, func(f *domain.FilterOptions) {
+			f.DepartureTimeRange = nil
+		}))),
+		Price: priceFacet(flights, ApplyFilters(flights, withoutFilter(opts, func(f *domain.FilterOptions) {
+			f.MinPrice = nil
+			f.MaxPrice = nil
+		}))),
+		Duration: durationFacet(flights, ApplyFilters(flights, withoutFilter(opts, func(f *domain.FilterOptions) {
+			f.DurationRange = nil
+		}))),
+		Amenities: amenityFacet(flights, ApplyFilters(flights, withoutFilter(opts, func(f *domain.FilterOptions) {
+			f.Amenities = nil
+		}))),
+	}
+}
+
+// withoutFilter returns a copy of opts with one dimension cleared by clear.
+// Returns nil if opts is nil.
+func withoutFilter(opts *domain.FilterOptions, clear func(*domain.FilterOptions)) *domain.FilterOptions {
+	if opts == nil {
+		return nil
+	}
+	copied := *opts
+	clear(&copied)
+	return &copied
+}
+
+// airlineFacet lists every airline in all, counted over matching.
+func airlineFacet(all, matching []domain.Flight) []domain.FacetCount {
+	labels := make(map[string]string)
+	for _, f := range all {
+		code := strings.ToUpper(f.Airline.Code)
+		if _, ok := labels[code]; !ok {
+			labels[code] = f.Airline.Name
+		}
+	}
+
+	counts := make(map[string]int, len(labels))
+	for _, f := range matching {
+		counts[strings.ToUpper(f.Airline.Code)]++
+	}
+
+	result := make([]domain.FacetCount, 0, len(labels))
+	for code, name := range labels {
+		result = append(result, domain.FacetCount{Value: code, Label: name, Count: counts[code]})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Value < result[j].Value
+	})
+	return result
+}
+
+// stopsFacet lists every stop count in all, counted over matching.
+func stopsFacet(all, matching []domain.Flight) []domain.FacetCount {
+	seen := make(map[int]struct{})
+	for _, f := range all {
+		seen[f.Stops] = struct{}{}
+	}
+
+	counts := make(map[int]int, len(seen))
+	for _, f := range matching {
+		counts[f.Stops]++
+	}
+
+	stops := make([]int, 0, len(seen))
+	for s := range seen {
+		stops = append(stops, s)
+	}
+	sort.Ints(stops)
+
+	result := make([]domain.FacetCount, 0, len(stops))
+	for _, s := range stops {
+		result = append(result, domain.FacetCount{Value: strconv.Itoa(s), Label: stopsLabel(s), Count: counts[s]})
+	}
+	return result
+}
+
+// stopsLabel returns a human-readable label for a stop count.
+func stopsLabel(stops int) string {
+	switch stops {
+	case 0:
+		return "Direct"
+	case 1:
+		return "1 stop"
+	default:
+		return strconv.Itoa(stops) + " stops"
+	}
+}
+
+// departureTimeFacet counts matching flights per departure time-of-day bucket.
+// All buckets are always returned, including empty ones.
+func departureTimeFacet(matching []domain.Flight) []domain.FacetCount {
+	result := make([]domain.FacetCount, len(departureTimeBuckets))
+	for i, b := range departureTimeBuckets {
+		result[i] = domain.FacetCount{Value: b.value, Label: b.label}
+	}
+
+	for _, f := range matching {
+		hour := f.Departure.LocalTime().Hour()
+		for i, b := range departureTimeBuckets {
+			if hour >= b.startHour && hour < b.endHour {
+				result[i].Count++
+				break
+			}
+		}
+	}
+	return result
+}
+
+// priceFacet builds the price bounds from all and a histogram of matching prices.
+// Bucket edges are derived from the bounds of all so they stay stable while filtering.
+func priceFacet(all, matching []domain.Flight) domain.PriceFacet {
+	if len(all) == 0 {
+		return domain.PriceFacet{Histogram: []domain.PriceBucket{}}
+	}
+
+	minPrice, maxPrice := findPriceRange(all)
+	facet := domain.PriceFacet{Min: minPrice, Max: maxPrice}
+
+	bucketCount := priceHistogramBuckets
+	if minPrice == maxPrice {
+		bucketCount = 1
+	}
+	width := (maxPrice - minPrice) / float64(bucketCount)
+
+	facet.Histogram = make([]domain.PriceBucket, bucketCount)
+	for i := range facet.Histogram {
+		facet.Histogram[i] = domain.PriceBucket{
+			Min: minPrice + float64(i)*width,
+			Max: minPrice + float64(i+1)*width,
+		}
+	}
+	facet.Histogram[bucketCount-1].Max = maxPrice
+
+	for _, f := range matching {
+		idx := bucketCount - 1
+		if width > 0 {
+			idx = int((f.Price.Amount - minPrice) / width)
+		}
+		if idx < 0 || f.Price.Amount < minPrice || f.Price.Amount > maxPrice {
+			continue
+		}
+		if idx >= bucketCount {
+			idx = bucketCount - 1
+		}
+		facet.Histogram[idx].Count++
+	}
+
+	return facet
+}
+
+// durationFacet builds the duration bounds from all and counts matching flights.
+func durationFacet(all, matching []domain.Flight) domain.DurationFacet {
+	if len(all) == 0 {
+		return domain.DurationFacet{}
+	}
+
+	minDuration, maxDuration := findDurationRange(all)
+	return domain.DurationFacet{
+		MinMinutes: minDuration,
+		MaxMinutes: maxDuration,
+		Count:      len(matching),
+	}
+}
+
+// amenityFacet lists every amenity offered in all, counted over matching.
+// Amenity names are normalized with domain.NormalizeAmenity.
+func amenityFacet(all, matching []domain.Flight) []domain.FacetCount {
+	seen := make(map[string]struct{})
+	for _, f := range all {
+		for _, a := range f.Amenities {
+			seen[domain.NormalizeAmenity(a)] = struct{}{}
+		}
+	}
+
+	counts := make(map[string]int, len(seen))
+	for _, f := range matching {
+		// Count each flight at most once per amenity
+		flightAmenities := make(map[string]struct{}, len(f.Amenities))
+		for _, a := range f.Amenities {
+			flightAmenities[domain.NormalizeAmenity(a)] = struct{}{}
+		}
+		for a := range flightAmenities {
+			counts[a]++
+		}
+	}
+
+	result := make([]domain.FacetCount, 0, len(seen))
+	for a := range seen {
+		result = append(result, domain.FacetCount{Value: a, Label: a, Count: counts[a]})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Value < result[j].Value
+	})
+	return result
+}
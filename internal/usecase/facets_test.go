@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func facetTestFlights() []domain.Flight {
+	day := time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC)
+	return []domain.Flight{
+		{
+			ID:        "ga1",
+			Airline:   domain.AirlineInfo{Code: "GA", Name: "Garuda Indonesia"},
+			Price:     domain.PriceInfo{Amount: 1000000},
+			Duration:  domain.DurationInfo{TotalMinutes: 110},
+			Departure: domain.FlightPoint{DateTime: day.Add(6 * time.Hour)},
+			Stops:     0,
+			Amenities: []string{"wifi", "meal"},
+		},
+		{
+			ID:        "ga2",
+			Airline:   domain.AirlineInfo{Code: "GA", Name: "Garuda Indonesia"},
+			Price:     domain.PriceInfo{Amount: 2000000},
+			Duration:  domain.DurationInfo{TotalMinutes: 300},
+			Departure: domain.FlightPoint{DateTime: day.Add(14 * time.Hour)},
+			Stops:     1,
+			Amenities: []string{"WiFi"},
+		},
+		{
+			ID:        "jt1",
+			Airline:   domain.AirlineInfo{Code: "JT", Name: "Lion Air"},
+			Price:     domain.PriceInfo{Amount: 500000},
+			Duration:  domain.DurationInfo{TotalMinutes: 105},
+			Departure: domain.FlightPoint{DateTime: day.Add(5 * time.Hour)},
+			Stops:     0,
+		},
+		{
+			ID:        "jt2",
+			Airline:   domain.AirlineInfo{Code: "JT", Name: "Lion Air"},
+			Price:     domain.PriceInfo{Amount: 700000},
+			Duration:  domain.DurationInfo{TotalMinutes: 230},
+			Departure: domain.FlightPoint{DateTime: day.Add(19 * time.Hour)},
+			Stops:     1,
+			Amenities: []string{"meal"},
+		},
+	}
+}
+
+func TestComputeFacets_NoFilters(t *testing.T) {
+	facets := ComputeFacets(facetTestFlights(), nil)
+
+	assert.Equal(t, []domain.FacetCount{
+		{Value: "GA", Label: "Garuda Indonesia", Count: 2},
+		{Value: "JT", Label: "Lion Air", Count: 2},
+	}, facets.Airlines)
+
+	assert.Equal(t, []domain.FacetCount{
+		{Value: "0", Label: "Direct", Count: 2},
+		{Value: "1", Label: "1 stop", Count: 2},
+	}, facets.Stops)
+
+	assert.Equal(t, []domain.FacetCount{
+		{Value: "early_morning", Label: "00:00 - 06:00", Count: 1},
+		{Value: "morning", Label: "06:00 - 12:00", Count: 1},
+		{Value: "afternoon", Label: "12:00 - 18:00", Count: 1},
+		{Value: "evening", Label: "18:00 - 24:00", Count: 1},
+	}, facets.DepartureTimes)
+
+	assert.Equal(t, 500000.0, facets.Price.Min)
+	assert.Equal(t, 2000000.0, facets.Price.Max)
+	require.Len(t, facets.Price.Histogram, priceHistogramBuckets)
+	histogramTotal := 0
+	for _, b := range facets.Price.Histogram {
+		histogramTotal += b.Count
+	}
+	assert.Equal(t, 4, histogramTotal)
+	assert.Equal(t, 2000000.0, facets.Price.Histogram[priceHistogramBuckets-1].Max)
+	assert.Equal(t, 1, facets.Price.Histogram[priceHistogramBuckets-1].Count)
+
+	assert.Equal(t, domain.DurationFacet{MinMinutes: 105, MaxMinutes: 300, Count: 4}, facets.Duration)
+
+	assert.Equal(t, []domain.FacetCount{
+		{Value: "meal", Label: "meal", Count: 2},
+		{Value: "wifi", Label: "wifi", Count: 2},
+	}, facets.Amenities)
+}
+
+func TestComputeFacets_CountsReflectOtherFilters(t *testing.T) {
+	opts := &domain.FilterOptions{
+		Airlines: []string{"GA"},
+		MaxStops: ptrInt(0),
+	}
+
+	facets := ComputeFacets(facetTestFlights(), opts)
+
+	// Airline counts ignore the airline filter but apply maxStops
+	assert.Equal(t, []domain.FacetCount{
+		{Value: "GA", Label: "Garuda Indonesia", Count: 1},
+		{Value: "JT", Label: "Lion Air", Count: 1},
+	}, facets.Airlines)
+
+	// Stop counts ignore maxStops but apply the airline filter
+	assert.Equal(t, []domain.FacetCount{
+		{Value: "0", Label: "Direct", Count: 1},
+		{Value: "1", Label: "1 stop", Count: 1},
+	}, facets.Stops)
+
+	// Bounds still cover every gathered flight
+	assert.Equal(t, 500000.0, facets.Price.Min)
+	assert.Equal(t, 2000000.0, facets.Price.Max)
+	assert.Equal(t, 105, facets.Duration.MinMinutes)
+	assert.Equal(t, 300, facets.Duration.MaxMinutes)
+	assert.Equal(t, 1, facets.Duration.Count)
+
+	// Amenities apply all filters, but still list every amenity
+	assert.Equal(t, []domain.FacetCount{
+		{Value: "meal", Label: "meal", Count: 1},
+		{Value: "wifi", Label: "wifi", Count: 1},
+	}, facets.Amenities)
+}
+
+func TestComputeFacets_DoesNotMutateFilters(t *testing.T) {
+	opts := &domain.FilterOptions{
+		Airlines: []string{"GA"},
+		MaxPrice: ptrFloat64(1500000),
+	}
+
+	ComputeFacets(facetTestFlights(), opts)
+
+	assert.Equal(t, []string{"GA"}, opts.Airlines)
+	require.NotNil(t, opts.MaxPrice)
+	assert.Equal(t, 1500000.0, *opts.MaxPrice)
+}
+
+func TestComputeFacets_EmptyFlights(t *testing.T) {
+	facets := ComputeFacets(nil, nil)
+
+	assert.Empty(t, facets.Airlines)
+	assert.Empty(t, facets.Stops)
+	assert.Len(t, facets.DepartureTimes, len(departureTimeBuckets))
+	assert.Empty(t, facets.Price.Histogram)
+	assert.Equal(t, domain.DurationFacet{}, facets.Duration)
+	assert.Empty(t, facets.Amenities)
+}
+
+func TestPriceFacet_SinglePrice(t *testing.T) {
+	flights := []domain.Flight{
+		{Price: domain.PriceInfo{Amount: 800000}},
+		{Price: domain.PriceInfo{Amount: 800000}},
+	}
+
+	facet := priceFacet(flights, flights)
+
+	require.Len(t, facet.Histogram, 1)
+	assert.Equal(t, domain.PriceBucket{Min: 800000, Max: 800000, Count: 2}, facet.Histogram[0])
+}
+
+func TestStopsLabel(t *testing.T) {
+	assert.Equal(t, "Direct", stopsLabel(0))
+	assert.Equal(t, "1 stop", stopsLabel(1))
+	assert.Equal(t, "3 stops", stopsLabel(3))
+}
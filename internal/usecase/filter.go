@@ -1,8 +1,6 @@
 package usecase
 
 import (
-	"strings"
-
 	"github.com/herdiagusthio/flight-search-system/domain"
 )
 
@@ -14,17 +12,13 @@ func ApplyFilters(flights []domain.Flight, opts *domain.FilterOptions) []domain.
 		return flights
 	}
 
-	// Pre-build airline set for O(1) lookup if airlines filter is provided
-	var airlineSet map[string]struct{}
-	if len(opts.Airlines) > 0 {
-		airlineSet = buildAirlineSet(opts.Airlines)
-	}
+	matches := opts.Matcher()
 
 	// Pre-allocate with estimated capacity
 	result := make([]domain.Flight, 0, len(flights))
 
 	for _, f := range flights {
-		if passesAllFilters(f, opts, airlineSet) {
+		if matches(f) {
 			result = append(result, f)
 		}
 	}
@@ -32,57 +26,6 @@ func ApplyFilters(flights []domain.Flight, opts *domain.FilterOptions) []domain.
 	return result
 }
 
-// passesAllFilters checks if a flight passes all filter criteria.
-func passesAllFilters(f domain.Flight, opts *domain.FilterOptions, airlineSet map[string]struct{}) bool {
-	// Price filter: include flights where price <= maxPrice
-	if opts.MaxPrice != nil && f.Price.Amount > *opts.MaxPrice {
-		return false
-	}
-
-	// Stops filter: include flights where stops <= maxStops
-	if opts.MaxStops != nil && f.Stops > *opts.MaxStops {
-		return false
-	}
-
-	// Airlines filter: include flights where airline code is in whitelist
-	if len(opts.Airlines) > 0 && !isAirlineInSet(f.Airline.Code, airlineSet) {
-		return false
-	}
-
-	// Departure time range filter: include flights departing within the range
-	if opts.DepartureTimeRange != nil && !opts.DepartureTimeRange.Contains(f.Departure.DateTime) {
-		return false
-	}
-
-	// Arrival time range filter: include flights arriving within the range
-	if opts.ArrivalTimeRange != nil && !opts.ArrivalTimeRange.Contains(f.Arrival.DateTime) {
-		return false
-	}
-
-	// Duration range filter: include flights with duration within the range
-	if opts.DurationRange != nil && !opts.DurationRange.Contains(f.Duration.TotalMinutes) {
-		return false
-	}
-
-	return true
-}
-
-// buildAirlineSet creates a case-insensitive lookup set from airline codes.
-func buildAirlineSet(airlines []string) map[string]struct{} {
-	set := make(map[string]struct{}, len(airlines))
-	for _, code := range airlines {
-		// Store uppercase for case-insensitive matching
-		set[strings.ToUpper(code)] = struct{}{}
-	}
-	return set
-}
-
-// isAirlineInSet checks if an airline code is in the allowed set (case-insensitive).
-func isAirlineInSet(code string, set map[string]struct{}) bool {
-	_, exists := set[strings.ToUpper(code)]
-	return exists
-}
-
 // FilterByMaxPrice filters flights by maximum price.
 // Returns all flights if maxPrice is nil.
 func FilterByMaxPrice(flights []domain.Flight, maxPrice *float64) []domain.Flight {
@@ -122,18 +65,18 @@ func FilterByAirlines(flights []domain.Flight, airlines []string) []domain.Fligh
 		return flights
 	}
 
-	airlineSet := buildAirlineSet(airlines)
+	matches := (&domain.FilterOptions{Airlines: airlines}).Matcher()
 	result := make([]domain.Flight, 0, len(flights))
 
 	for _, f := range flights {
-		if isAirlineInSet(f.Airline.Code, airlineSet) {
+		if matches(f) {
 			result = append(result, f)
 		}
 	}
 	return result
 }
 
-// FilterByDepartureTime filters flights by departure time range.
+// FilterByDepartureTime filters flights by departure time range in the departure airport's local time.
 // Returns all flights if timeRange is nil.
 func FilterByDepartureTime(flights []domain.Flight, timeRange *domain.TimeRange) []domain.Flight {
 	if timeRange == nil {
@@ -142,14 +85,14 @@ func FilterByDepartureTime(flights []domain.Flight, timeRange *domain.TimeRange)
 
 	result := make([]domain.Flight, 0, len(flights))
 	for _, f := range flights {
-		if timeRange.Contains(f.Departure.DateTime) {
+		if timeRange.Contains(f.Departure.LocalTime()) {
 			result = append(result, f)
 		}
 	}
 	return result
 }
 
-// FilterByArrivalTime filters flights by arrival time range.
+// FilterByArrivalTime filters flights by arrival time range in the arrival airport's local time.
 // Returns all flights if timeRange is nil.
 func FilterByArrivalTime(flights []domain.Flight, timeRange *domain.TimeRange) []domain.Flight {
 	if timeRange == nil {
@@ -158,7 +101,7 @@ func FilterByArrivalTime(flights []domain.Flight, timeRange *domain.TimeRange) [
 
 	result := make([]domain.Flight, 0, len(flights))
 	for _, f := range flights {
-		if timeRange.Contains(f.Arrival.DateTime) {
+		if timeRange.Contains(f.Arrival.LocalTime()) {
 			result = append(result, f)
 		}
 	}
@@ -17,18 +17,25 @@ func TestApplyFilters(t *testing.T) {
 			Price: domain.PriceInfo{Amount: 500000, Currency: "IDR"},
 			Stops: 0,
 			Airline: domain.AirlineInfo{Code: "GA", Name: "Garuda"},
-			Departure: domain.FlightPoint{DateTime: baseTime.Add(2 * time.Hour)},
-			Arrival:   domain.FlightPoint{DateTime: baseTime.Add(4 * time.Hour)},
+			Departure: domain.FlightPoint{DateTime: baseTime.Add(2 * time.Hour), Terminal: "3"},
+			Arrival:   domain.FlightPoint{DateTime: baseTime.Add(4 * time.Hour), Terminal: "I"},
 			Duration:  domain.DurationInfo{TotalMinutes: 120},
+			Baggage:   domain.BaggageInfo{CheckedKg: 20},
+			Aircraft:  "Boeing 737-800",
+			Amenities: []string{"wifi", "meal"},
 		},
 		{
 			ID:    "f2",
 			Price: domain.PriceInfo{Amount: 800000, Currency: "IDR"},
 			Stops: 1,
 			Airline: domain.AirlineInfo{Code: "JT", Name: "Lion Air"},
-			Departure: domain.FlightPoint{DateTime: baseTime.Add(6 * time.Hour)},
-			Arrival:   domain.FlightPoint{DateTime: baseTime.Add(9 * time.Hour)},
+			Departure: domain.FlightPoint{DateTime: baseTime.Add(6 * time.Hour), Terminal: "1A"},
+			Arrival:   domain.FlightPoint{DateTime: baseTime.Add(9 * time.Hour), Terminal: "2"},
 			Duration:  domain.DurationInfo{TotalMinutes: 180},
+			Baggage:   domain.BaggageInfo{CheckedKg: 20},
+			Aircraft:  "Boeing 737-900ER",
+			Amenities: []string{"wifi"},
+			Layovers:  []domain.Layover{{AirportCode: "SUB", DurationMinutes: 40}},
 		},
 		{
 			ID:    "f3",
@@ -38,6 +45,11 @@ func TestApplyFilters(t *testing.T) {
 			Departure: domain.FlightPoint{DateTime: baseTime.Add(12 * time.Hour)},
 			Arrival:   domain.FlightPoint{DateTime: baseTime.Add(16 * time.Hour)},
 			Duration:  domain.DurationInfo{TotalMinutes: 240},
+			Aircraft:  "Airbus A320",
+			Layovers: []domain.Layover{
+				{AirportCode: "UPG", DurationMinutes: 60},
+				{AirportCode: "SOC", DurationMinutes: 90, Overnight: true},
+			},
 		},
 	}
 
@@ -136,6 +148,103 @@ func TestApplyFilters(t *testing.T) {
 			expected: 0,
 			checkIDs: []string{},
 		},
+		{
+			name: "filter by min price",
+			filters: &domain.FilterOptions{
+				MinPrice: ptrFloat64(600000),
+			},
+			expected: 2,
+			checkIDs: []string{"f2", "f3"},
+		},
+		{
+			name: "filter by price band",
+			filters: &domain.FilterOptions{
+				MinPrice: ptrFloat64(600000),
+				MaxPrice: ptrFloat64(1000000),
+			},
+			expected: 1,
+			checkIDs: []string{"f2"},
+		},
+		{
+			name: "filter by excluded airlines",
+			filters: &domain.FilterOptions{
+				ExcludedAirlines: []string{"qz"},
+			},
+			expected: 2,
+			checkIDs: []string{"f1", "f2"},
+		},
+		{
+			name: "filter by required amenities",
+			filters: &domain.FilterOptions{
+				Amenities: []string{"wifi", "meals"},
+			},
+			expected: 1,
+			checkIDs: []string{"f1"},
+		},
+		{
+			name: "filter by min checked baggage",
+			filters: &domain.FilterOptions{
+				MinCheckedBaggageKg: ptrInt(15),
+			},
+			expected: 2,
+			checkIDs: []string{"f1", "f2"},
+		},
+		{
+			name: "filter by aircraft family",
+			filters: &domain.FilterOptions{
+				Aircraft: []string{"a320"},
+			},
+			expected: 1,
+			checkIDs: []string{"f3"},
+		},
+		{
+			name: "filter by departure terminal",
+			filters: &domain.FilterOptions{
+				DepartureTerminals: []string{"3"},
+			},
+			expected: 1,
+			checkIDs: []string{"f1"},
+		},
+		{
+			name: "filter by arrival terminal",
+			filters: &domain.FilterOptions{
+				ArrivalTerminals: []string{"i", "2"},
+			},
+			expected: 2,
+			checkIDs: []string{"f1", "f2"},
+		},
+		{
+			name: "filter by minimum layover duration",
+			filters: &domain.FilterOptions{
+				LayoverDurationRange: &domain.DurationRange{MinMinutes: ptrInt(45)},
+			},
+			expected: 2,
+			checkIDs: []string{"f1", "f3"},
+		},
+		{
+			name: "filter by maximum layover duration",
+			filters: &domain.FilterOptions{
+				LayoverDurationRange: &domain.DurationRange{MaxMinutes: ptrInt(60)},
+			},
+			expected: 2,
+			checkIDs: []string{"f1", "f2"},
+		},
+		{
+			name: "filter by excluded connection airport",
+			filters: &domain.FilterOptions{
+				ExcludedConnectionAirports: []string{"sub"},
+			},
+			expected: 2,
+			checkIDs: []string{"f1", "f3"},
+		},
+		{
+			name: "filter out overnight layovers",
+			filters: &domain.FilterOptions{
+				NoOvernightLayovers: true,
+			},
+			expected: 2,
+			checkIDs: []string{"f1", "f2"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -264,6 +373,14 @@ func TestFilterByDepartureTime(t *testing.T) {
 			},
 			1, // f3 (18:00)
 		},
+		{
+			"range crossing midnight",
+			&domain.TimeRange{
+				Start: time.Date(0, 1, 1, 17, 0, 0, 0, time.UTC), // 17:00
+				End:   time.Date(0, 1, 1, 7, 0, 0, 0, time.UTC),  // 07:00
+			},
+			2, // f1 (06:00) and f3 (18:00)
+		},
 	}
 
 	for _, tt := range tests {
@@ -274,6 +391,22 @@ func TestFilterByDepartureTime(t *testing.T) {
 	}
 }
 
+func TestFilterByDepartureTime_AirportLocalTime(t *testing.T) {
+	// Same instant, parsed in different locations by different normalizers
+	instant := time.Date(2025, 12, 15, 0, 30, 0, 0, time.UTC) // 07:30 WIB
+	flights := []domain.Flight{
+		{ID: "utc", Departure: domain.FlightPoint{DateTime: instant, Timezone: "Asia/Jakarta"}},
+		{ID: "offset", Departure: domain.FlightPoint{DateTime: instant.In(time.FixedZone("", 7*3600)), Timezone: "Asia/Jakarta"}},
+	}
+
+	result := FilterByDepartureTime(flights, &domain.TimeRange{
+		Start: time.Date(0, 1, 1, 6, 0, 0, 0, time.UTC),
+		End:   time.Date(0, 1, 1, 9, 0, 0, 0, time.UTC),
+	})
+
+	assert.Len(t, result, 2)
+}
+
 func TestFilterByArrivalTime(t *testing.T) {
 	baseTime := time.Date(2024, 12, 25, 10, 0, 0, 0, time.UTC)
 
@@ -337,55 +470,6 @@ func TestFilterByDuration(t *testing.T) {
 	}
 }
 
-func TestBuildAirlineSet(t *testing.T) {
-	tests := []struct {
-		name     string
-		input    []string
-		checkKey string
-		exists   bool
-	}{
-		{"uppercase stored as uppercase", []string{"GA"}, "GA", true},
-		{"lowercase converted to uppercase", []string{"ga"}, "GA", true},
-		{"mixed case converted", []string{"Ga"}, "GA", true},
-		{"multiple airlines", []string{"GA", "jt", "Qz"}, "JT", true},
-		{"not in set", []string{"GA"}, "JT", false},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			set := buildAirlineSet(tt.input)
-			_, exists := set[tt.checkKey]
-			assert.Equal(t, tt.exists, exists)
-		})
-	}
-}
-
-func TestIsAirlineInSet(t *testing.T) {
-	set := map[string]struct{}{
-		"GA": {},
-		"JT": {},
-		"QZ": {},
-	}
-
-	tests := []struct {
-		name   string
-		code   string
-		exists bool
-	}{
-		{"exact match uppercase", "GA", true},
-		{"lowercase match", "ga", true},
-		{"mixed case match", "Ga", true},
-		{"not in set", "ID", false},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := isAirlineInSet(tt.code, set)
-			assert.Equal(t, tt.exists, result)
-		})
-	}
-}
-
 // Helper functions
 func ptrFloat64(v float64) *float64 { return &v }
 func ptrInt(v int) *int              { return &v }
@@ -2,15 +2,23 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"math/rand"
+	"slices"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/internal/metrics"
 	"github.com/herdiagusthio/flight-search-system/pkg/util"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 //go:generate mockgen -destination=flight_search_mock.go -package=usecase github.com/flight-search/flight-search-and-aggregation-system/internal/usecase FlightSearchUseCase
@@ -21,18 +29,31 @@ const (
 	DefaultProviderTimeout = 2 * time.Second
 )
 
+// tracer creates the spans of the use case operations.
+var tracer = otel.Tracer("github.com/herdiagusthio/flight-search-system/internal/usecase")
+
 // FlightSearchUseCase defines flight search operations.
 type FlightSearchUseCase interface {
 	// Search queries all providers and returns aggregated results.
 	Search(ctx context.Context, criteria domain.SearchCriteria, opts SearchOptions) (*domain.SearchResponse, error)
+	// Explore queries all providers for every destination served from the origin over the
+	// date range and returns the cheapest fare per destination.
+	Explore(ctx context.Context, criteria domain.ExploreCriteria) (*domain.ExploreResponse, error)
 }
 
 // flightSearchUseCase implements FlightSearchUseCase.
 type flightSearchUseCase struct {
-	providers       []domain.FlightProvider
+	registry        domain.ProviderRegistry
 	globalTimeout   time.Duration
 	providerTimeout time.Duration
 	retryConfig     util.RetryConfig
+	currency        *CurrencyConverter
+	rankingWeights  RankingWeights
+	ranker          *Ranker
+	selfTransfer    SelfTransferConfig
+	explore         ExploreConfig
+	cache           *resultCache
+	rateLimits      *rateLimiters
 }
 
 // Config contains configuration options for the use case.
@@ -40,6 +61,32 @@ type Config struct {
 	GlobalTimeout   time.Duration
 	ProviderTimeout time.Duration
 	RetryConfig     util.RetryConfig
+
+	// ExchangeRates converts provider prices to BaseCurrency and to display currencies.
+	// If nil, only prices already in BaseCurrency are supported.
+	ExchangeRates domain.ExchangeRateProvider
+	// BaseCurrency is the currency used for filtering and ranking (default IDR).
+	BaseCurrency string
+
+	// RankingWeights are the weights of the balanced ranking profile.
+	// Empty or all-zero weights mean DefaultRankingWeights.
+	RankingWeights RankingWeights
+	// ScoringComponents are scoring factors added to the built-in ones, weighted by name.
+	ScoringComponents []ScoringComponent
+
+	// SelfTransfer configures virtual connections of separately ticketed flights.
+	// Zero values other than Enabled mean the defaults.
+	SelfTransfer SelfTransferConfig
+	// Explore configures explore searches. Zero values mean the defaults.
+	Explore ExploreConfig
+
+	// ResultCacheTTL is how long successful provider results are cached.
+	// Zero disables caching.
+	ResultCacheTTL time.Duration
+
+	// RateLimits limits the queries sent to each provider. Providers over their limit
+	// are skipped and reported as rate limited. Zero limits mean no limit.
+	RateLimits RateLimitConfig
 }
 
 // DefaultConfig returns the default configuration.
@@ -48,12 +95,24 @@ func DefaultConfig() Config {
 		GlobalTimeout:   DefaultGlobalTimeout,
 		ProviderTimeout: DefaultProviderTimeout,
 		RetryConfig:     util.DefaultRetryConfig(),
+		BaseCurrency:    DefaultBaseCurrency,
+		RankingWeights:  DefaultRankingWeights(),
+		SelfTransfer:    DefaultSelfTransferConfig(),
+		Explore:         DefaultExploreConfig(),
+		RateLimits:      RateLimitConfig{QueueTimeout: DefaultRateLimitQueueTimeout},
 	}
 }
 
-// NewFlightSearchUseCase creates a FlightSearchUseCase.
+// NewFlightSearchUseCase creates a FlightSearchUseCase querying the given providers.
 // Uses default timeouts if config is nil.
 func NewFlightSearchUseCase(providers []domain.FlightProvider, config *Config) FlightSearchUseCase {
+	return NewFlightSearchUseCaseWithRegistry(domain.NewProviderRegistryWith(providers...), config)
+}
+
+// NewFlightSearchUseCaseWithRegistry creates a FlightSearchUseCase querying the enabled
+// providers of the registry, so that providers can be enabled, disabled and reweighted
+// at runtime. Uses default timeouts if config is nil.
+func NewFlightSearchUseCaseWithRegistry(registry domain.ProviderRegistry, config *Config) FlightSearchUseCase {
 	cfg := DefaultConfig()
 	if config != nil {
 		if config.GlobalTimeout > 0 {
@@ -65,45 +124,138 @@ func NewFlightSearchUseCase(providers []domain.FlightProvider, config *Config) F
 		if config.RetryConfig.MaxAttempts > 0 {
 			cfg.RetryConfig = config.RetryConfig
 		}
+		if config.BaseCurrency != "" {
+			cfg.BaseCurrency = config.BaseCurrency
+		}
+		if !config.RankingWeights.isZero() {
+			cfg.RankingWeights = config.RankingWeights
+		}
+		cfg.ScoringComponents = config.ScoringComponents
+		cfg.ExchangeRates = config.ExchangeRates
+
+		if config.SelfTransfer.Enabled {
+			// A zero saving is meaningful: show every self-transfer
+			cfg.SelfTransfer.Enabled = true
+			cfg.SelfTransfer.MinSaving = config.SelfTransfer.MinSaving
+		}
+		if len(config.SelfTransfer.Hubs) > 0 {
+			cfg.SelfTransfer.Hubs = config.SelfTransfer.Hubs
+		}
+		if config.SelfTransfer.DefaultMinConnection > 0 {
+			cfg.SelfTransfer.DefaultMinConnection = config.SelfTransfer.DefaultMinConnection
+		}
+		if config.SelfTransfer.MaxConnection > 0 {
+			cfg.SelfTransfer.MaxConnection = config.SelfTransfer.MaxConnection
+		}
+
+		if config.Explore.MaxDays > 0 {
+			cfg.Explore.MaxDays = config.Explore.MaxDays
+		}
+		if config.Explore.ProviderConcurrency > 0 {
+			cfg.Explore.ProviderConcurrency = config.Explore.ProviderConcurrency
+		}
+		cfg.ResultCacheTTL = config.ResultCacheTTL
+		cfg.RateLimits.Default = config.RateLimits.Default
+		cfg.RateLimits.Providers = config.RateLimits.Providers
+		if config.RateLimits.QueueTimeout != 0 {
+			cfg.RateLimits.QueueTimeout = config.RateLimits.QueueTimeout
+		}
 	}
 
 	return &flightSearchUseCase{
-		providers:       providers,
+		registry:        registry,
 		globalTimeout:   cfg.GlobalTimeout,
 		providerTimeout: cfg.ProviderTimeout,
 		retryConfig:     cfg.RetryConfig,
+		currency:        NewCurrencyConverter(cfg.ExchangeRates, cfg.BaseCurrency),
+		rankingWeights:  cfg.RankingWeights,
+		ranker:          NewRanker(cfg.ScoringComponents...),
+		selfTransfer:    cfg.SelfTransfer,
+		explore:         cfg.Explore,
+		cache:           newResultCache(cfg.ResultCacheTTL),
+		rateLimits:      newRateLimiters(cfg.RateLimits),
 	}
 }
 
 // providerResult holds the result from a single provider query.
 type providerResult struct {
-	Provider string
-	Flights  []domain.Flight
-	Error    error
-	Duration time.Duration
+	Provider    string
+	Flights     []domain.Flight
+	Error       error
+	Duration    time.Duration
+	Cached      bool // Whether the flights were served from the result cache
+	RateLimited bool // Whether the provider was skipped for being over its rate limit
 }
 
 // Search implements FlightSearchUseCase.Search using Scatter-Gather pattern.
+// The search is traced in a span, with a child span per provider call.
 func (uc *flightSearchUseCase) Search(ctx context.Context, criteria domain.SearchCriteria, opts SearchOptions) (*domain.SearchResponse, error) {
+	ctx, span := tracer.Start(ctx, "FlightSearchUseCase.Search", trace.WithAttributes(
+		attribute.String("origin", criteria.Origin),
+		attribute.String("destination", criteria.Destination),
+		attribute.String("departure_date", criteria.DepartureDate),
+		attribute.Int("passengers", criteria.Passengers),
+	))
+	defer span.End()
+
+	result, err := uc.search(ctx, criteria, opts)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.Int("flight_count", len(result.Flights)),
+		attribute.Int("providers_queried", result.Metadata.ProvidersQueried),
+		attribute.Int("providers_failed", result.Metadata.ProvidersFailed),
+		attribute.Int("providers_rate_limited", result.Metadata.ProvidersRateLimited),
+		attribute.Bool("cache_hit", result.Metadata.CacheHit),
+	)
+	return result, nil
+}
+
+// search gathers, filters, ranks and sorts the flights of all providers serving the criteria.
+func (uc *flightSearchUseCase) search(ctx context.Context, criteria domain.SearchCriteria, opts SearchOptions) (*domain.SearchResponse, error) {
 	startTime := time.Now()
 
-	// Handle case with no providers
-	if len(uc.providers) == 0 {
+	// Handle case with no enabled providers
+	enabled := uc.registry.Enabled()
+	if len(enabled) == 0 {
 		return nil, domain.ErrAllProvidersFailed
 	}
 
+	// Reject display currencies that cannot be converted before querying providers
+	if opts.DisplayCurrency != "" {
+		if _, err := uc.currency.Rate(ctx, uc.currency.Base(), opts.DisplayCurrency); err != nil {
+			return nil, domain.WrapInvalidRequest("currency %q is not supported: %v", opts.DisplayCurrency, err)
+		}
+	}
+
+	// Resolve the ranking profile before querying providers
+	weights, err := resolveRankingWeights(opts.RankingProfile, uc.rankingWeights)
+	if err != nil {
+		return nil, domain.WrapInvalidRequest("%v", err)
+	}
+	if err := uc.ranker.ValidateWeights(weights); err != nil {
+		return nil, fmt.Errorf("invalid ranking weights: %w", err)
+	}
+
 	// Create context with global timeout
 	ctx, cancel := context.WithTimeout(ctx, uc.globalTimeout)
 	defer cancel()
 
+	// Skip providers whose declared coverage excludes the route
+	providers, skippedProviders := coveringProviders(enabled, criteria)
+
 	// Buffered channel to prevent goroutine blocking
-	resultsChan := make(chan providerResult, len(uc.providers))
+	resultsChan := make(chan providerResult, len(providers))
 
 	// WaitGroup to track goroutine completion
 	var wg sync.WaitGroup
 
-	// Scatter: launch goroutines for each provider
-	for _, provider := range uc.providers {
+	// Scatter: launch goroutines for each covering provider
+	for _, provider := range providers {
 		wg.Add(1)
 		go func(p domain.FlightProvider) {
 			defer wg.Done()
@@ -120,10 +272,17 @@ func (uc *flightSearchUseCase) Search(ctx context.Context, criteria domain.Searc
 	// Gather: collect results
 	var allFlights []domain.Flight
 	var failedProviders []string
-	queriedProviders := make([]string, 0, len(uc.providers))
+	var rateLimitedProviders []string
+	queriedProviders := make([]string, 0, len(providers))
+	cacheHit := len(providers) > 0
 
 	for result := range resultsChan {
+		if result.RateLimited {
+			rateLimitedProviders = append(rateLimitedProviders, result.Provider)
+			continue
+		}
 		queriedProviders = append(queriedProviders, result.Provider)
+		cacheHit = cacheHit && result.Cached
 		if result.Error != nil {
 			failedProviders = append(failedProviders, result.Provider)
 			continue
@@ -132,16 +291,10 @@ func (uc *flightSearchUseCase) Search(ctx context.Context, criteria domain.Searc
 	}
 
 	// Check if context was cancelled before we got all results
-	if ctx.Err() != nil && len(queriedProviders) < len(uc.providers) {
+	if ctx.Err() != nil && len(queriedProviders)+len(rateLimitedProviders) < len(providers) {
 		// Record remaining providers as failed
-		for _, p := range uc.providers {
-			found := false
-			for _, q := range queriedProviders {
-				if q == p.Name() {
-					found = true
-					break
-				}
-			}
+		for _, p := range providers {
+			found := slices.Contains(queriedProviders, p.Name()) || slices.Contains(rateLimitedProviders, p.Name())
 			if !found {
 				queriedProviders = append(queriedProviders, p.Name())
 				failedProviders = append(failedProviders, p.Name())
@@ -149,40 +302,185 @@ func (uc *flightSearchUseCase) Search(ctx context.Context, criteria domain.Searc
 		}
 	}
 
-	// Check if all providers failed
-	if len(failedProviders) == len(uc.providers) {
+	// Check if all covering providers failed or were rate limited; a route no provider
+	// serves is not a failure
+	if len(providers) > 0 && len(failedProviders)+len(rateLimitedProviders) == len(providers) {
 		return nil, domain.ErrAllProvidersFailed
 	}
+	sort.Strings(failedProviders)
+	sort.Strings(rateLimitedProviders)
+
+	// Normalize prices to the base currency so they can be compared
+	allFlights = uc.currency.NormalizePrices(ctx, allFlights)
+
+	// Rank self-transfers alongside regular flights when there are no regular flights
+	// or they are much more expensive; the legs are only queried once the regular
+	// results show how cheap a self-transfer must be
+	if uc.selfTransfer.Enabled {
+		maxPrice := selfTransferMaxPrice(allFlights, uc.selfTransfer.MinSaving)
+		allFlights = append(allFlights, uc.buildSelfTransfers(ctx, criteria, maxPrice)...)
+	}
+
+	// Compute facets over the unfiltered gathered flights
+	facets := ComputeFacets(allFlights, opts.Filters)
 
 	// Apply filtering using the dedicated filter module
 	filtered := ApplyFilters(allFlights, opts.Filters)
 
+	// Annotate flights requiring travel documents the passenger has not declared,
+	// and total each fare for the requested number of passengers
+	for i := range filtered {
+		filtered[i].AnnotateMissingDocuments(criteria.TravelDocuments)
+		filtered[i].Price.ApplyPassengers(criteria.Passengers)
+	}
+
 	// Calculate ranking scores using the dedicated ranking module
-	ranked := CalculateRankingScores(filtered)
+	prefs := ScoringPreferences{DepartureWindow: opts.PreferredDepartureWindow}
+	ranked := uc.ranker.Score(filtered, weights, prefs, opts.Explain)
+	applyProviderWeights(ranked, uc.registry.Statuses())
 
 	// Sort results using the dedicated sorting module
-	sorted := SortFlights(ranked, opts.SortBy)
+	sorted := SortFlightsBy(ranked, opts.sortKeys())
+
+	// Convert prices to the requested display currency
+	if opts.DisplayCurrency != "" {
+		if err := uc.currency.ApplyDisplayCurrency(ctx, sorted, opts.DisplayCurrency); err != nil {
+			return nil, err
+		}
+	}
 
 	// Build response with new format
-	successfulProviders := len(uc.providers) - len(failedProviders)
+	successfulProviders := len(queriedProviders) - len(failedProviders)
 	response := domain.NewSearchResponse(
 		&criteria,
 		sorted,
 		domain.SearchMetadata{
-			TotalResults:       len(sorted),
-			ProvidersQueried:   len(uc.providers),
-			ProvidersSucceeded: successfulProviders,
-			ProvidersFailed:    len(failedProviders),
-			SearchTimeMs:       time.Since(startTime).Milliseconds(),
-			CacheHit:           false, // Not implemented yet
+			TotalResults:         len(sorted),
+			ProvidersQueried:     len(queriedProviders),
+			ProvidersSucceeded:   successfulProviders,
+			ProvidersFailed:      len(failedProviders),
+			ProvidersSkipped:     len(skippedProviders),
+			ProvidersRateLimited: len(rateLimitedProviders),
+			FailedProviders:      failedProviders,
+			SkippedProviders:     skippedProviders,
+			RateLimitedProviders: rateLimitedProviders,
+			SearchTimeMs:         time.Since(startTime).Milliseconds(),
+			CacheHit:             cacheHit && len(queriedProviders) == len(providers),
 		},
 	)
+	response.Facets = &facets
+	metrics.ResultSetSize.WithLabelValues("search").Observe(float64(len(sorted)))
 
 	return &response, nil
 }
 
+// coveringProviders returns the providers serving the criteria and the names of those
+// skipped because their declared coverage excludes it.
+func coveringProviders(providers []domain.FlightProvider, criteria domain.SearchCriteria) ([]domain.FlightProvider, []string) {
+	covering := make([]domain.FlightProvider, 0, len(providers))
+	var skipped []string
+	for _, p := range providers {
+		if !domain.ProviderCovers(p, criteria) {
+			skipped = append(skipped, p.Name())
+			continue
+		}
+		covering = append(covering, p)
+	}
+
+	if len(skipped) > 0 {
+		log.Debug().
+			Str("origin", criteria.Origin).
+			Str("destination", criteria.Destination).
+			Str("class", criteria.Class).
+			Strs("skipped_providers", skipped).
+			Msg("Skipping providers not covering the route")
+	}
+
+	return covering, skipped
+}
+
+// applyProviderWeights subtracts (weight − 1) × domain.ProviderWeightInfluence from the
+// ranking score of each flight of a reweighted provider, recording the weight in the
+// ranking explanation. Self-transfers take the mean weight of their legs' providers.
+func applyProviderWeights(flights []domain.Flight, statuses []domain.ProviderStatus) {
+	weights := make(map[string]float64, len(statuses))
+	for _, s := range statuses {
+		if s.Weight != domain.DefaultProviderWeight {
+			weights[s.Name] = s.Weight
+		}
+	}
+	if len(weights) == 0 {
+		return
+	}
+
+	for i := range flights {
+		weight := providerWeight(flights[i], weights)
+		if weight == domain.DefaultProviderWeight {
+			continue
+		}
+		flights[i].RankingScore -= (weight - domain.DefaultProviderWeight) * domain.ProviderWeightInfluence
+		if flights[i].RankingExplanation != nil {
+			flights[i].RankingExplanation.ProviderWeight = &weight
+		}
+	}
+}
+
+// providerWeight returns the weight of a flight's provider, or for a self-transfer the
+// mean weight of its legs' providers. Providers missing from weights have the default.
+func providerWeight(f domain.Flight, weights map[string]float64) float64 {
+	if f.SelfTransfer == nil || len(f.SelfTransfer.Legs) == 0 {
+		if weight, ok := weights[f.Provider]; ok {
+			return weight
+		}
+		return domain.DefaultProviderWeight
+	}
+
+	var total float64
+	for _, leg := range f.SelfTransfer.Legs {
+		total += providerWeight(leg, weights)
+	}
+	return total / float64(len(f.SelfTransfer.Legs))
+}
+
 // queryProvider queries a single provider with timeout and panic recovery.
+// Results are served from and stored in the result cache when enabled. Providers over
+// their rate limit are not queried and reported as rate limited.
 func (uc *flightSearchUseCase) queryProvider(ctx context.Context, provider domain.FlightProvider, criteria domain.SearchCriteria, results chan<- providerResult) {
+	if flights, ok := uc.cache.get(provider.Name(), criteria); ok {
+		trace.SpanFromContext(ctx).AddEvent("provider result cache hit", trace.WithAttributes(
+			attribute.String("provider", provider.Name()),
+		))
+		results <- providerResult{
+			Provider: provider.Name(),
+			Flights:  flights,
+			Cached:   true,
+		}
+		return
+	}
+
+	// Wait briefly for the provider's rate limit, otherwise skip it
+	limiter := uc.rateLimits.get(provider.Name())
+	release, err := limiter.acquire(ctx)
+	if err != nil {
+		rateLimited := errors.Is(err, domain.ErrProviderRateLimited)
+		if rateLimited {
+			trace.SpanFromContext(ctx).AddEvent("provider rate limited", trace.WithAttributes(
+				attribute.String("provider", provider.Name()),
+			))
+			log.Warn().
+				Str("provider", provider.Name()).
+				Msg("Provider over its rate limit, skipping")
+			metrics.ProviderErrors.WithLabelValues(provider.Name(), metrics.ErrorTypeRateLimited).Inc()
+		}
+		results <- providerResult{
+			Provider:    provider.Name(),
+			Error:       err,
+			RateLimited: rateLimited,
+		}
+		return
+	}
+	defer release()
+
 	// Per-provider timeout
 	ctx, cancel := context.WithTimeout(ctx, uc.providerTimeout)
 	defer cancel()
@@ -193,6 +491,7 @@ func (uc *flightSearchUseCase) queryProvider(ctx context.Context, provider domai
 	// Panic recovery to prevent one provider from crashing the whole search
 	defer func() {
 		if r := recover(); r != nil {
+			metrics.ProviderErrors.WithLabelValues(providerName, metrics.ErrorTypePanic).Inc()
 			results <- providerResult{
 				Provider: providerName,
 				Error:    fmt.Errorf("provider panic: %v", r),
@@ -207,7 +506,19 @@ func (uc *flightSearchUseCase) queryProvider(ctx context.Context, provider domai
 	// Execute provider search with retry logic for retryable errors
 retryLoop:
 	for attempt := 1; attempt <= uc.retryConfig.MaxAttempts; attempt++ {
-		flights, lastErr = provider.Search(ctx, criteria)
+		// Retries take a token too; stop retrying if none is available in time
+		if attempt > 1 {
+			if err := limiter.token(ctx); err != nil {
+				log.Debug().
+					Str("provider", providerName).
+					Int("attempt", attempt).
+					Err(err).
+					Msg("Retry not attempted, provider over its rate limit")
+				break retryLoop
+			}
+		}
+
+		flights, lastErr = searchProvider(ctx, provider, criteria, attempt)
 
 		// Check if context was cancelled during the provider call
 		if ctx.Err() != nil {
@@ -307,10 +618,59 @@ retryLoop:
 		}
 	}
 
+	if lastErr == nil {
+		uc.cache.put(providerName, criteria, flights)
+	}
+
 	results <- providerResult{
 		Provider: providerName,
 		Flights:  flights,
 		Error:    lastErr,
 		Duration: time.Since(start),
 	}
+}
+
+// searchProvider makes one provider search call in a span, recording its metrics.
+func searchProvider(ctx context.Context, provider domain.FlightProvider, criteria domain.SearchCriteria, attempt int) ([]domain.Flight, error) {
+	ctx, span := tracer.Start(ctx, "FlightProvider.Search", trace.WithAttributes(
+		attribute.String("provider", provider.Name()),
+		attribute.Int("attempt", attempt),
+	))
+	defer span.End()
+
+	metrics.ProviderCalls.WithLabelValues(provider.Name()).Inc()
+	if attempt > 1 {
+		metrics.ProviderRetries.WithLabelValues(provider.Name()).Inc()
+	}
+
+	start := time.Now()
+	flights, err := provider.Search(ctx, criteria)
+	observeProviderCall(provider.Name(), time.Since(start), len(flights), err, ctx.Err())
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else {
+		span.SetAttributes(attribute.Int("flight_count", len(flights)))
+	}
+	return flights, err
+}
+
+// observeProviderCall records the metrics of a provider search call. A call whose
+// context ended is recorded as failed with the context error.
+func observeProviderCall(provider string, duration time.Duration, flights int, err, ctxErr error) {
+	metrics.ProviderCallDuration.WithLabelValues(provider).Observe(duration.Seconds())
+	if ctxErr != nil {
+		err = ctxErr
+	}
+	if err == nil {
+		metrics.ProviderFlights.WithLabelValues(provider).Add(float64(flights))
+		return
+	}
+
+	errorType := metrics.ProviderErrorType(err)
+	metrics.ProviderErrors.WithLabelValues(provider, errorType).Inc()
+	if errorType == metrics.ErrorTypeTimeout {
+		metrics.ProviderTimeouts.WithLabelValues(provider).Inc()
+	}
 }
\ No newline at end of file
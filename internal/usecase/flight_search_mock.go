@@ -41,6 +41,21 @@ func (m *MockFlightSearchUseCase) EXPECT() *MockFlightSearchUseCaseMockRecorder
 	return m.recorder
 }
 
+// Explore mocks base method.
+func (m *MockFlightSearchUseCase) Explore(ctx context.Context, criteria domain.ExploreCriteria) (*domain.ExploreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Explore", ctx, criteria)
+	ret0, _ := ret[0].(*domain.ExploreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Explore indicates an expected call of Explore.
+func (mr *MockFlightSearchUseCaseMockRecorder) Explore(ctx, criteria any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Explore", reflect.TypeOf((*MockFlightSearchUseCase)(nil).Explore), ctx, criteria)
+}
+
 // Search mocks base method.
 func (m *MockFlightSearchUseCase) Search(ctx context.Context, criteria domain.SearchCriteria, opts SearchOptions) (*domain.SearchResponse, error) {
 	m.ctrl.T.Helper()
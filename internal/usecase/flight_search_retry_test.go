@@ -3,13 +3,22 @@ package usecase
 import (
 	"context"
 	"errors"
+	"sort"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/internal/metrics"
 	"github.com/herdiagusthio/flight-search-system/pkg/util"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 // mockProviderWithRetryable is a mock provider that can return retryable or non-retryable errors
@@ -324,3 +333,102 @@ func (m *mockProviderSlow) Search(ctx context.Context, criteria domain.SearchCri
 		return nil, ctx.Err()
 	}
 }
+
+func TestSearch_RecordsProviderMetrics(t *testing.T) {
+	// The provider name is unique to this test, since the metrics are global
+	provider := &mockProviderWithRetryable{
+		name:          "metrics_provider",
+		successAfter:  2,
+		retryable:     true,
+		returnError:   domain.ErrProviderUnavailable,
+		returnFlights: []domain.Flight{{FlightNumber: "TEST-123"}, {FlightNumber: "TEST-456"}},
+	}
+
+	cfg := &Config{
+		GlobalTimeout:   5 * time.Second,
+		ProviderTimeout: 2 * time.Second,
+		RetryConfig: util.RetryConfig{
+			MaxAttempts:  3,
+			InitialDelay: 10 * time.Millisecond,
+			MaxDelay:     50 * time.Millisecond,
+			Multiplier:   2.0,
+		},
+		ResultCacheTTL: time.Minute,
+	}
+
+	uc := NewFlightSearchUseCase([]domain.FlightProvider{provider}, cfg)
+	for i := 0; i < 2; i++ {
+		_, err := uc.Search(context.Background(), domain.SearchCriteria{}, SearchOptions{})
+		require.NoError(t, err)
+	}
+
+	name := provider.name
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.ProviderCalls.WithLabelValues(name)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.ProviderRetries.WithLabelValues(name)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.ProviderErrors.WithLabelValues(name, metrics.ErrorTypeUnavailable)))
+	assert.Zero(t, testutil.ToFloat64(metrics.ProviderTimeouts.WithLabelValues(name)))
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.ProviderFlights.WithLabelValues(name)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.CacheLookups.WithLabelValues(name, metrics.CacheMiss)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.CacheLookups.WithLabelValues(name, metrics.CacheHit)))
+}
+
+// spanRecorder records the spans of the use case. The tracer provider is installed once,
+// since tracers bind to the first global provider.
+var spanRecorder = sync.OnceValue(func() *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	return recorder
+})
+
+func TestSearch_RecordsSpans(t *testing.T) {
+	recorder := spanRecorder()
+	provider := &mockProviderWithRetryable{
+		name:          "traced_provider",
+		successAfter:  2,
+		retryable:     true,
+		returnError:   domain.ErrProviderUnavailable,
+		returnFlights: []domain.Flight{{FlightNumber: "TEST-123"}},
+	}
+
+	cfg := &Config{
+		GlobalTimeout:   5 * time.Second,
+		ProviderTimeout: 2 * time.Second,
+		RetryConfig: util.RetryConfig{
+			MaxAttempts:  3,
+			InitialDelay: 10 * time.Millisecond,
+			MaxDelay:     50 * time.Millisecond,
+			Multiplier:   2.0,
+		},
+	}
+
+	ctx, root := otel.Tracer("test").Start(context.Background(), "request")
+	uc := NewFlightSearchUseCase([]domain.FlightProvider{provider}, cfg)
+	_, err := uc.Search(ctx, domain.SearchCriteria{Origin: "CGK", Destination: "DPS", Passengers: 1}, SearchOptions{})
+	root.End()
+	require.NoError(t, err)
+
+	// Spans of this test's trace, by name
+	spans := map[string][]sdktrace.ReadOnlySpan{}
+	for _, s := range recorder.Ended() {
+		if s.SpanContext().TraceID() == root.SpanContext().TraceID() {
+			spans[s.Name()] = append(spans[s.Name()], s)
+		}
+	}
+
+	require.Len(t, spans["FlightSearchUseCase.Search"], 1)
+	search := spans["FlightSearchUseCase.Search"][0]
+	assert.Equal(t, root.SpanContext().SpanID(), search.Parent().SpanID())
+	assert.Contains(t, search.Attributes(), attribute.String("origin", "CGK"))
+	assert.Contains(t, search.Attributes(), attribute.Int("flight_count", 1))
+
+	calls := spans["FlightProvider.Search"]
+	require.Len(t, calls, 2)
+	sort.Slice(calls, func(i, j int) bool { return calls[i].StartTime().Before(calls[j].StartTime()) })
+	for i, call := range calls {
+		assert.Equal(t, search.SpanContext().SpanID(), call.Parent().SpanID())
+		assert.Contains(t, call.Attributes(), attribute.String("provider", "traced_provider"))
+		assert.Contains(t, call.Attributes(), attribute.Int("attempt", i+1))
+	}
+	assert.Equal(t, codes.Error, calls[0].Status().Code)
+	assert.Contains(t, calls[1].Attributes(), attribute.Int("flight_count", 1))
+}
@@ -131,6 +131,152 @@ func TestSearch_SingleProvider(t *testing.T) {
 	assert.Equal(t, 0, result.Metadata.ProvidersFailed)
 }
 
+func TestSearch_AnnotatesMissingDocuments(t *testing.T) {
+	provider := &mockProvider{
+		name: "test_provider",
+		flights: []domain.Flight{
+			{ID: "intl", Price: domain.PriceInfo{Amount: 1500000}, International: true, Arrival: domain.FlightPoint{AirportCode: "NRT", Country: "JP"}},
+			{ID: "dom", Price: domain.PriceInfo{Amount: 500000}, Arrival: domain.FlightPoint{AirportCode: "DPS", Country: "ID"}},
+		},
+	}
+
+	uc := NewFlightSearchUseCase([]domain.FlightProvider{provider}, nil)
+	criteria := domain.SearchCriteria{
+		Origin:          "CGK",
+		Destination:     "NRT",
+		DepartureDate:   "2024-12-25",
+		Passengers:      1,
+		TravelDocuments: &domain.TravelDocuments{Nationality: "ID", HasPassport: true},
+	}
+
+	result, err := uc.Search(context.Background(), criteria, SearchOptions{SortBy: domain.SortByPrice})
+
+	require.NoError(t, err)
+	require.Len(t, result.Flights, 2)
+	assert.Equal(t, "dom", result.Flights[0].ID)
+	assert.Empty(t, result.Flights[0].MissingDocuments)
+	assert.Equal(t, "intl", result.Flights[1].ID)
+	assert.Equal(t, []string{domain.DocumentVisa}, result.Flights[1].MissingDocuments)
+}
+
+func TestSearch_MultiCurrency(t *testing.T) {
+	provider := &mockProvider{
+		name: "test_provider",
+		flights: []domain.Flight{
+			{ID: "usd", Price: domain.PriceInfo{Amount: 40, Currency: "USD"}},
+			{ID: "idr", Price: domain.PriceInfo{Amount: 800000, Currency: "IDR"}},
+		},
+	}
+	uc := NewFlightSearchUseCase([]domain.FlightProvider{provider}, &Config{ExchangeRates: testRates()})
+	criteria := domain.SearchCriteria{Origin: "CGK", Destination: "DPS", DepartureDate: "2024-12-25", Passengers: 1}
+
+	t.Run("ranks and filters in base currency", func(t *testing.T) {
+		opts := SearchOptions{SortBy: domain.SortByPrice, Filters: &domain.FilterOptions{MaxPrice: ptrFloat64(700000)}}
+
+		result, err := uc.Search(context.Background(), criteria, opts)
+
+		require.NoError(t, err)
+		require.Len(t, result.Flights, 1)
+		assert.Equal(t, "usd", result.Flights[0].ID)
+		assert.Equal(t, 640000.0, result.Flights[0].Price.Amount)
+		assert.Equal(t, "IDR", result.Flights[0].Price.Currency)
+		assert.Equal(t, 40.0, result.Flights[0].Price.OriginalAmount)
+	})
+
+	t.Run("converts to display currency", func(t *testing.T) {
+		opts := SearchOptions{SortBy: domain.SortByPrice, DisplayCurrency: "SGD"}
+
+		result, err := uc.Search(context.Background(), criteria, opts)
+
+		require.NoError(t, err)
+		require.Len(t, result.Flights, 2)
+		assert.Equal(t, "SGD", result.Flights[0].Price.Currency)
+		assert.InDelta(t, 53.33, result.Flights[0].Price.Amount, 0.01)
+		assert.Equal(t, "S$53.33", result.Flights[0].Price.Formatted)
+	})
+
+	t.Run("unsupported display currency", func(t *testing.T) {
+		_, err := uc.Search(context.Background(), criteria, SearchOptions{DisplayCurrency: "XYZ"})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+	})
+}
+
+func TestSearch_RankingProfile(t *testing.T) {
+	provider := &mockProvider{
+		name: "test_provider",
+		flights: []domain.Flight{
+			{ID: "cheap_slow", Price: domain.PriceInfo{Amount: 500000}, Duration: domain.DurationInfo{TotalMinutes: 300}, Stops: 1},
+			{ID: "pricey_fast", Price: domain.PriceInfo{Amount: 1500000}, Duration: domain.DurationInfo{TotalMinutes: 100}, Stops: 0},
+		},
+	}
+	criteria := domain.SearchCriteria{Origin: "CGK", Destination: "DPS", DepartureDate: "2024-12-25", Passengers: 1}
+
+	tests := []struct {
+		name       string
+		config     *Config
+		profile    string
+		expectBest string
+	}{
+		{"balanced profile uses configured weights", &Config{RankingWeights: RankingWeights{FactorPrice: 1}}, RankingProfileBalanced, "cheap_slow"},
+		{"configured weights", &Config{RankingWeights: RankingWeights{FactorDuration: 0.7, FactorStops: 0.3}}, "", "pricey_fast"},
+		{"business profile", nil, RankingProfileBusiness, "pricey_fast"},
+		{"cheapest-ish profile overrides configured weights", &Config{RankingWeights: RankingWeights{FactorDuration: 1}}, RankingProfileCheapest, "cheap_slow"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewFlightSearchUseCase([]domain.FlightProvider{provider}, tt.config)
+
+			result, err := uc.Search(context.Background(), criteria, SearchOptions{SortBy: domain.SortByBestValue, RankingProfile: tt.profile})
+
+			require.NoError(t, err)
+			require.Len(t, result.Flights, 2)
+			assert.Equal(t, tt.expectBest, result.Flights[0].ID)
+		})
+	}
+
+	t.Run("explain", func(t *testing.T) {
+		uc := NewFlightSearchUseCase([]domain.FlightProvider{provider}, nil)
+
+		result, err := uc.Search(context.Background(), criteria, SearchOptions{RankingProfile: RankingProfileFastest, Explain: true})
+
+		require.NoError(t, err)
+		for _, f := range result.Flights {
+			require.NotNil(t, f.RankingExplanation, f.ID)
+			assert.Equal(t, map[string]float64(rankingProfiles[RankingProfileFastest]), f.RankingExplanation.Weights)
+		}
+	})
+
+	t.Run("unknown profile", func(t *testing.T) {
+		uc := NewFlightSearchUseCase([]domain.FlightProvider{provider}, nil)
+
+		_, err := uc.Search(context.Background(), criteria, SearchOptions{RankingProfile: "luxury"})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+	})
+}
+
+func TestSearch_TotalsFareForPassengers(t *testing.T) {
+	provider := &mockProvider{
+		name: "test_provider",
+		flights: []domain.Flight{
+			{ID: "f1", Price: domain.PriceInfo{Amount: 750000, Currency: "IDR"}},
+		},
+	}
+	uc := NewFlightSearchUseCase([]domain.FlightProvider{provider}, nil)
+	criteria := domain.SearchCriteria{Origin: "CGK", Destination: "DPS", DepartureDate: "2024-12-25", Passengers: 3}
+
+	result, err := uc.Search(context.Background(), criteria, SearchOptions{})
+
+	require.NoError(t, err)
+	require.Len(t, result.Flights, 1)
+	breakdown := result.Flights[0].Price.Breakdown
+	assert.Equal(t, 750000.0, breakdown.PerPassenger)
+	assert.Equal(t, 3, breakdown.Passengers)
+	assert.Equal(t, 2250000.0, breakdown.Total)
+}
+
 func TestSearch_MultipleProviders(t *testing.T) {
 	provider1 := &mockProvider{
 		name: "provider1",
@@ -193,6 +339,7 @@ func TestSearch_ProviderError(t *testing.T) {
 	assert.Equal(t, 2, result.Metadata.ProvidersQueried)
 	assert.Equal(t, 1, result.Metadata.ProvidersSucceeded)
 	assert.Equal(t, 1, result.Metadata.ProvidersFailed)
+	assert.Equal(t, []string{"provider1"}, result.Metadata.FailedProviders)
 }
 
 func TestSearch_AllProvidersFail(t *testing.T) {
@@ -221,6 +368,78 @@ func TestSearch_AllProvidersFail(t *testing.T) {
 	assert.ErrorIs(t, err, domain.ErrAllProvidersFailed)
 }
 
+// coveringProvider is a test provider declaring its route coverage.
+type coveringProvider struct {
+	countingProvider
+	coverage domain.RouteCoverage
+}
+
+func (p *coveringProvider) Coverage() domain.RouteCoverage {
+	return p.coverage
+}
+
+func TestSearch_SkipsNonCoveringProviders(t *testing.T) {
+	covering := &coveringProvider{
+		countingProvider: countingProvider{mockProvider: mockProvider{
+			name:    "garuda",
+			flights: []domain.Flight{{ID: "f1", Price: domain.PriceInfo{Amount: 500000}}},
+		}},
+		coverage: domain.RouteCoverage{Airports: []string{"CGK", "DPS"}},
+	}
+	nonCovering := &coveringProvider{
+		countingProvider: countingProvider{mockProvider: mockProvider{name: "airasia"}},
+		coverage:         domain.RouteCoverage{Airports: []string{"CGK", "DPS"}, Classes: []string{"economy"}},
+	}
+	failing := &mockProvider{name: "lion_air", err: errors.New("provider error")}
+
+	uc := NewFlightSearchUseCase([]domain.FlightProvider{covering, nonCovering, failing}, nil)
+	criteria := domain.SearchCriteria{
+		Origin:        "CGK",
+		Destination:   "DPS",
+		DepartureDate: "2024-12-25",
+		Passengers:    1,
+		Class:         "business",
+	}
+
+	result, err := uc.Search(context.Background(), criteria, DefaultSearchOptions())
+
+	require.NoError(t, err)
+	require.Len(t, result.Flights, 1)
+	assert.Equal(t, 1, covering.calls)
+	assert.Zero(t, nonCovering.calls)
+
+	assert.Equal(t, 2, result.Metadata.ProvidersQueried)
+	assert.Equal(t, 1, result.Metadata.ProvidersSucceeded)
+	assert.Equal(t, 1, result.Metadata.ProvidersFailed)
+	assert.Equal(t, 1, result.Metadata.ProvidersSkipped)
+	assert.Equal(t, []string{"lion_air"}, result.Metadata.FailedProviders)
+	assert.Equal(t, []string{"airasia"}, result.Metadata.SkippedProviders)
+}
+
+func TestSearch_NoCoveringProviders(t *testing.T) {
+	provider := &coveringProvider{
+		countingProvider: countingProvider{mockProvider: mockProvider{name: "garuda"}},
+		coverage:         domain.RouteCoverage{Airports: []string{"CGK", "DPS"}},
+	}
+
+	uc := NewFlightSearchUseCase([]domain.FlightProvider{provider}, nil)
+	criteria := domain.SearchCriteria{
+		Origin:        "SUB",
+		Destination:   "LOP",
+		DepartureDate: "2024-12-25",
+		Passengers:    1,
+	}
+
+	result, err := uc.Search(context.Background(), criteria, DefaultSearchOptions())
+
+	require.NoError(t, err, "a route no provider serves is not a provider failure")
+	assert.Empty(t, result.Flights)
+	assert.Zero(t, provider.calls)
+	assert.Zero(t, result.Metadata.ProvidersQueried)
+	assert.Equal(t, []string{"garuda"}, result.Metadata.SkippedProviders)
+	assert.False(t, result.Metadata.CacheHit)
+}
+
 func TestSearch_WithFilters(t *testing.T) {
 	provider := &mockProvider{
 		name: "test_provider",
@@ -255,6 +474,12 @@ func TestSearch_WithFilters(t *testing.T) {
 	// Should be sorted by price
 	assert.Equal(t, "f1", result.Flights[0].ID)
 	assert.Equal(t, "f2", result.Flights[1].ID)
+
+	// Facets are computed over all gathered flights, not just the filtered ones
+	require.NotNil(t, result.Facets)
+	assert.Equal(t, 500000.0, result.Facets.Price.Min)
+	assert.Equal(t, 1200000.0, result.Facets.Price.Max)
+	assert.Len(t, result.Facets.Stops, 3)
 }
 
 func TestSearch_WithSorting(t *testing.T) {
@@ -270,6 +495,7 @@ func TestSearch_WithSorting(t *testing.T) {
 	tests := []struct {
 		name        string
 		sortBy      domain.SortOption
+		sortKeys    []domain.SortKey
 		expectedIDs []string
 	}{
 		{
@@ -282,6 +508,17 @@ func TestSearch_WithSorting(t *testing.T) {
 			sortBy:      domain.SortByDuration,
 			expectedIDs: []string{"f1", "f2", "f3"},
 		},
+		{
+			name:        "pareto keeps non-dominated flights",
+			sortBy:      domain.SortByPareto,
+			expectedIDs: []string{"f2", "f1"},
+		},
+		{
+			name:        "sort keys override sortBy",
+			sortBy:      domain.SortByPrice,
+			sortKeys:    []domain.SortKey{{Option: domain.SortByDuration, Descending: true}},
+			expectedIDs: []string{"f3", "f2", "f1"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -296,13 +533,15 @@ func TestSearch_WithSorting(t *testing.T) {
 			}
 
 			opts := SearchOptions{
-				SortBy: tt.sortBy,
+				SortBy:   tt.sortBy,
+				SortKeys: tt.sortKeys,
 			}
 
 			result, err := uc.Search(ctx, criteria, opts)
 
 			require.NoError(t, err)
-			assert.Equal(t, 3, len(result.Flights))
+			assert.Equal(t, len(tt.expectedIDs), len(result.Flights))
+			assert.Equal(t, len(tt.expectedIDs), result.Metadata.TotalResults)
 
 			ids := make([]string, len(result.Flights))
 			for i, f := range result.Flights {
@@ -8,6 +8,24 @@ import (
 type SearchOptions struct {
 	Filters *domain.FilterOptions
 	SortBy  domain.SortOption
+
+	// SortKeys are the sort keys in priority order. When set, they replace SortBy.
+	SortKeys []domain.SortKey
+
+	// DisplayCurrency is the ISO 4217 currency prices are returned in.
+	// Empty means the base currency.
+	DisplayCurrency string
+
+	// RankingProfile selects the ranking weights used for best-value sorting.
+	// Empty means the balanced profile, which uses the configured weights.
+	RankingProfile string
+
+	// Explain attaches a RankingExplanation to each flight.
+	Explain bool
+
+	// PreferredDepartureWindow is the traveler's preferred local departure window,
+	// scored by the departure time ranking factor. Nil means the default window.
+	PreferredDepartureWindow *domain.TimeRange
 }
 
 // DefaultSearchOptions returns SearchOptions with sensible defaults.
@@ -17,3 +35,11 @@ func DefaultSearchOptions() SearchOptions {
 		SortBy:  domain.SortByBestValue,
 	}
 }
+
+// sortKeys returns SortKeys, or SortBy as the only ascending key if SortKeys is empty.
+func (o SearchOptions) sortKeys() []domain.SortKey {
+	if len(o.SortKeys) > 0 {
+		return o.SortKeys
+	}
+	return []domain.SortKey{{Option: o.SortBy}}
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"sort"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+)
+
+// ParetoFrontier returns the flights not dominated by any other flight over price,
+// duration and stops. A flight dominates another if it is no worse on all three and
+// strictly better on at least one; flights equal on all three are all kept.
+// The frontier is sorted by price, then duration, then stops, and each flight is tagged
+// with domain.ParetoTagCheapest, domain.ParetoTagFastest and domain.ParetoTagBestBalance
+// where applicable. Returns a copy; the input is not modified.
+func ParetoFrontier(flights []domain.Flight) []domain.Flight {
+	if len(flights) == 0 {
+		return flights
+	}
+
+	// Keep non-dominated flights
+	frontier := make([]domain.Flight, 0, len(flights))
+	for i, f := range flights {
+		dominated := false
+		for j, other := range flights {
+			if i != j && dominates(other, f) {
+				dominated = true
+				break
+			}
+		}
+		if !dominated {
+			f.ParetoTags = nil
+			frontier = append(frontier, f)
+		}
+	}
+
+	sort.SliceStable(frontier, func(i, j int) bool {
+		a, b := frontier[i], frontier[j]
+		if a.Price.Amount != b.Price.Amount {
+			return a.Price.Amount < b.Price.Amount
+		}
+		if a.Duration.TotalMinutes != b.Duration.TotalMinutes {
+			return a.Duration.TotalMinutes < b.Duration.TotalMinutes
+		}
+		return a.Stops < b.Stops
+	})
+
+	tagParetoFrontier(frontier)
+	return frontier
+}
+
+// dominates reports whether a is no worse than b on price, duration and stops,
+// and strictly better on at least one of them.
+func dominates(a, b domain.Flight) bool {
+	if a.Price.Amount > b.Price.Amount ||
+		a.Duration.TotalMinutes > b.Duration.TotalMinutes ||
+		a.Stops > b.Stops {
+		return false
+	}
+	return a.Price.Amount < b.Price.Amount ||
+		a.Duration.TotalMinutes < b.Duration.TotalMinutes ||
+		a.Stops < b.Stops
+}
+
+// tagParetoFrontier tags every cheapest and every fastest flight of a sorted frontier,
+// and the first flight with the lowest best-value score as the best balance.
+func tagParetoFrontier(frontier []domain.Flight) {
+	if len(frontier) == 0 {
+		return
+	}
+
+	minPrice, _ := findPriceRange(frontier)
+	minDuration, _ := findDurationRange(frontier)
+	best := 0
+	for i, f := range frontier {
+		if f.RankingScore < frontier[best].RankingScore {
+			best = i
+		}
+	}
+
+	for i := range frontier {
+		if frontier[i].Price.Amount == minPrice {
+			frontier[i].ParetoTags = append(frontier[i].ParetoTags, domain.ParetoTagCheapest)
+		}
+		if frontier[i].Duration.TotalMinutes == minDuration {
+			frontier[i].ParetoTags = append(frontier[i].ParetoTags, domain.ParetoTagFastest)
+		}
+		if i == best {
+			frontier[i].ParetoTags = append(frontier[i].ParetoTags, domain.ParetoTagBestBalance)
+		}
+	}
+}
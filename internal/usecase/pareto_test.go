@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// paretoFlight builds a flight with the attributes the Pareto frontier compares.
+func paretoFlight(id string, price float64, minutes, stops int, score float64) domain.Flight {
+	return domain.Flight{
+		ID:           id,
+		Price:        domain.PriceInfo{Amount: price},
+		Duration:     domain.DurationInfo{TotalMinutes: minutes},
+		Stops:        stops,
+		RankingScore: score,
+	}
+}
+
+func TestParetoFrontier(t *testing.T) {
+	tests := []struct {
+		name         string
+		flights      []domain.Flight
+		expectedIDs  []string
+		expectedTags map[string][]string
+	}{
+		{
+			name:        "empty flights returns empty",
+			flights:     []domain.Flight{},
+			expectedIDs: []string{},
+		},
+		{
+			name:        "single flight gets every tag",
+			flights:     []domain.Flight{paretoFlight("f1", 500000, 120, 0, 0)},
+			expectedIDs: []string{"f1"},
+			expectedTags: map[string][]string{
+				"f1": {domain.ParetoTagCheapest, domain.ParetoTagFastest, domain.ParetoTagBestBalance},
+			},
+		},
+		{
+			name: "dominated flights are removed",
+			flights: []domain.Flight{
+				paretoFlight("slow-direct", 900000, 240, 0, 0.5),
+				paretoFlight("fast", 1500000, 90, 0, 0.4),
+				paretoFlight("cheap", 500000, 300, 1, 0.3),
+				paretoFlight("dominated", 1000000, 250, 1, 0.7),
+				paretoFlight("balanced", 800000, 150, 0, 0.2),
+			},
+			expectedIDs: []string{"cheap", "balanced", "fast"},
+			expectedTags: map[string][]string{
+				"cheap":    {domain.ParetoTagCheapest},
+				"balanced": {domain.ParetoTagBestBalance},
+				"fast":     {domain.ParetoTagFastest},
+			},
+		},
+		{
+			name: "fewer stops keeps an otherwise equal flight",
+			flights: []domain.Flight{
+				paretoFlight("one-stop", 500000, 120, 1, 0.5),
+				paretoFlight("direct", 500000, 120, 0, 0),
+			},
+			expectedIDs: []string{"direct"},
+		},
+		{
+			name: "identical flights are all kept and tagged",
+			flights: []domain.Flight{
+				paretoFlight("a", 500000, 120, 0, 0),
+				paretoFlight("b", 500000, 120, 0, 0),
+			},
+			expectedIDs: []string{"a", "b"},
+			expectedTags: map[string][]string{
+				"a": {domain.ParetoTagCheapest, domain.ParetoTagFastest, domain.ParetoTagBestBalance},
+				"b": {domain.ParetoTagCheapest, domain.ParetoTagFastest},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ParetoFrontier(tt.flights)
+
+			ids := make([]string, len(result))
+			for i, f := range result {
+				ids[i] = f.ID
+				if tt.expectedTags != nil {
+					assert.Equal(t, tt.expectedTags[f.ID], f.ParetoTags, f.ID)
+				}
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+
+			// Verify original slice not mutated
+			for _, f := range tt.flights {
+				assert.Nil(t, f.ParetoTags)
+			}
+		})
+	}
+}
+
+func TestDominates(t *testing.T) {
+	base := paretoFlight("base", 500000, 120, 1, 0)
+
+	tests := []struct {
+		name     string
+		other    domain.Flight
+		expected bool
+	}{
+		{name: "cheaper", other: paretoFlight("o", 400000, 120, 1, 0), expected: true},
+		{name: "shorter", other: paretoFlight("o", 500000, 100, 1, 0), expected: true},
+		{name: "fewer stops", other: paretoFlight("o", 500000, 120, 0, 0), expected: true},
+		{name: "equal", other: paretoFlight("o", 500000, 120, 1, 0), expected: false},
+		{name: "trade-off", other: paretoFlight("o", 400000, 150, 1, 0), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, dominates(tt.other, base))
+		})
+	}
+}
+
+func TestSortFlights_Pareto(t *testing.T) {
+	flights := []domain.Flight{
+		paretoFlight("f1", 800000, 180, 1, 0.5),
+		paretoFlight("f2", 500000, 120, 0, 0.1),
+	}
+
+	result := SortFlights(flights, domain.SortByPareto)
+
+	require.Len(t, result, 1)
+	assert.Equal(t, "f2", result[0].ID)
+	assert.Equal(t, []string{domain.ParetoTagCheapest, domain.ParetoTagFastest, domain.ParetoTagBestBalance}, result[0].ParetoTags)
+}
@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/rs/zerolog/log"
+)
+
+//go:generate mockgen -destination=provider_admin_mock.go -package=usecase github.com/herdiagusthio/flight-search-system/internal/usecase ProviderAdminUseCase
+
+// Provider admin actions recorded in the audit log.
+const (
+	ProviderActionEnable   = "enable"
+	ProviderActionDisable  = "disable"
+	ProviderActionReweight = "reweight"
+)
+
+// DefaultAuditLogSize is the number of provider audit entries kept in memory.
+const DefaultAuditLogSize = 100
+
+// Actor identifies who requested an admin change.
+type Actor struct {
+	RemoteAddr string
+	RequestID  string
+}
+
+// ProviderAuditEntry records a runtime change to a provider.
+type ProviderAuditEntry struct {
+	Time     time.Time
+	Actor    Actor
+	Action   string
+	Provider string
+	Before   domain.ProviderStatus
+	After    domain.ProviderStatus
+}
+
+// ProviderAdminUseCase defines runtime provider administration operations.
+// Every change is written to the log and kept in an in-memory audit log.
+type ProviderAdminUseCase interface {
+	// ListProviders returns the status of every registered provider.
+	ListProviders(ctx context.Context) []domain.ProviderStatus
+	// SetProviderEnabled enables or disables a provider and returns its new status.
+	SetProviderEnabled(ctx context.Context, name string, enabled bool, actor Actor) (domain.ProviderStatus, error)
+	// SetProviderWeight sets the ranking weight of a provider and returns its new status.
+	SetProviderWeight(ctx context.Context, name string, weight float64, actor Actor) (domain.ProviderStatus, error)
+	// AuditLog returns the most recent provider changes, newest first.
+	AuditLog(ctx context.Context) []ProviderAuditEntry
+}
+
+// providerAdminUseCase implements ProviderAdminUseCase.
+type providerAdminUseCase struct {
+	registry domain.ProviderRegistry
+	now      func() time.Time
+
+	// mu serializes changes with their audit entries, so the audit log is in the order
+	// the changes were made to the registry
+	mu      sync.Mutex
+	entries []ProviderAuditEntry // Oldest first
+	size    int
+}
+
+// NewProviderAdminUseCase creates a ProviderAdminUseCase managing the registry,
+// keeping the last auditLogSize changes (DefaultAuditLogSize if not positive).
+func NewProviderAdminUseCase(registry domain.ProviderRegistry, auditLogSize int) ProviderAdminUseCase {
+	if auditLogSize <= 0 {
+		auditLogSize = DefaultAuditLogSize
+	}
+	return &providerAdminUseCase{
+		registry: registry,
+		now:      time.Now,
+		size:     auditLogSize,
+	}
+}
+
+// ListProviders implements ProviderAdminUseCase.ListProviders.
+func (uc *providerAdminUseCase) ListProviders(_ context.Context) []domain.ProviderStatus {
+	return uc.registry.Statuses()
+}
+
+// SetProviderEnabled implements ProviderAdminUseCase.SetProviderEnabled.
+func (uc *providerAdminUseCase) SetProviderEnabled(_ context.Context, name string, enabled bool, actor Actor) (domain.ProviderStatus, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	before, err := uc.registry.SetEnabled(name, enabled)
+	if err != nil {
+		return domain.ProviderStatus{}, err
+	}
+
+	after := before
+	after.Enabled = enabled
+	action := ProviderActionDisable
+	if enabled {
+		action = ProviderActionEnable
+	}
+	uc.audit(actor, action, before, after)
+
+	return after, nil
+}
+
+// SetProviderWeight implements ProviderAdminUseCase.SetProviderWeight.
+func (uc *providerAdminUseCase) SetProviderWeight(_ context.Context, name string, weight float64, actor Actor) (domain.ProviderStatus, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	before, err := uc.registry.SetWeight(name, weight)
+	if err != nil {
+		return domain.ProviderStatus{}, err
+	}
+
+	after := before
+	after.Weight = weight
+	uc.audit(actor, ProviderActionReweight, before, after)
+
+	return after, nil
+}
+
+// AuditLog implements ProviderAdminUseCase.AuditLog.
+func (uc *providerAdminUseCase) AuditLog(_ context.Context) []ProviderAuditEntry {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	entries := make([]ProviderAuditEntry, len(uc.entries))
+	for i, e := range uc.entries {
+		entries[len(uc.entries)-1-i] = e
+	}
+	return entries
+}
+
+// audit logs a provider change and records it in the audit log. The caller must hold mu.
+func (uc *providerAdminUseCase) audit(actor Actor, action string, before, after domain.ProviderStatus) {
+	entry := ProviderAuditEntry{
+		Time:     uc.now(),
+		Actor:    actor,
+		Action:   action,
+		Provider: after.Name,
+		Before:   before,
+		After:    after,
+	}
+
+	log.Info().
+		Str("audit", "provider").
+		Str("action", action).
+		Str("provider", entry.Provider).
+		Str("remote_addr", actor.RemoteAddr).
+		Str("request_id", actor.RequestID).
+		Bool("enabled_before", before.Enabled).
+		Bool("enabled_after", after.Enabled).
+		Float64("weight_before", before.Weight).
+		Float64("weight_after", after.Weight).
+		Msg("Provider configuration changed")
+
+	if len(uc.entries) == uc.size {
+		uc.entries = append(uc.entries[:0], uc.entries[1:]...)
+	}
+	uc.entries = append(uc.entries, entry)
+}
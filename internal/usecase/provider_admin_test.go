@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRegistry() domain.ProviderRegistry {
+	return domain.NewProviderRegistryWith(
+		&mockProvider{name: "garuda", flights: []domain.Flight{{ID: "ga", Provider: "garuda", Price: domain.PriceInfo{Amount: 600000}}}},
+		&mockProvider{name: "airasia", flights: []domain.Flight{{ID: "qz", Provider: "airasia", Price: domain.PriceInfo{Amount: 500000}}}},
+	)
+}
+
+func TestProviderAdmin_SetProviderEnabled(t *testing.T) {
+	registry := newTestRegistry()
+	admin := NewProviderAdminUseCase(registry, 0)
+	actor := Actor{RemoteAddr: "10.0.0.1", RequestID: "req-1"}
+
+	status, err := admin.SetProviderEnabled(context.Background(), "airasia", false, actor)
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.ProviderStatus{Name: "airasia", Enabled: false, Weight: 1}, status)
+	assert.Equal(t, []domain.ProviderStatus{
+		{Name: "garuda", Enabled: true, Weight: 1},
+		{Name: "airasia", Enabled: false, Weight: 1},
+	}, admin.ListProviders(context.Background()))
+
+	entries := admin.AuditLog(context.Background())
+	require.Len(t, entries, 1)
+	assert.Equal(t, ProviderActionDisable, entries[0].Action)
+	assert.Equal(t, "airasia", entries[0].Provider)
+	assert.Equal(t, actor, entries[0].Actor)
+	assert.True(t, entries[0].Before.Enabled)
+	assert.False(t, entries[0].After.Enabled)
+	assert.False(t, entries[0].Time.IsZero())
+
+	_, err = admin.SetProviderEnabled(context.Background(), "citilink", true, actor)
+	assert.ErrorIs(t, err, domain.ErrProviderNotFound)
+	assert.Len(t, admin.AuditLog(context.Background()), 1, "failed changes are not audited")
+}
+
+func TestProviderAdmin_SetProviderWeight(t *testing.T) {
+	admin := NewProviderAdminUseCase(newTestRegistry(), 0)
+
+	status, err := admin.SetProviderWeight(context.Background(), "garuda", 2, Actor{})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2.0, status.Weight)
+
+	entries := admin.AuditLog(context.Background())
+	require.Len(t, entries, 1)
+	assert.Equal(t, ProviderActionReweight, entries[0].Action)
+	assert.Equal(t, 1.0, entries[0].Before.Weight)
+	assert.Equal(t, 2.0, entries[0].After.Weight)
+
+	_, err = admin.SetProviderWeight(context.Background(), "garuda", -1, Actor{})
+	assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+}
+
+func TestProviderAdmin_AuditLogKeepsMostRecent(t *testing.T) {
+	admin := NewProviderAdminUseCase(newTestRegistry(), 2)
+	now := time.Date(2025, 12, 1, 8, 0, 0, 0, time.UTC)
+	admin.(*providerAdminUseCase).now = func() time.Time {
+		now = now.Add(time.Minute)
+		return now
+	}
+
+	for _, enabled := range []bool{false, true, false} {
+		_, err := admin.SetProviderEnabled(context.Background(), "garuda", enabled, Actor{})
+		require.NoError(t, err)
+	}
+
+	entries := admin.AuditLog(context.Background())
+	require.Len(t, entries, 2)
+	assert.Equal(t, ProviderActionDisable, entries[0].Action, "newest first")
+	assert.Equal(t, ProviderActionEnable, entries[1].Action)
+	assert.True(t, entries[0].Time.After(entries[1].Time))
+}
+
+func TestProviderAdmin_AuditLogMatchesConcurrentChanges(t *testing.T) {
+	admin := NewProviderAdminUseCase(newTestRegistry(), 1000)
+
+	var wg sync.WaitGroup
+	for i := range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := admin.SetProviderWeight(context.Background(), "garuda", float64(i%20)/10, Actor{})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	entries := admin.AuditLog(context.Background())
+	require.Len(t, entries, 100)
+	for i := 0; i < len(entries)-1; i++ {
+		assert.Equal(t, entries[i+1].After, entries[i].Before, "entry %d follows the change before it", i)
+	}
+	status, _ := newTestRegistry().Status("garuda")
+	assert.Equal(t, status, entries[len(entries)-1].Before)
+	assert.Equal(t, admin.ListProviders(context.Background())[0], entries[0].After)
+}
+
+func TestSearch_UsesRegistryAtRuntime(t *testing.T) {
+	registry := newTestRegistry()
+	uc := NewFlightSearchUseCaseWithRegistry(registry, nil)
+	admin := NewProviderAdminUseCase(registry, 0)
+	criteria := domain.SearchCriteria{Origin: "CGK", Destination: "DPS", DepartureDate: "2025-12-15", Passengers: 1}
+
+	result, err := uc.Search(context.Background(), criteria, DefaultSearchOptions())
+	require.NoError(t, err)
+	assert.Len(t, result.Flights, 2)
+
+	_, err = admin.SetProviderEnabled(context.Background(), "airasia", false, Actor{})
+	require.NoError(t, err)
+
+	result, err = uc.Search(context.Background(), criteria, DefaultSearchOptions())
+	require.NoError(t, err)
+	require.Len(t, result.Flights, 1)
+	assert.Equal(t, "ga", result.Flights[0].ID)
+	assert.Equal(t, 1, result.Metadata.ProvidersQueried)
+
+	_, err = admin.SetProviderEnabled(context.Background(), "garuda", false, Actor{})
+	require.NoError(t, err)
+
+	_, err = uc.Search(context.Background(), criteria, DefaultSearchOptions())
+	assert.ErrorIs(t, err, domain.ErrAllProvidersFailed)
+}
+
+func TestSearch_ProviderWeights(t *testing.T) {
+	registry := newTestRegistry()
+	uc := NewFlightSearchUseCaseWithRegistry(registry, nil)
+	criteria := domain.SearchCriteria{Origin: "CGK", Destination: "DPS", DepartureDate: "2025-12-15", Passengers: 1}
+	opts := DefaultSearchOptions()
+	opts.Explain = true
+
+	result, err := uc.Search(context.Background(), criteria, opts)
+	require.NoError(t, err)
+	require.Len(t, result.Flights, 2)
+	assert.Equal(t, "qz", result.Flights[0].ID, "cheapest first with equal weights")
+	assert.Nil(t, result.Flights[0].RankingExplanation.ProviderWeight)
+	unweighted := result.Flights[1].RankingScore
+
+	_, err = registry.SetWeight("garuda", domain.MaxProviderWeight)
+	require.NoError(t, err)
+
+	result, err = uc.Search(context.Background(), criteria, opts)
+	require.NoError(t, err)
+	require.Len(t, result.Flights, 2)
+	assert.Equal(t, "qz", result.Flights[0].ID, "the weight does not override a much better price")
+	assert.Equal(t, "ga", result.Flights[1].ID)
+	assert.InDelta(t, unweighted-domain.ProviderWeightInfluence, result.Flights[1].RankingScore, 1e-9)
+	require.NotNil(t, result.Flights[1].RankingExplanation.ProviderWeight)
+	assert.Equal(t, 2.0, *result.Flights[1].RankingExplanation.ProviderWeight)
+}
+
+func TestApplyProviderWeights(t *testing.T) {
+	statuses := []domain.ProviderStatus{
+		{Name: "garuda", Enabled: true, Weight: 2},
+		{Name: "airasia", Enabled: true, Weight: 0},
+		{Name: "lion_air", Enabled: true, Weight: 1},
+	}
+
+	tests := []struct {
+		name          string
+		flight        domain.Flight
+		expectedScore float64
+	}{
+		{
+			name:          "promoted provider",
+			flight:        domain.Flight{Provider: "garuda", RankingScore: 0.5},
+			expectedScore: 0.4,
+		},
+		{
+			name:          "demoted provider",
+			flight:        domain.Flight{Provider: "airasia", RankingScore: 0.5},
+			expectedScore: 0.6,
+		},
+		{
+			name:          "default weight",
+			flight:        domain.Flight{Provider: "lion_air", RankingScore: 0.5},
+			expectedScore: 0.5,
+		},
+		{
+			name: "self-transfer takes the mean weight of its legs",
+			flight: domain.Flight{
+				Provider:     "garuda+lion_air",
+				RankingScore: 0.5,
+				SelfTransfer: &domain.SelfTransfer{Legs: []domain.Flight{{Provider: "garuda"}, {Provider: "lion_air"}}},
+			},
+			expectedScore: 0.45,
+		},
+		{
+			name: "self-transfer with opposite weights",
+			flight: domain.Flight{
+				Provider:     "garuda+airasia",
+				RankingScore: 0.5,
+				SelfTransfer: &domain.SelfTransfer{Legs: []domain.Flight{{Provider: "garuda"}, {Provider: "airasia"}}},
+			},
+			expectedScore: 0.5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flights := []domain.Flight{tt.flight}
+			applyProviderWeights(flights, statuses)
+			assert.InDelta(t, tt.expectedScore, flights[0].RankingScore, 1e-9)
+		})
+	}
+}
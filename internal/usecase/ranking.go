@@ -1,47 +1,119 @@
 package usecase
 
 import (
+	"cmp"
+	"fmt"
 	"math"
 	"sort"
+	"strings"
 
 	"github.com/herdiagusthio/flight-search-system/domain"
 )
 
-// Ranking weights (total = 1.0).
+// Ranking profile names selectable per search.
 const (
-	weightPrice    = 0.5 // Price impact: 50%
-	weightDuration = 0.3 // Duration impact: 30%
-	weightStops    = 0.2 // Stops impact: 20%
+	RankingProfileBalanced = "balanced"     // Configured default weights
+	RankingProfileCheapest = "cheapest-ish" // Favors price, for budget travelers
+	RankingProfileFastest  = "fastest-ish"  // Favors short travel time
+	RankingProfileBusiness = "business"     // Favors short, direct flights over price
 )
 
-// CalculateRankingScores calculates ranking score for each flight.
-// Score = 0.5×price + 0.3×duration + 0.2×stops (normalized to [0,1]).
-// Lower score = better value.
-func CalculateRankingScores(flights []domain.Flight) []domain.Flight {
-	if len(flights) == 0 {
-		return flights
+// weightTolerance is the allowed rounding error when checking that weights sum to 1.
+const weightTolerance = 1e-6
+
+// RankingWeights are the weights of the scoring factors in the ranking score, keyed by
+// factor name. Factors without a weight are not scored. Valid weights are non-negative
+// and sum to 1.
+type RankingWeights map[string]float64
+
+// DefaultRankingWeights returns the default weights: 50% price, 30% duration, 20% stops.
+func DefaultRankingWeights() RankingWeights {
+	return RankingWeights{FactorPrice: 0.5, FactorDuration: 0.3, FactorStops: 0.2}
+}
+
+// Validate checks that the weights are non-negative and sum to 1.
+func (w RankingWeights) Validate() error {
+	sum := 0.0
+	for name, weight := range w {
+		if weight < 0 {
+			return fmt.Errorf("ranking weights must be non-negative; got %s=%g", name, weight)
+		}
+		sum += weight
+	}
+	if math.Abs(sum-1) > weightTolerance {
+		return fmt.Errorf("ranking weights must sum to 1; got %g", sum)
+	}
+	return nil
+}
+
+// isZero reports whether no factor has a non-zero weight.
+func (w RankingWeights) isZero() bool {
+	for _, weight := range w {
+		if weight != 0 {
+			return false
+		}
 	}
+	return true
+}
 
-	// Find min/max for normalization
-	minPrice, maxPrice := findPriceRange(flights)
-	minDuration, maxDuration := findDurationRange(flights)
-	minStops, maxStops := findStopsRange(flights)
+// rankingProfiles are the built-in profiles. The balanced profile is not listed
+// because it uses the configured weights.
+var rankingProfiles = map[string]RankingWeights{
+	RankingProfileCheapest: {FactorPrice: 0.7, FactorDuration: 0.1, FactorStops: 0.1, FactorBaggage: 0.1},
+	RankingProfileFastest:  {FactorPrice: 0.2, FactorDuration: 0.5, FactorStops: 0.2, FactorOnTime: 0.1},
+	RankingProfileBusiness: {FactorPrice: 0.1, FactorDuration: 0.3, FactorStops: 0.3, FactorDepartureTime: 0.1, FactorOnTime: 0.1, FactorAmenities: 0.1},
+}
 
-	// Calculate scores - create a copy to avoid mutating input
-	result := make([]domain.Flight, len(flights))
-	for i, f := range flights {
-		result[i] = f
+// RankingProfiles returns the names of all ranking profiles, sorted.
+func RankingProfiles() []string {
+	names := []string{RankingProfileBalanced}
+	for name := range rankingProfiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
 
-		normPrice := normalizeValue(f.Price.Amount, minPrice, maxPrice)
-		normDuration := normalizeValue(float64(f.Duration.TotalMinutes), float64(minDuration), float64(maxDuration))
-		normStops := normalizeValue(float64(f.Stops), float64(minStops), float64(maxStops))
+// IsRankingProfile reports whether name is a known ranking profile (case-insensitive).
+func IsRankingProfile(name string) bool {
+	name = strings.ToLower(strings.TrimSpace(name))
+	_, ok := rankingProfiles[name]
+	return ok || name == RankingProfileBalanced
+}
 
-		result[i].RankingScore = (weightPrice * normPrice) +
-			(weightDuration * normDuration) +
-			(weightStops * normStops)
+// resolveRankingWeights returns the weights of the named profile.
+// An empty name or the balanced profile resolves to defaults.
+func resolveRankingWeights(profile string, defaults RankingWeights) (RankingWeights, error) {
+	profile = strings.ToLower(strings.TrimSpace(profile))
+	if profile == "" || profile == RankingProfileBalanced {
+		return defaults, nil
 	}
+	weights, ok := rankingProfiles[profile]
+	if !ok {
+		return nil, fmt.Errorf("unknown ranking profile %q", profile)
+	}
+	return weights, nil
+}
 
-	return result
+// CalculateRankingScores calculates ranking score for each flight using the default weights.
+// Score = 0.5×price + 0.3×duration + 0.2×stops (normalized to [0,1]).
+// Lower score = better value.
+func CalculateRankingScores(flights []domain.Flight) []domain.Flight {
+	return CalculateRankingScoresWithWeights(flights, DefaultRankingWeights())
+}
+
+// CalculateRankingScoresWithWeights calculates ranking score for each flight as the
+// weighted sum of the built-in scoring components (each normalized to [0,1]).
+// Lower score = better value.
+func CalculateRankingScoresWithWeights(flights []domain.Flight, w RankingWeights) []domain.Flight {
+	return defaultRanker.Score(flights, w, ScoringPreferences{}, false)
+}
+
+// CalculateExplainedRankingScores calculates ranking scores like CalculateRankingScoresWithWeights
+// and attaches a RankingExplanation to each flight with its normalized components, the weights
+// and the normalization bounds of the result set.
+func CalculateExplainedRankingScores(flights []domain.Flight, w RankingWeights) []domain.Flight {
+	return defaultRanker.Score(flights, w, ScoringPreferences{}, true)
 }
 
 // normalizeValue normalizes value to [0,1] range.
@@ -114,8 +186,17 @@ func findStopsRange(flights []domain.Flight) (min, max int) {
 }
 
 // SortFlights sorts flights by the specified option using stable sorting.
+// SortByPareto also filters the flights down to their Pareto frontier (see ParetoFrontier).
 // Defaults to SortByBestValue if sortBy is invalid.
 func SortFlights(flights []domain.Flight, sortBy domain.SortOption) []domain.Flight {
+	return SortFlightsBy(flights, []domain.SortKey{{Option: sortBy}})
+}
+
+// SortFlightsBy sorts flights by the sort keys in priority order using stable sorting:
+// flights equal on every key keep their relative order. A single SortByPareto key
+// filters the flights down to their Pareto frontier instead (see ParetoFrontier).
+// Invalid keys are ignored; defaults to SortByBestValue if no key is valid.
+func SortFlightsBy(flights []domain.Flight, keys []domain.SortKey) []domain.Flight {
 	if len(flights) == 0 {
 		return flights
 	}
@@ -124,35 +205,63 @@ func SortFlights(flights []domain.Flight, sortBy domain.SortOption) []domain.Fli
 	result := make([]domain.Flight, len(flights))
 	copy(result, flights)
 
+	// The Pareto frontier is filtered and tagged, even for a single flight
+	if len(keys) == 1 && keys[0].Option == domain.SortByPareto {
+		return ParetoFrontier(result)
+	}
+
 	// Single flight doesn't need sorting
 	if len(result) == 1 {
 		return result
 	}
 
-	// Default to best value if sortBy is empty or invalid
-	if sortBy == "" || !sortBy.IsValid() {
-		sortBy = domain.SortByBestValue
+	// Default to best value if no key is valid
+	valid := make([]domain.SortKey, 0, len(keys))
+	for _, key := range keys {
+		if key.Option.IsValid() && key.Option != domain.SortByPareto {
+			valid = append(valid, key)
+		}
+	}
+	if len(valid) == 0 {
+		valid = append(valid, domain.SortKey{Option: domain.SortByBestValue})
 	}
 
-	switch sortBy {
-	case domain.SortByBestValue:
-		// Lower score = better value
-		sort.SliceStable(result, func(i, j int) bool {
-			return result[i].RankingScore < result[j].RankingScore
-		})
+	sort.SliceStable(result, func(i, j int) bool {
+		for _, key := range valid {
+			c := compareFlights(result[i], result[j], key.Option)
+			if key.Descending {
+				c = -c
+			}
+			if c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+
+	return result
+}
+
+// compareFlights compares two flights by a sort option, in ascending order.
+// Returns a negative number if a sorts before b, a positive number if after, 0 if equal.
+func compareFlights(a, b domain.Flight, option domain.SortOption) int {
+	switch option {
 	case domain.SortByPrice:
-		sort.SliceStable(result, func(i, j int) bool {
-			return result[i].Price.Amount < result[j].Price.Amount
-		})
+		return cmp.Compare(a.Price.Amount, b.Price.Amount)
 	case domain.SortByDuration:
-		sort.SliceStable(result, func(i, j int) bool {
-			return result[i].Duration.TotalMinutes < result[j].Duration.TotalMinutes
-		})
+		return cmp.Compare(a.Duration.TotalMinutes, b.Duration.TotalMinutes)
 	case domain.SortByDeparture:
-		sort.SliceStable(result, func(i, j int) bool {
-			return result[i].Departure.DateTime.Before(result[j].Departure.DateTime)
-		})
+		return a.Departure.DateTime.Compare(b.Departure.DateTime)
+	case domain.SortByArrival:
+		return a.Arrival.DateTime.Compare(b.Arrival.DateTime)
+	case domain.SortByStops:
+		return cmp.Compare(a.Stops, b.Stops)
+	case domain.SortBySeats:
+		return cmp.Compare(a.AvailableSeats, b.AvailableSeats)
+	case domain.SortByAirline:
+		return strings.Compare(strings.ToLower(a.Airline.Name), strings.ToLower(b.Airline.Name))
+	default:
+		// Lower score = better value
+		return cmp.Compare(a.RankingScore, b.RankingScore)
 	}
-
-	return result
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/herdiagusthio/flight-search-system/domain"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCalculateRankingScores(t *testing.T) {
@@ -78,6 +79,133 @@ func TestCalculateRankingScores(t *testing.T) {
 	}
 }
 
+func TestCalculateRankingScoresWithWeights(t *testing.T) {
+	flights := []domain.Flight{
+		{ID: "cheap_slow", Price: domain.PriceInfo{Amount: 500000}, Duration: domain.DurationInfo{TotalMinutes: 300}, Stops: 1},
+		{ID: "pricey_fast", Price: domain.PriceInfo{Amount: 1500000}, Duration: domain.DurationInfo{TotalMinutes: 100}, Stops: 0},
+	}
+
+	tests := []struct {
+		name       string
+		weights    RankingWeights
+		expectBest string
+	}{
+		{"price only", RankingWeights{FactorPrice: 1}, "cheap_slow"},
+		{"duration only", RankingWeights{FactorDuration: 1}, "pricey_fast"},
+		{"cheapest-ish profile", rankingProfiles[RankingProfileCheapest], "cheap_slow"},
+		{"business profile", rankingProfiles[RankingProfileBusiness], "pricey_fast"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CalculateRankingScoresWithWeights(flights, tt.weights)
+			sorted := SortFlights(result, domain.SortByBestValue)
+			assert.Equal(t, tt.expectBest, sorted[0].ID)
+		})
+	}
+}
+
+func TestCalculateExplainedRankingScores(t *testing.T) {
+	flights := []domain.Flight{
+		{ID: "f1", Price: domain.PriceInfo{Amount: 500000}, Duration: domain.DurationInfo{TotalMinutes: 300}, Stops: 1},
+		{ID: "f2", Price: domain.PriceInfo{Amount: 1500000}, Duration: domain.DurationInfo{TotalMinutes: 100}, Stops: 0},
+		{ID: "f3", Price: domain.PriceInfo{Amount: 1000000}, Duration: domain.DurationInfo{TotalMinutes: 200}, Stops: 0},
+	}
+	weights := DefaultRankingWeights()
+
+	result := CalculateExplainedRankingScores(flights, weights)
+
+	require.Len(t, result, 3)
+	expectedBounds := map[string]domain.RankingBound{
+		FactorPrice:    {Min: 500000, Max: 1500000},
+		FactorDuration: {Min: 100, Max: 300},
+		FactorStops:    {Min: 0, Max: 1},
+	}
+	for _, f := range result {
+		e := f.RankingExplanation
+		require.NotNil(t, e, f.ID)
+		assert.Equal(t, map[string]float64{FactorPrice: 0.5, FactorDuration: 0.3, FactorStops: 0.2}, e.Weights)
+		assert.Equal(t, expectedBounds, e.Bounds)
+
+		// The score is reproducible from the explanation
+		score := 0.0
+		for name, weight := range e.Weights {
+			score += weight * e.Components[name]
+		}
+		assert.InDelta(t, f.RankingScore, score, 1e-9, f.ID)
+	}
+	assert.Equal(t, map[string]float64{FactorPrice: 0.5, FactorDuration: 0.5, FactorStops: 0}, result[2].RankingExplanation.Components)
+
+	// Explanations are opt-in
+	for _, f := range CalculateRankingScoresWithWeights(flights, weights) {
+		assert.Nil(t, f.RankingExplanation)
+	}
+}
+
+func TestRankingWeights_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights RankingWeights
+		wantErr string
+	}{
+		{"default weights", DefaultRankingWeights(), ""},
+		{"single weight", RankingWeights{FactorDuration: 1}, ""},
+		{"rounding tolerated", RankingWeights{FactorPrice: 0.1, FactorDuration: 0.7, FactorStops: 0.2}, ""},
+		{"negative weight", RankingWeights{FactorPrice: 1.2, FactorDuration: -0.2}, "must be non-negative"},
+		{"not normalized", RankingWeights{FactorPrice: 0.5, FactorDuration: 0.5, FactorStops: 0.5}, "must sum to 1"},
+		{"all zero", RankingWeights{}, "must sum to 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.weights.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRankingProfiles(t *testing.T) {
+	assert.Equal(t, []string{"balanced", "business", "cheapest-ish", "fastest-ish"}, RankingProfiles())
+
+	for name, weights := range rankingProfiles {
+		assert.NoError(t, weights.Validate(), name)
+	}
+}
+
+func TestResolveRankingWeights(t *testing.T) {
+	defaults := RankingWeights{FactorPrice: 0.4, FactorDuration: 0.4, FactorStops: 0.2}
+
+	tests := []struct {
+		name    string
+		profile string
+		expect  RankingWeights
+		wantErr bool
+	}{
+		{"empty uses defaults", "", defaults, false},
+		{"balanced uses defaults", "balanced", defaults, false},
+		{"built-in profile", "fastest-ish", rankingProfiles[RankingProfileFastest], false},
+		{"case-insensitive", " Business ", rankingProfiles[RankingProfileBusiness], false},
+		{"unknown profile", "luxury", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			weights, err := resolveRankingWeights(tt.profile, defaults)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expect, weights)
+			assert.True(t, IsRankingProfile(tt.profile) || tt.profile == "")
+		})
+	}
+}
+
 func TestNormalizeValue(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -337,3 +465,96 @@ func TestSortFlightsStability(t *testing.T) {
 	assert.Equal(t, "f2", result[1].ID)
 	assert.Equal(t, "f3", result[2].ID)
 }
+
+func TestSortFlightsBy(t *testing.T) {
+	baseTime := time.Date(2024, 12, 25, 10, 0, 0, 0, time.UTC)
+
+	flights := []domain.Flight{
+		{
+			ID:             "f1",
+			Airline:        domain.AirlineInfo{Name: "Lion Air"},
+			Price:          domain.PriceInfo{Amount: 800000},
+			Stops:          1,
+			Departure:      domain.FlightPoint{DateTime: baseTime},
+			Arrival:        domain.FlightPoint{DateTime: baseTime.Add(3 * time.Hour)},
+			AvailableSeats: 20,
+		},
+		{
+			ID:             "f2",
+			Airline:        domain.AirlineInfo{Name: "batik Air"},
+			Price:          domain.PriceInfo{Amount: 500000},
+			Stops:          0,
+			Departure:      domain.FlightPoint{DateTime: baseTime.Add(2 * time.Hour)},
+			Arrival:        domain.FlightPoint{DateTime: baseTime.Add(4 * time.Hour)},
+			AvailableSeats: 5,
+		},
+		{
+			ID:             "f3",
+			Airline:        domain.AirlineInfo{Name: "Garuda Indonesia"},
+			Price:          domain.PriceInfo{Amount: 500000},
+			Stops:          0,
+			Departure:      domain.FlightPoint{DateTime: baseTime.Add(4 * time.Hour)},
+			Arrival:        domain.FlightPoint{DateTime: baseTime.Add(5 * time.Hour)},
+			AvailableSeats: 40,
+		},
+		{
+			ID:             "f4",
+			Airline:        domain.AirlineInfo{Name: "Garuda Indonesia"},
+			Price:          domain.PriceInfo{Amount: 1200000},
+			Stops:          0,
+			Departure:      domain.FlightPoint{DateTime: baseTime.Add(1 * time.Hour)},
+			Arrival:        domain.FlightPoint{DateTime: baseTime.Add(2 * time.Hour)},
+			AvailableSeats: 40,
+		},
+	}
+
+	tests := []struct {
+		name        string
+		keys        []domain.SortKey
+		expectedIDs []string
+	}{
+		{
+			name:        "stops then price then departure descending",
+			keys:        []domain.SortKey{{Option: domain.SortByStops}, {Option: domain.SortByPrice}, {Option: domain.SortByDeparture, Descending: true}},
+			expectedIDs: []string{"f3", "f2", "f4", "f1"},
+		},
+		{
+			name:        "price descending",
+			keys:        []domain.SortKey{{Option: domain.SortByPrice, Descending: true}},
+			expectedIDs: []string{"f4", "f1", "f2", "f3"},
+		},
+		{
+			name:        "arrival",
+			keys:        []domain.SortKey{{Option: domain.SortByArrival}},
+			expectedIDs: []string{"f4", "f1", "f2", "f3"},
+		},
+		{
+			name:        "seats descending keeps ties stable",
+			keys:        []domain.SortKey{{Option: domain.SortBySeats, Descending: true}},
+			expectedIDs: []string{"f3", "f4", "f1", "f2"},
+		},
+		{
+			name:        "airline name is case-insensitive",
+			keys:        []domain.SortKey{{Option: domain.SortByAirline}, {Option: domain.SortByPrice}},
+			expectedIDs: []string{"f2", "f3", "f4", "f1"},
+		},
+		{
+			name:        "invalid keys are ignored",
+			keys:        []domain.SortKey{{Option: "invalid"}, {Option: domain.SortByDeparture}},
+			expectedIDs: []string{"f1", "f4", "f2", "f3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := SortFlightsBy(flights, tt.keys)
+
+			ids := make([]string, len(result))
+			for i, f := range result {
+				ids[i] = f.ID
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+			assert.Equal(t, "f1", flights[0].ID, "original slice should not be reordered")
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"golang.org/x/time/rate"
+)
+
+// DefaultRateLimitQueueTimeout is how long a query waits for a rate-limited provider
+// before skipping it, when rate limits are configured.
+const DefaultRateLimitQueueTimeout = 100 * time.Millisecond
+
+// RateLimit limits the queries sent to a provider. Zero values mean no limit.
+type RateLimit struct {
+	// RequestsPerSecond is the rate at which the provider's token bucket refills.
+	// Every provider call, including retries, takes a token.
+	RequestsPerSecond float64
+	// Burst is the size of the token bucket. Zero means the requests per second,
+	// rounded up.
+	Burst int
+	// MaxInFlight is the maximum number of concurrent queries to the provider.
+	MaxInFlight int
+}
+
+// isZero reports whether the limit imposes no limit.
+func (l RateLimit) isZero() bool {
+	return l.RequestsPerSecond <= 0 && l.MaxInFlight <= 0
+}
+
+// RateLimitConfig configures per-provider rate limits.
+type RateLimitConfig struct {
+	// Default is the limit of providers without their own.
+	Default RateLimit
+	// Providers are limits by provider name, replacing Default.
+	Providers map[string]RateLimit
+	// QueueTimeout is how long a query waits for a token or an in-flight slot before
+	// the provider is skipped as rate limited. Zero uses DefaultRateLimitQueueTimeout;
+	// a negative value skips it immediately.
+	QueueTimeout time.Duration
+}
+
+// providerLimiter enforces the rate limit of one provider.
+// A nil *providerLimiter limits nothing.
+type providerLimiter struct {
+	tokens *rate.Limiter // nil if there is no rate limit
+	slots  chan struct{} // nil if there is no in-flight limit
+	wait   time.Duration
+}
+
+// newProviderLimiter creates a limiter enforcing limit, waiting up to wait for it.
+// Returns nil if the limit imposes no limit.
+func newProviderLimiter(limit RateLimit, wait time.Duration) *providerLimiter {
+	if limit.isZero() {
+		return nil
+	}
+
+	l := &providerLimiter{wait: wait}
+	if limit.RequestsPerSecond > 0 {
+		burst := limit.Burst
+		if burst <= 0 {
+			burst = int(math.Ceil(limit.RequestsPerSecond))
+		}
+		l.tokens = rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), burst)
+	}
+	if limit.MaxInFlight > 0 {
+		l.slots = make(chan struct{}, limit.MaxInFlight)
+	}
+	return l
+}
+
+// acquire takes an in-flight slot and a token, waiting up to the queue timeout.
+// The returned function releases the slot and must be called when the query is done.
+// Returns ErrProviderRateLimited if the limit is not available in time, or the context
+// error if ctx is done first.
+func (l *providerLimiter) acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	deadline := time.Now().Add(l.wait)
+	release := func() {}
+	if l.slots != nil {
+		if err := l.takeSlot(ctx, deadline); err != nil {
+			return nil, err
+		}
+		release = func() { <-l.slots }
+	}
+
+	if err := l.takeToken(ctx, deadline); err != nil {
+		release()
+		return nil, err
+	}
+	return release, nil
+}
+
+// token takes a token for a further call of a query holding a slot, such as a retry,
+// waiting up to the queue timeout.
+func (l *providerLimiter) token(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	return l.takeToken(ctx, time.Now().Add(l.wait))
+}
+
+// takeSlot takes an in-flight slot, waiting until deadline.
+func (l *providerLimiter) takeSlot(ctx context.Context, deadline time.Time) error {
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	default:
+	}
+
+	wait := time.Until(deadline)
+	if wait <= 0 {
+		return domain.ErrProviderRateLimited
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-timer.C:
+		return domain.ErrProviderRateLimited
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// takeToken takes a token from the bucket, waiting until deadline.
+func (l *providerLimiter) takeToken(ctx context.Context, deadline time.Time) error {
+	if l.tokens == nil || l.tokens.Allow() {
+		return nil
+	}
+	if !time.Now().Before(deadline) {
+		return domain.ErrProviderRateLimited
+	}
+
+	waitCtx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	// Wait fails without waiting if the token would only be available after the deadline
+	if err := l.tokens.Wait(waitCtx); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return domain.ErrProviderRateLimited
+	}
+	return nil
+}
+
+// rateLimiters holds the limiters of all providers, created on first use so that
+// providers registered at runtime are limited too.
+// A nil *rateLimiters limits nothing.
+type rateLimiters struct {
+	config RateLimitConfig
+
+	mu         sync.Mutex
+	byProvider map[string]*providerLimiter
+}
+
+// newRateLimiters creates the limiters for the configuration.
+// Returns nil, disabling rate limiting, if no limit is configured.
+func newRateLimiters(config RateLimitConfig) *rateLimiters {
+	limited := !config.Default.isZero()
+	for _, limit := range config.Providers {
+		limited = limited || !limit.isZero()
+	}
+	if !limited {
+		return nil
+	}
+
+	return &rateLimiters{
+		config:     config,
+		byProvider: make(map[string]*providerLimiter),
+	}
+}
+
+// get returns the limiter of a provider, or nil if the provider is not limited.
+func (r *rateLimiters) get(provider string) *providerLimiter {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if l, ok := r.byProvider[provider]; ok {
+		return l
+	}
+	limit, ok := r.config.Providers[provider]
+	if !ok {
+		limit = r.config.Default
+	}
+	l := newProviderLimiter(limit, r.config.QueueTimeout)
+	r.byProvider[provider] = l
+	return l
+}
@@ -0,0 +1,288 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/internal/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProviderLimiter(t *testing.T) {
+	tests := []struct {
+		name       string
+		limit      RateLimit
+		wantNil    bool
+		wantBurst  int
+		wantSlots  int
+		wantTokens bool
+	}{
+		{
+			name:    "zero limit limits nothing",
+			limit:   RateLimit{},
+			wantNil: true,
+		},
+		{
+			name:       "burst defaults to requests per second rounded up",
+			limit:      RateLimit{RequestsPerSecond: 2.5},
+			wantBurst:  3,
+			wantTokens: true,
+		},
+		{
+			name:       "explicit burst",
+			limit:      RateLimit{RequestsPerSecond: 1, Burst: 5},
+			wantBurst:  5,
+			wantTokens: true,
+		},
+		{
+			name:      "in-flight limit only",
+			limit:     RateLimit{MaxInFlight: 2},
+			wantSlots: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newProviderLimiter(tt.limit, time.Second)
+			if tt.wantNil {
+				assert.Nil(t, l)
+				return
+			}
+
+			require.NotNil(t, l)
+			if tt.wantTokens {
+				require.NotNil(t, l.tokens)
+				assert.Equal(t, tt.wantBurst, l.tokens.Burst())
+			} else {
+				assert.Nil(t, l.tokens)
+			}
+			assert.Equal(t, tt.wantSlots, cap(l.slots))
+		})
+	}
+}
+
+func TestProviderLimiter_NilLimitsNothing(t *testing.T) {
+	var l *providerLimiter
+
+	release, err := l.acquire(context.Background())
+	require.NoError(t, err)
+	release()
+	assert.NoError(t, l.token(context.Background()))
+}
+
+func TestProviderLimiter_TokenBucket(t *testing.T) {
+	l := newProviderLimiter(RateLimit{RequestsPerSecond: 1, Burst: 2}, 10*time.Millisecond)
+
+	for i := 0; i < 2; i++ {
+		release, err := l.acquire(context.Background())
+		require.NoError(t, err, "burst token %d", i+1)
+		release()
+	}
+
+	// The next token is only available after a second, beyond the queue timeout
+	_, err := l.acquire(context.Background())
+	assert.ErrorIs(t, err, domain.ErrProviderRateLimited)
+	assert.ErrorIs(t, l.token(context.Background()), domain.ErrProviderRateLimited)
+}
+
+func TestProviderLimiter_QueuesForToken(t *testing.T) {
+	l := newProviderLimiter(RateLimit{RequestsPerSecond: 50, Burst: 1}, 200*time.Millisecond)
+
+	release, err := l.acquire(context.Background())
+	require.NoError(t, err)
+	release()
+
+	// The next token refills within 20ms, inside the queue timeout
+	start := time.Now()
+	release, err = l.acquire(context.Background())
+	require.NoError(t, err)
+	release()
+	assert.Greater(t, time.Since(start), 5*time.Millisecond, "should have waited for the token")
+}
+
+func TestProviderLimiter_MaxInFlight(t *testing.T) {
+	l := newProviderLimiter(RateLimit{MaxInFlight: 1}, 0)
+
+	release, err := l.acquire(context.Background())
+	require.NoError(t, err)
+
+	_, err = l.acquire(context.Background())
+	assert.ErrorIs(t, err, domain.ErrProviderRateLimited, "slot is held")
+
+	release()
+	release, err = l.acquire(context.Background())
+	require.NoError(t, err, "slot was released")
+	release()
+}
+
+func TestProviderLimiter_QueuesForSlot(t *testing.T) {
+	l := newProviderLimiter(RateLimit{MaxInFlight: 1}, time.Second)
+
+	release, err := l.acquire(context.Background())
+	require.NoError(t, err)
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		release()
+	}()
+
+	release, err = l.acquire(context.Background())
+	require.NoError(t, err, "should get the slot once released")
+	release()
+}
+
+func TestProviderLimiter_ContextDone(t *testing.T) {
+	l := newProviderLimiter(RateLimit{MaxInFlight: 1}, time.Second)
+
+	release, err := l.acquire(context.Background())
+	require.NoError(t, err)
+	defer release()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = l.acquire(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.False(t, errors.Is(err, domain.ErrProviderRateLimited))
+}
+
+func TestRateLimiters(t *testing.T) {
+	t.Run("no limits disables rate limiting", func(t *testing.T) {
+		r := newRateLimiters(RateLimitConfig{QueueTimeout: time.Second})
+		assert.Nil(t, r)
+		assert.Nil(t, r.get("garuda"))
+	})
+
+	t.Run("provider limit replaces the default", func(t *testing.T) {
+		r := newRateLimiters(RateLimitConfig{
+			Default:   RateLimit{MaxInFlight: 4},
+			Providers: map[string]RateLimit{"lion_air": {RequestsPerSecond: 2}, "airasia": {}},
+		})
+		require.NotNil(t, r)
+
+		garuda := r.get("garuda")
+		require.NotNil(t, garuda)
+		assert.Equal(t, 4, cap(garuda.slots))
+		assert.Same(t, garuda, r.get("garuda"), "limiter is shared across queries")
+
+		lionAir := r.get("lion_air")
+		require.NotNil(t, lionAir)
+		assert.Nil(t, lionAir.slots)
+		assert.NotNil(t, lionAir.tokens)
+
+		assert.Nil(t, r.get("airasia"), "zero provider limit disables the default")
+	})
+}
+
+func TestNewFlightSearchUseCase_RateLimitQueueTimeout(t *testing.T) {
+	tests := []struct {
+		name         string
+		queueTimeout time.Duration
+		expected     time.Duration
+	}{
+		{"unset uses the default", 0, DefaultRateLimitQueueTimeout},
+		{"custom", time.Second, time.Second},
+		{"negative skips immediately", -1, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewFlightSearchUseCase(nil, &Config{
+				RateLimits: RateLimitConfig{Default: RateLimit{MaxInFlight: 2}, QueueTimeout: tt.queueTimeout},
+			}).(*flightSearchUseCase)
+
+			limiter := uc.rateLimits.get("garuda")
+			require.NotNil(t, limiter)
+			assert.Equal(t, tt.expected, limiter.wait)
+		})
+	}
+}
+
+func TestSearch_SkipsRateLimitedProviders(t *testing.T) {
+	garuda := &countingProvider{mockProvider: mockProvider{
+		name:    "garuda",
+		flights: []domain.Flight{{ID: "f1", Price: domain.PriceInfo{Amount: 500000}}},
+	}}
+	lionAir := &countingProvider{mockProvider: mockProvider{
+		name:    "lion_air",
+		flights: []domain.Flight{{ID: "f2", Price: domain.PriceInfo{Amount: 400000}}},
+	}}
+
+	uc := NewFlightSearchUseCase([]domain.FlightProvider{garuda, lionAir}, &Config{
+		RateLimits: RateLimitConfig{
+			Providers: map[string]RateLimit{"lion_air": {RequestsPerSecond: 0.01, Burst: 1}},
+		},
+	})
+	criteria := domain.SearchCriteria{
+		Origin:        "CGK",
+		Destination:   "DPS",
+		DepartureDate: "2024-12-25",
+		Passengers:    1,
+	}
+
+	first, err := uc.Search(context.Background(), criteria, DefaultSearchOptions())
+	require.NoError(t, err)
+	assert.Len(t, first.Flights, 2)
+	assert.Zero(t, first.Metadata.ProvidersRateLimited)
+
+	second, err := uc.Search(context.Background(), criteria, DefaultSearchOptions())
+	require.NoError(t, err)
+	assert.Len(t, second.Flights, 1)
+	assert.Equal(t, 1, lionAir.calls, "rate-limited provider should not be queried")
+	assert.Equal(t, 2, garuda.calls)
+
+	assert.Equal(t, 1, second.Metadata.ProvidersQueried)
+	assert.Equal(t, 1, second.Metadata.ProvidersSucceeded)
+	assert.Zero(t, second.Metadata.ProvidersFailed)
+	assert.Equal(t, 1, second.Metadata.ProvidersRateLimited)
+	assert.Equal(t, []string{"lion_air"}, second.Metadata.RateLimitedProviders)
+}
+
+func TestSearch_RecordsRateLimitedProviders(t *testing.T) {
+	// The provider name is unique to this test, since the metrics are global
+	provider := &mockProvider{
+		name:    "rate_limited_metrics_provider",
+		flights: []domain.Flight{{ID: "f1", Price: domain.PriceInfo{Amount: 500000}}},
+	}
+	uc := NewFlightSearchUseCase([]domain.FlightProvider{provider}, &Config{
+		RateLimits: RateLimitConfig{
+			Providers: map[string]RateLimit{provider.name: {RequestsPerSecond: 0.01, Burst: 1}},
+		},
+	})
+
+	for i := 0; i < 3; i++ {
+		_, _ = uc.Search(context.Background(), domain.SearchCriteria{}, DefaultSearchOptions())
+	}
+
+	counter := metrics.ProviderErrors.WithLabelValues(provider.name, metrics.ErrorTypeRateLimited)
+	assert.Equal(t, 2.0, testutil.ToFloat64(counter))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.ProviderCalls.WithLabelValues(provider.name)))
+}
+
+func TestSearch_AllProvidersRateLimited(t *testing.T) {
+	provider := &mockProvider{
+		name:    "garuda",
+		flights: []domain.Flight{{ID: "f1", Price: domain.PriceInfo{Amount: 500000}}},
+	}
+
+	uc := NewFlightSearchUseCase([]domain.FlightProvider{provider}, &Config{
+		RateLimits: RateLimitConfig{Default: RateLimit{RequestsPerSecond: 0.01, Burst: 1}},
+	})
+	criteria := domain.SearchCriteria{
+		Origin:        "CGK",
+		Destination:   "DPS",
+		DepartureDate: "2024-12-25",
+		Passengers:    1,
+	}
+
+	_, err := uc.Search(context.Background(), criteria, DefaultSearchOptions())
+	require.NoError(t, err)
+
+	result, err := uc.Search(context.Background(), criteria, DefaultSearchOptions())
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, domain.ErrAllProvidersFailed)
+}
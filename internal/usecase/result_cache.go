@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/internal/metrics"
+)
+
+// resultCacheMaxEntries bounds the cache size; expired entries are purged when it is reached.
+const resultCacheMaxEntries = 10000
+
+// resultCache caches successful provider results by provider and search criteria.
+// Flights are copied in and out, since searches modify the flights they rank.
+// A nil *resultCache caches nothing.
+type resultCache struct {
+	ttl     time.Duration
+	now     func() time.Time
+	mu      sync.Mutex
+	entries map[string]resultCacheEntry
+}
+
+// resultCacheEntry is a cached provider result.
+type resultCacheEntry struct {
+	flights   []domain.Flight
+	expiresAt time.Time
+}
+
+// newResultCache creates a result cache keeping entries for ttl.
+// Returns nil, disabling caching, if ttl is not positive.
+func newResultCache(ttl time.Duration) *resultCache {
+	if ttl <= 0 {
+		return nil
+	}
+	return &resultCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]resultCacheEntry),
+	}
+}
+
+// resultCacheKey identifies a provider query. Travel documents are not part of the key,
+// since providers do not take them into account.
+func resultCacheKey(provider string, criteria domain.SearchCriteria) string {
+	return fmt.Sprintf("%s|%s|%s|%s|%d|%s", provider, criteria.Origin, criteria.Destination,
+		criteria.DepartureDate, criteria.Passengers, criteria.Class)
+}
+
+// get returns a copy of the cached flights of a provider for the criteria, if not
+// expired. Lookups are recorded as cache hits or misses.
+func (c *resultCache) get(provider string, criteria domain.SearchCriteria) ([]domain.Flight, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	flights, ok := c.lookup(provider, criteria)
+	result := metrics.CacheMiss
+	if ok {
+		result = metrics.CacheHit
+	}
+	metrics.CacheLookups.WithLabelValues(provider, result).Inc()
+	return flights, ok
+}
+
+// lookup returns the cached flights of a provider for the criteria, if not expired.
+func (c *resultCache) lookup(provider string, criteria domain.SearchCriteria) ([]domain.Flight, bool) {
+	key := resultCacheKey(provider, criteria)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return slices.Clone(entry.flights), true
+}
+
+// put caches a copy of the flights of a provider for the criteria.
+func (c *resultCache) put(provider string, criteria domain.SearchCriteria, flights []domain.Flight) {
+	if c == nil {
+		return
+	}
+
+	now := c.now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= resultCacheMaxEntries {
+		for key, entry := range c.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(c.entries, key)
+			}
+		}
+		if len(c.entries) >= resultCacheMaxEntries {
+			return
+		}
+	}
+	c.entries[resultCacheKey(provider, criteria)] = resultCacheEntry{
+		flights:   slices.Clone(flights),
+		expiresAt: now.Add(c.ttl),
+	}
+}
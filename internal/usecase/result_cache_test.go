@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingProvider is a test provider counting its Search calls.
+type countingProvider struct {
+	mockProvider
+	calls int
+}
+
+func (p *countingProvider) Search(ctx context.Context, criteria domain.SearchCriteria) ([]domain.Flight, error) {
+	p.calls++
+	return p.mockProvider.Search(ctx, criteria)
+}
+
+func TestResultCache(t *testing.T) {
+	now := time.Date(2025, 12, 1, 8, 0, 0, 0, time.UTC)
+	cache := newResultCache(time.Minute)
+	cache.now = func() time.Time { return now }
+
+	criteria := domain.SearchCriteria{Origin: "CGK", Destination: "DPS", DepartureDate: "2025-12-15", Passengers: 1, Class: "economy"}
+	flights := []domain.Flight{{ID: "f1"}}
+
+	_, ok := cache.get("garuda", criteria)
+	assert.False(t, ok, "empty cache")
+
+	cache.put("garuda", criteria, flights)
+
+	cached, ok := cache.get("garuda", criteria)
+	require.True(t, ok)
+	assert.Equal(t, flights, cached)
+
+	_, ok = cache.get("lion_air", criteria)
+	assert.False(t, ok, "other provider")
+
+	other := criteria
+	other.Passengers = 2
+	_, ok = cache.get("garuda", other)
+	assert.False(t, ok, "other criteria")
+
+	withDocuments := criteria
+	withDocuments.TravelDocuments = &domain.TravelDocuments{Nationality: "ID"}
+	_, ok = cache.get("garuda", withDocuments)
+	assert.True(t, ok, "travel documents are not part of the key")
+
+	now = now.Add(time.Minute)
+	_, ok = cache.get("garuda", criteria)
+	assert.False(t, ok, "expired")
+}
+
+func TestResultCache_CopiesFlights(t *testing.T) {
+	cache := newResultCache(time.Minute)
+	criteria := domain.SearchCriteria{Origin: "CGK", Destination: "DPS", DepartureDate: "2025-12-15", Passengers: 1}
+	flights := []domain.Flight{{ID: "f1", Price: domain.PriceInfo{Amount: 500000, Currency: "IDR"}}}
+
+	cache.put("garuda", criteria, flights)
+	flights[0].Price.Amount = 1
+
+	cached, ok := cache.get("garuda", criteria)
+	require.True(t, ok)
+	assert.Equal(t, 500000.0, cached[0].Price.Amount, "changes to the stored slice do not reach the cache")
+
+	cached[0].Price.Currency = "USD"
+	cached, ok = cache.get("garuda", criteria)
+	require.True(t, ok)
+	assert.Equal(t, "IDR", cached[0].Price.Currency, "changes to a returned slice do not reach the cache")
+}
+
+func TestResultCache_Disabled(t *testing.T) {
+	cache := newResultCache(0)
+	assert.Nil(t, cache)
+
+	criteria := domain.SearchCriteria{Origin: "CGK", Destination: "DPS"}
+	cache.put("garuda", criteria, []domain.Flight{{ID: "f1"}})
+	_, ok := cache.get("garuda", criteria)
+	assert.False(t, ok)
+}
+
+func TestSearch_ResultCache(t *testing.T) {
+	provider := &countingProvider{mockProvider: mockProvider{
+		name:    "test_provider",
+		flights: []domain.Flight{{ID: "f1", Price: domain.PriceInfo{Amount: 500000}}},
+	}}
+	uc := NewFlightSearchUseCase([]domain.FlightProvider{provider}, &Config{ResultCacheTTL: time.Minute})
+	criteria := domain.SearchCriteria{Origin: "CGK", Destination: "DPS", DepartureDate: "2025-12-15", Passengers: 1}
+
+	first, err := uc.Search(context.Background(), criteria, DefaultSearchOptions())
+	require.NoError(t, err)
+	assert.False(t, first.Metadata.CacheHit)
+
+	second, err := uc.Search(context.Background(), criteria, DefaultSearchOptions())
+	require.NoError(t, err)
+	assert.True(t, second.Metadata.CacheHit)
+	assert.Equal(t, 1, provider.calls)
+	require.Len(t, second.Flights, 1)
+	assert.Equal(t, "f1", second.Flights[0].ID)
+}
+
+func TestSearch_ResultCacheSkipsFailures(t *testing.T) {
+	provider := &countingProvider{mockProvider: mockProvider{
+		name: "test_provider",
+		err:  &domain.ProviderError{Provider: "test_provider", Err: domain.ErrProviderUnavailable},
+	}}
+	uc := NewFlightSearchUseCase([]domain.FlightProvider{provider}, &Config{ResultCacheTTL: time.Minute})
+	criteria := domain.SearchCriteria{Origin: "CGK", Destination: "DPS", DepartureDate: "2025-12-15", Passengers: 1}
+
+	_, err := uc.Search(context.Background(), criteria, DefaultSearchOptions())
+	require.Error(t, err)
+	_, err = uc.Search(context.Background(), criteria, DefaultSearchOptions())
+	require.Error(t, err)
+
+	assert.Equal(t, 2, provider.calls)
+}
@@ -0,0 +1,328 @@
+package usecase
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+)
+
+// Built-in scoring factor names, used as keys of RankingWeights.
+const (
+	FactorPrice         = "price"         // Lower price is better
+	FactorDuration      = "duration"      // Shorter travel time is better
+	FactorStops         = "stops"         // Fewer stops is better
+	FactorDepartureTime = "departureTime" // Departing inside the preferred window is better
+	FactorBaggage       = "baggage"       // More checked baggage is better
+	FactorAmenities     = "amenities"     // More amenities is better
+	FactorOnTime        = "onTime"        // Higher airline on-time rate is better
+	FactorSeats         = "seats"         // More available seats (less scarcity) is better
+)
+
+// Default preferred departure window, in local time, used when the search sets none.
+const (
+	defaultDepartureWindowStart = 6 * 60  // 06:00
+	defaultDepartureWindowEnd   = 22 * 60 // 22:00
+)
+
+// departureWindowTolerance is how far outside the preferred window, in minutes,
+// a departure gets the worst departure time score.
+const departureWindowTolerance = 6 * 60
+
+// unknownOnTimeScore is the neutral on-time score of airlines without on-time data.
+const unknownOnTimeScore = 0.5
+
+// ScoringPreferences are per-search traveler preferences available to scoring components.
+type ScoringPreferences struct {
+	// DepartureWindow is the preferred local departure window. Nil means the default window.
+	DepartureWindow *domain.TimeRange
+}
+
+// ScoringComponent is a pluggable factor of the best-value score.
+// Components are combined by Ranker according to RankingWeights keyed by Name.
+type ScoringComponent interface {
+	// Name identifies the component in RankingWeights and ranking explanations.
+	Name() string
+	// Prepare readies the component for a result set.
+	Prepare(flights []domain.Flight, prefs ScoringPreferences) PreparedComponent
+}
+
+// PreparedComponent scores flights of the result set it was prepared for.
+type PreparedComponent struct {
+	// Score returns the flight's normalized score in [0,1], 0 being the best.
+	Score func(f domain.Flight) float64
+	// Bounds are the result set's normalization bounds, nil if the component does not
+	// normalize against the result set.
+	Bounds *domain.RankingBound
+}
+
+// builtinScoringComponents returns the scoring components every Ranker supports.
+func builtinScoringComponents() []ScoringComponent {
+	return []ScoringComponent{
+		minMaxComponent{
+			name:   FactorPrice,
+			value:  func(f domain.Flight) float64 { return f.Price.Amount },
+			bounds: findPriceRange,
+		},
+		minMaxComponent{
+			name:  FactorDuration,
+			value: func(f domain.Flight) float64 { return float64(f.Duration.TotalMinutes) },
+			bounds: func(flights []domain.Flight) (float64, float64) {
+				min, max := findDurationRange(flights)
+				return float64(min), float64(max)
+			},
+		},
+		minMaxComponent{
+			name:  FactorStops,
+			value: func(f domain.Flight) float64 { return float64(f.Stops) },
+			bounds: func(flights []domain.Flight) (float64, float64) {
+				min, max := findStopsRange(flights)
+				return float64(min), float64(max)
+			},
+		},
+		departureTimeComponent{},
+		minMaxComponent{
+			name:           FactorBaggage,
+			value:          func(f domain.Flight) float64 { return float64(f.Baggage.CheckedKg) },
+			higherIsBetter: true,
+		},
+		minMaxComponent{
+			name:           FactorAmenities,
+			value:          func(f domain.Flight) float64 { return float64(len(f.Amenities)) },
+			higherIsBetter: true,
+		},
+		onTimeComponent{},
+		minMaxComponent{
+			name:           FactorSeats,
+			value:          func(f domain.Flight) float64 { return float64(f.AvailableSeats) },
+			higherIsBetter: true,
+		},
+	}
+}
+
+// Ranker computes best-value scores as the weighted sum of scoring components.
+type Ranker struct {
+	components map[string]ScoringComponent
+}
+
+// NewRanker creates a Ranker supporting the built-in components and the given extra
+// components. An extra component replaces a built-in component with the same name.
+func NewRanker(extra ...ScoringComponent) *Ranker {
+	components := make(map[string]ScoringComponent)
+	for _, c := range builtinScoringComponents() {
+		components[c.Name()] = c
+	}
+	for _, c := range extra {
+		components[c.Name()] = c
+	}
+	return &Ranker{components: components}
+}
+
+// defaultRanker is the Ranker used by the package-level ranking functions.
+var defaultRanker = NewRanker()
+
+// Components returns the names of the supported scoring components, sorted.
+func (r *Ranker) Components() []string {
+	names := make([]string, 0, len(r.components))
+	for name := range r.components {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ValidateWeights checks that the weights are valid and only name supported components.
+func (r *Ranker) ValidateWeights(w RankingWeights) error {
+	for name := range w {
+		if _, ok := r.components[name]; !ok {
+			return fmt.Errorf("unknown ranking factor %q", name)
+		}
+	}
+	return w.Validate()
+}
+
+// Score sets each flight's RankingScore to the weighted sum of its component scores.
+// Components with zero weight are skipped. If explain is set, each flight also gets a
+// RankingExplanation. Factors without a supported component are ignored.
+// Returns a copy; the input is not modified.
+func (r *Ranker) Score(flights []domain.Flight, w RankingWeights, prefs ScoringPreferences, explain bool) []domain.Flight {
+	if len(flights) == 0 {
+		return flights
+	}
+
+	// Prepare weighted components in name order so scores are summed deterministically
+	type weighted struct {
+		name     string
+		weight   float64
+		prepared PreparedComponent
+	}
+	var active []weighted
+	weights := make(map[string]float64)
+	bounds := make(map[string]domain.RankingBound)
+	for _, name := range r.Components() {
+		weight := w[name]
+		if weight == 0 {
+			continue
+		}
+		prepared := r.components[name].Prepare(flights, prefs)
+		active = append(active, weighted{name: name, weight: weight, prepared: prepared})
+		weights[name] = weight
+		if prepared.Bounds != nil {
+			bounds[name] = *prepared.Bounds
+		}
+	}
+
+	// Calculate scores - create a copy to avoid mutating input
+	result := make([]domain.Flight, len(flights))
+	for i, f := range flights {
+		result[i] = f
+
+		score := 0.0
+		components := make(map[string]float64, len(active))
+		for _, c := range active {
+			value := c.prepared.Score(f)
+			components[c.name] = value
+			score += c.weight * value
+		}
+		result[i].RankingScore = score
+
+		if explain {
+			result[i].RankingExplanation = &domain.RankingExplanation{
+				Components: components,
+				Weights:    maps.Clone(weights),
+				Bounds:     maps.Clone(bounds),
+			}
+		}
+	}
+
+	return result
+}
+
+// minMaxComponent scores a numeric flight attribute normalized between the
+// result set's minimum and maximum.
+type minMaxComponent struct {
+	name           string
+	value          func(f domain.Flight) float64
+	bounds         func(flights []domain.Flight) (min, max float64) // Defaults to the range of value
+	higherIsBetter bool
+}
+
+// Name implements ScoringComponent.
+func (c minMaxComponent) Name() string {
+	return c.name
+}
+
+// Prepare implements ScoringComponent.
+func (c minMaxComponent) Prepare(flights []domain.Flight, _ ScoringPreferences) PreparedComponent {
+	var min, max float64
+	if c.bounds != nil {
+		min, max = c.bounds(flights)
+	} else {
+		min, max = valueRange(flights, c.value)
+	}
+
+	return PreparedComponent{
+		Score: func(f domain.Flight) float64 {
+			if max == min {
+				return 0 // All values equal = all optimal
+			}
+			normalized := normalizeValue(c.value(f), min, max)
+			if c.higherIsBetter {
+				return 1 - normalized
+			}
+			return normalized
+		},
+		Bounds: &domain.RankingBound{Min: min, Max: max},
+	}
+}
+
+// valueRange finds the minimum and maximum of value across all flights.
+func valueRange(flights []domain.Flight, value func(f domain.Flight) float64) (min, max float64) {
+	if len(flights) == 0 {
+		return 0, 0
+	}
+
+	min, max = math.MaxFloat64, -math.MaxFloat64
+	for _, f := range flights {
+		v := value(f)
+		min = math.Min(min, v)
+		max = math.Max(max, v)
+	}
+	return min, max
+}
+
+// departureTimeComponent scores how far a flight departs outside the preferred
+// departure window, in local time. Departures inside the window score 0 and the score
+// grows linearly to 1 at departureWindowTolerance minutes outside it.
+type departureTimeComponent struct{}
+
+// Name implements ScoringComponent.
+func (departureTimeComponent) Name() string {
+	return FactorDepartureTime
+}
+
+// Prepare implements ScoringComponent.
+func (departureTimeComponent) Prepare(_ []domain.Flight, prefs ScoringPreferences) PreparedComponent {
+	start, end := defaultDepartureWindowStart, defaultDepartureWindowEnd
+	if w := prefs.DepartureWindow; w != nil {
+		start = minuteOfDay(w.Start)
+		end = minuteOfDay(w.End)
+	}
+
+	return PreparedComponent{
+		Score: func(f domain.Flight) float64 {
+			outside := minutesOutsideWindow(minuteOfDay(f.Departure.LocalTime()), start, end)
+			return math.Min(float64(outside)/departureWindowTolerance, 1)
+		},
+	}
+}
+
+// minuteOfDay returns the minutes elapsed since midnight.
+func minuteOfDay(t time.Time) int {
+	return t.Hour()*60 + t.Minute()
+}
+
+// minutesOutsideWindow returns how many minutes minute lies outside the window
+// [start, end], measured around the clock. A window with start after end wraps midnight.
+func minutesOutsideWindow(minute, start, end int) int {
+	const day = 24 * 60
+	inside := minute >= start && minute <= end
+	if start > end {
+		inside = minute >= start || minute <= end
+	}
+	if inside {
+		return 0
+	}
+
+	// Distance to the nearest window edge, going around the clock
+	toStart := (start - minute + day) % day
+	fromEnd := (minute - end + day) % day
+	if toStart < fromEnd {
+		return toStart
+	}
+	return fromEnd
+}
+
+// onTimeComponent scores the airline's on-time rate from the airline reference data.
+// Airlines without on-time data get a neutral score.
+type onTimeComponent struct{}
+
+// Name implements ScoringComponent.
+func (onTimeComponent) Name() string {
+	return FactorOnTime
+}
+
+// Prepare implements ScoringComponent.
+func (onTimeComponent) Prepare(_ []domain.Flight, _ ScoringPreferences) PreparedComponent {
+	return PreparedComponent{
+		Score: func(f domain.Flight) float64 {
+			if f.Airline.OnTimeRate <= 0 {
+				return unknownOnTimeScore
+			}
+			return 1 - math.Min(f.Airline.OnTimeRate, 1)
+		},
+	}
+}
@@ -0,0 +1,221 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// departingAt returns a flight departing at the given UTC time of day.
+func departingAt(id string, hour, minute int) domain.Flight {
+	return domain.Flight{
+		ID:        id,
+		Departure: domain.FlightPoint{DateTime: time.Date(2025, 12, 15, hour, minute, 0, 0, time.UTC)},
+	}
+}
+
+// scoresByID prepares the component for the flights and returns each flight's score.
+func scoresByID(c ScoringComponent, flights []domain.Flight, prefs ScoringPreferences) map[string]float64 {
+	prepared := c.Prepare(flights, prefs)
+	scores := make(map[string]float64, len(flights))
+	for _, f := range flights {
+		scores[f.ID] = prepared.Score(f)
+	}
+	return scores
+}
+
+func TestMinutesOutsideWindow(t *testing.T) {
+	tests := []struct {
+		name       string
+		minute     int
+		start, end int
+		expected   int
+	}{
+		{name: "inside window", minute: 9 * 60, start: 6 * 60, end: 22 * 60, expected: 0},
+		{name: "on window start", minute: 6 * 60, start: 6 * 60, end: 22 * 60, expected: 0},
+		{name: "before window", minute: 5 * 60, start: 6 * 60, end: 22 * 60, expected: 60},
+		{name: "after window", minute: 23 * 60, start: 6 * 60, end: 22 * 60, expected: 60},
+		{name: "nearest edge across midnight", minute: 30, start: 6 * 60, end: 23 * 60, expected: 90},
+		{name: "inside window wrapping midnight", minute: 60, start: 22 * 60, end: 2 * 60, expected: 0},
+		{name: "outside window wrapping midnight", minute: 12 * 60, start: 22 * 60, end: 2 * 60, expected: 600},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, minutesOutsideWindow(tt.minute, tt.start, tt.end))
+		})
+	}
+}
+
+func TestDepartureTimeComponent(t *testing.T) {
+	flights := []domain.Flight{
+		departingAt("morning", 8, 0),
+		departingAt("early", 3, 0),
+		departingAt("red-eye", 0, 0),
+		departingAt("evening", 19, 0),
+	}
+
+	tests := []struct {
+		name     string
+		prefs    ScoringPreferences
+		expected map[string]float64
+	}{
+		{
+			name:     "default window",
+			prefs:    ScoringPreferences{},
+			expected: map[string]float64{"morning": 0, "early": 0.5, "red-eye": 1.0 / 3, "evening": 0},
+		},
+		{
+			name: "preferred window",
+			prefs: ScoringPreferences{DepartureWindow: &domain.TimeRange{
+				Start: time.Date(2006, 1, 2, 18, 0, 0, 0, time.UTC),
+				End:   time.Date(2006, 1, 2, 21, 0, 0, 0, time.UTC),
+			}},
+			expected: map[string]float64{"morning": 1, "early": 1, "red-eye": 0.5, "evening": 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scores := scoresByID(departureTimeComponent{}, flights, tt.prefs)
+			for id, expected := range tt.expected {
+				assert.InDelta(t, expected, scores[id], 1e-9, id)
+			}
+		})
+	}
+}
+
+func TestHigherIsBetterComponents(t *testing.T) {
+	flights := []domain.Flight{
+		{ID: "rich", Baggage: domain.BaggageInfo{CheckedKg: 30}, Amenities: []string{"wifi", "meal"}, AvailableSeats: 50},
+		{ID: "mid", Baggage: domain.BaggageInfo{CheckedKg: 20}, Amenities: []string{"wifi"}, AvailableSeats: 30},
+		{ID: "bare", Baggage: domain.BaggageInfo{CheckedKg: 10}, AvailableSeats: 10},
+	}
+	components := make(map[string]ScoringComponent)
+	for _, c := range builtinScoringComponents() {
+		components[c.Name()] = c
+	}
+
+	for _, factor := range []string{FactorBaggage, FactorAmenities, FactorSeats} {
+		t.Run(factor, func(t *testing.T) {
+			scores := scoresByID(components[factor], flights, ScoringPreferences{})
+			assert.Equal(t, 0.0, scores["rich"])
+			assert.InDelta(t, 0.5, scores["mid"], 1e-9)
+			assert.Equal(t, 1.0, scores["bare"])
+		})
+	}
+
+	t.Run("equal values score 0", func(t *testing.T) {
+		equal := []domain.Flight{
+			{ID: "a", Baggage: domain.BaggageInfo{CheckedKg: 20}},
+			{ID: "b", Baggage: domain.BaggageInfo{CheckedKg: 20}},
+		}
+		scores := scoresByID(components[FactorBaggage], equal, ScoringPreferences{})
+		assert.Equal(t, map[string]float64{"a": 0, "b": 0}, scores)
+	})
+}
+
+func TestOnTimeComponent(t *testing.T) {
+	flights := []domain.Flight{
+		{ID: "punctual", Airline: domain.AirlineInfo{OnTimeRate: 0.9}},
+		{ID: "late", Airline: domain.AirlineInfo{OnTimeRate: 0.6}},
+		{ID: "unknown"},
+	}
+
+	scores := scoresByID(onTimeComponent{}, flights, ScoringPreferences{})
+	assert.InDelta(t, 0.1, scores["punctual"], 1e-9)
+	assert.InDelta(t, 0.4, scores["late"], 1e-9)
+	assert.Equal(t, unknownOnTimeScore, scores["unknown"])
+}
+
+// evenIDComponent is a test scoring component preferring flights with an even ID length.
+type evenIDComponent struct{}
+
+func (evenIDComponent) Name() string { return "evenID" }
+
+func (evenIDComponent) Prepare(_ []domain.Flight, _ ScoringPreferences) PreparedComponent {
+	return PreparedComponent{
+		Score: func(f domain.Flight) float64 {
+			if len(f.ID)%2 == 0 {
+				return 0
+			}
+			return 1
+		},
+	}
+}
+
+func TestRanker_CustomComponent(t *testing.T) {
+	ranker := NewRanker(evenIDComponent{})
+	assert.Contains(t, ranker.Components(), "evenID")
+	assert.Contains(t, ranker.Components(), FactorPrice)
+
+	weights := RankingWeights{FactorPrice: 0.5, "evenID": 0.5}
+	require.NoError(t, ranker.ValidateWeights(weights))
+
+	flights := []domain.Flight{
+		{ID: "odd", Price: domain.PriceInfo{Amount: 500000}},
+		{ID: "even", Price: domain.PriceInfo{Amount: 1000000}},
+	}
+	result := ranker.Score(flights, weights, ScoringPreferences{}, false)
+	require.Len(t, result, 2)
+	assert.Equal(t, 0.5, result[0].RankingScore)
+	assert.Equal(t, 0.5, result[1].RankingScore)
+}
+
+func TestRanker_ValidateWeights(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights RankingWeights
+		wantErr string
+	}{
+		{
+			name:    "built-in factors",
+			weights: RankingWeights{FactorPrice: 0.4, FactorDepartureTime: 0.2, FactorOnTime: 0.2, FactorSeats: 0.2},
+		},
+		{
+			name:    "unknown factor",
+			weights: RankingWeights{FactorPrice: 0.5, "legroom": 0.5},
+			wantErr: `unknown ranking factor "legroom"`,
+		},
+		{
+			name:    "invalid sum",
+			weights: RankingWeights{FactorPrice: 0.5},
+			wantErr: "must sum to 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewRanker().ValidateWeights(tt.weights)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestRanker_ExplanationOmitsZeroWeightFactors(t *testing.T) {
+	flights := []domain.Flight{
+		{ID: "f1", Price: domain.PriceInfo{Amount: 500000}, Baggage: domain.BaggageInfo{CheckedKg: 20}},
+		{ID: "f2", Price: domain.PriceInfo{Amount: 1000000}, Baggage: domain.BaggageInfo{CheckedKg: 0}},
+	}
+	weights := RankingWeights{FactorPrice: 0.6, FactorBaggage: 0.4, FactorStops: 0}
+
+	result := NewRanker().Score(flights, weights, ScoringPreferences{}, true)
+	require.Len(t, result, 2)
+
+	explanation := result[1].RankingExplanation
+	require.NotNil(t, explanation)
+	assert.Equal(t, map[string]float64{FactorPrice: 1, FactorBaggage: 1}, explanation.Components)
+	assert.Equal(t, map[string]float64{FactorPrice: 0.6, FactorBaggage: 0.4}, explanation.Weights)
+	assert.Equal(t, domain.RankingBound{Min: 500000, Max: 1000000}, explanation.Bounds[FactorPrice])
+	assert.Equal(t, domain.RankingBound{Min: 0, Max: 20}, explanation.Bounds[FactorBaggage])
+	assert.Equal(t, 0.0, result[0].RankingScore)
+	assert.InDelta(t, 1.0, result[1].RankingScore, 1e-9)
+}
@@ -0,0 +1,215 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/herdiagusthio/flight-search-system/pkg/airport"
+	"github.com/rs/zerolog/log"
+)
+
+// Default self-transfer settings.
+const (
+	DefaultSelfTransferMinConnection = 90 * time.Minute
+	DefaultSelfTransferMaxConnection = 8 * time.Hour
+	DefaultSelfTransferMinSaving     = 0.2
+)
+
+// DefaultSelfTransferHubs returns the default connection airports for self-transfers.
+func DefaultSelfTransferHubs() []string {
+	return []string{"CGK", "SUB", "DPS", "UPG", "KNO", "SIN", "KUL"}
+}
+
+// SelfTransferConfig configures the self-transfer connection builder.
+type SelfTransferConfig struct {
+	// Enabled turns on building self-transfer connections via Hubs.
+	Enabled bool
+	// Hubs are the connection airports queried for legs.
+	Hubs []string
+	// DefaultMinConnection is the minimum connection time at hubs without one in the
+	// airport reference data.
+	DefaultMinConnection time.Duration
+	// MaxConnection is the longest accepted connection time.
+	MaxConnection time.Duration
+	// MinSaving is the share of the cheapest regular fare a self-transfer must save to be
+	// shown alongside regular flights, used as given when Enabled is set. Self-transfers
+	// are always shown if there are no regular flights.
+	MinSaving float64
+}
+
+// DefaultSelfTransferConfig returns the default self-transfer configuration, disabled.
+func DefaultSelfTransferConfig() SelfTransferConfig {
+	return SelfTransferConfig{
+		Hubs:                 DefaultSelfTransferHubs(),
+		DefaultMinConnection: DefaultSelfTransferMinConnection,
+		MaxConnection:        DefaultSelfTransferMaxConnection,
+		MinSaving:            DefaultSelfTransferMinSaving,
+	}
+}
+
+// minConnection returns the minimum self-transfer connection time at a hub.
+func (c SelfTransferConfig) minConnection(hub string) time.Duration {
+	if a, ok := airport.Lookup(hub); ok && a.MinConnectionMinutes > 0 {
+		return time.Duration(a.MinConnectionMinutes) * time.Minute
+	}
+	return c.DefaultMinConnection
+}
+
+// buildSelfTransfers queries the providers for legs to and from each hub and combines
+// compatible legs into self-transfer itineraries, priced in the base currency, costing
+// at most maxPrice. The legs of all hubs are queried concurrently; legs from a hub on the
+// next day are also queried when a leg to the hub arrives late enough for the connection
+// window to pass midnight. Leg queries that fail are skipped.
+func (uc *flightSearchUseCase) buildSelfTransfers(ctx context.Context, criteria domain.SearchCriteria, maxPrice float64) []domain.Flight {
+	type hubLegs struct {
+		hub               string
+		inbound, outbound []domain.Flight
+		nextDay           []domain.Flight
+	}
+
+	nextDate := ""
+	if date, err := time.Parse("2006-01-02", criteria.DepartureDate); err == nil {
+		nextDate = date.AddDate(0, 0, 1).Format("2006-01-02")
+	}
+
+	var wg sync.WaitGroup
+	var legs []*hubLegs
+	for _, hub := range uc.selfTransfer.Hubs {
+		hub = strings.ToUpper(strings.TrimSpace(hub))
+		if hub == criteria.Origin || hub == criteria.Destination {
+			continue
+		}
+
+		l := &hubLegs{hub: hub}
+		legs = append(legs, l)
+
+		inbound := criteria
+		inbound.Destination = hub
+		outbound := criteria
+		outbound.Origin = hub
+		nextDay := outbound
+		nextDay.DepartureDate = nextDate
+
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			l.inbound = cheaperThan(uc.currency.NormalizePrices(ctx, uc.queryAll(ctx, inbound)), maxPrice)
+			if nextDate == "" || !connectsNextDay(l.inbound, criteria.DepartureDate, uc.selfTransfer.MaxConnection) {
+				return
+			}
+			l.nextDay = cheaperThan(uc.currency.NormalizePrices(ctx, uc.queryAll(ctx, nextDay)), maxPrice)
+		}()
+		go func() {
+			defer wg.Done()
+			l.outbound = cheaperThan(uc.currency.NormalizePrices(ctx, uc.queryAll(ctx, outbound)), maxPrice)
+		}()
+	}
+	wg.Wait()
+
+	var connections []domain.Flight
+	for _, l := range legs {
+		for _, f := range connectLegs(criteria, l.hub, l.inbound, slices.Concat(l.outbound, l.nextDay),
+			uc.selfTransfer.minConnection(l.hub), uc.selfTransfer.MaxConnection) {
+			if f.Price.Amount <= maxPrice {
+				connections = append(connections, f)
+			}
+		}
+	}
+
+	log.Debug().
+		Str("origin", criteria.Origin).
+		Str("destination", criteria.Destination).
+		Int("connections", len(connections)).
+		Msg("Self-transfer connections built")
+
+	return connections
+}
+
+// connectsNextDay reports whether any of the inbound legs arrives late enough on date
+// that the latest onward departure, maxConnection after arrival, falls on a later date
+// at the hub.
+func connectsNextDay(inbound []domain.Flight, date string, maxConnection time.Duration) bool {
+	for _, f := range inbound {
+		if f.Arrival.DateTime.Add(maxConnection).Format("2006-01-02") > date {
+			return true
+		}
+	}
+	return false
+}
+
+// queryAll queries every enabled provider covering the criteria concurrently and returns
+// the flights of the providers that succeeded.
+func (uc *flightSearchUseCase) queryAll(ctx context.Context, criteria domain.SearchCriteria) []domain.Flight {
+	providers, _ := coveringProviders(uc.registry.Enabled(), criteria)
+	resultsChan := make(chan providerResult, len(providers))
+	for _, provider := range providers {
+		go uc.queryProvider(ctx, provider, criteria, resultsChan)
+	}
+
+	var flights []domain.Flight
+	for range providers {
+		result := <-resultsChan
+		if result.Error != nil {
+			log.Debug().
+				Str("provider", result.Provider).
+				Str("origin", criteria.Origin).
+				Str("destination", criteria.Destination).
+				Err(result.Error).
+				Msg("Self-transfer leg query failed")
+			continue
+		}
+		flights = append(flights, result.Flights...)
+	}
+	return flights
+}
+
+// connectLegs combines each inbound leg from the origin to the hub with each outbound
+// leg from the hub to the destination departing between minConnection and
+// maxConnection after the inbound leg arrives.
+func connectLegs(criteria domain.SearchCriteria, hub string, inbound, outbound []domain.Flight, minConnection, maxConnection time.Duration) []domain.Flight {
+	var connections []domain.Flight
+	for _, first := range inbound {
+		if first.Departure.AirportCode != criteria.Origin || first.Arrival.AirportCode != hub {
+			continue
+		}
+		for _, second := range outbound {
+			if second.Departure.AirportCode != hub || second.Arrival.AirportCode != criteria.Destination {
+				continue
+			}
+			connection := second.Departure.DateTime.Sub(first.Arrival.DateTime)
+			if connection < minConnection || connection > maxConnection {
+				continue
+			}
+			connections = append(connections, domain.NewSelfTransfer(first, second))
+		}
+	}
+	return connections
+}
+
+// selfTransferMaxPrice returns the highest price at which a self-transfer is shown
+// alongside the regular flights: unlimited if there are no regular flights, otherwise
+// the cheapest regular fare less minSaving of it.
+func selfTransferMaxPrice(regular []domain.Flight, minSaving float64) float64 {
+	if len(regular) == 0 {
+		return math.Inf(1)
+	}
+
+	cheapest, _ := findPriceRange(regular)
+	return cheapest * (1 - minSaving)
+}
+
+// cheaperThan returns the flights priced below maxPrice.
+func cheaperThan(flights []domain.Flight, maxPrice float64) []domain.Flight {
+	var result []domain.Flight
+	for _, f := range flights {
+		if f.Price.Amount < maxPrice {
+			result = append(result, f)
+		}
+	}
+	return result
+}
@@ -0,0 +1,223 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// routeProvider is a test provider returning only the flights on the searched route and
+// date. It records the searched routes as "ORIGIN-DESTINATION".
+type routeProvider struct {
+	name    string
+	flights []domain.Flight
+
+	mu       sync.Mutex
+	searched []string
+}
+
+func (p *routeProvider) Name() string {
+	return p.name
+}
+
+func (p *routeProvider) Search(_ context.Context, criteria domain.SearchCriteria) ([]domain.Flight, error) {
+	p.mu.Lock()
+	p.searched = append(p.searched, criteria.Origin+"-"+criteria.Destination)
+	p.mu.Unlock()
+
+	var flights []domain.Flight
+	for _, f := range p.flights {
+		if f.Departure.AirportCode == criteria.Origin && f.Arrival.AirportCode == criteria.Destination &&
+			f.Departure.DateTime.Format("2006-01-02") == criteria.DepartureDate {
+			flights = append(flights, f)
+		}
+	}
+	return flights, nil
+}
+
+// legFlight builds a flight between two airports on 2025-12-15, with times given as
+// UTC hour and minute; hours from 24 fall on the next day.
+func legFlight(id, from, to string, depHour, depMinute, arrHour, arrMinute int, price float64) domain.Flight {
+	departure := time.Date(2025, 12, 15, depHour, depMinute, 0, 0, time.UTC)
+	arrival := time.Date(2025, 12, 15, arrHour, arrMinute, 0, 0, time.UTC)
+	return domain.Flight{
+		ID:           id,
+		FlightNumber: id,
+		Provider:     "test_provider",
+		Departure:    domain.FlightPoint{AirportCode: from, DateTime: departure},
+		Arrival:      domain.FlightPoint{AirportCode: to, DateTime: arrival},
+		Duration:     domain.NewDurationInfo(int(arrival.Sub(departure).Minutes())),
+		Price:        domain.PriceInfo{Amount: price, Currency: "IDR"},
+	}
+}
+
+func TestSelfTransferConfig_MinConnection(t *testing.T) {
+	c := SelfTransferConfig{DefaultMinConnection: 45 * time.Minute}
+
+	assert.Equal(t, 120*time.Minute, c.minConnection("CGK"), "from airport reference data")
+	assert.Equal(t, 45*time.Minute, c.minConnection("LOP"), "default for airports without one")
+}
+
+func TestConnectLegs(t *testing.T) {
+	criteria := domain.SearchCriteria{Origin: "CGK", Destination: "LOP"}
+	inbound := []domain.Flight{
+		legFlight("in-early", "CGK", "SUB", 0, 0, 1, 30, 500000),
+		legFlight("in-late", "CGK", "SUB", 3, 0, 4, 30, 500000),
+		legFlight("in-elsewhere", "CGK", "DPS", 0, 0, 2, 0, 500000),
+	}
+	outbound := []domain.Flight{
+		legFlight("out", "SUB", "LOP", 5, 0, 6, 0, 600000),
+		legFlight("out-elsewhere", "SUB", "UPG", 5, 0, 7, 0, 600000),
+	}
+
+	tests := []struct {
+		name          string
+		minConnection time.Duration
+		maxConnection time.Duration
+		expectedIDs   []string
+	}{
+		{
+			name:          "connections within the window",
+			minConnection: 30 * time.Minute,
+			maxConnection: 8 * time.Hour,
+			expectedIDs:   []string{"in-early+out", "in-late+out"},
+		},
+		{
+			name:          "too short connection excluded",
+			minConnection: time.Hour,
+			maxConnection: 8 * time.Hour,
+			expectedIDs:   []string{"in-early+out"},
+		},
+		{
+			name:          "too long connection excluded",
+			minConnection: 30 * time.Minute,
+			maxConnection: 2 * time.Hour,
+			expectedIDs:   []string{"in-late+out"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := connectLegs(criteria, "SUB", inbound, outbound, tt.minConnection, tt.maxConnection)
+
+			ids := []string{}
+			for _, f := range result {
+				ids = append(ids, f.ID)
+				require.NotNil(t, f.SelfTransfer)
+				assert.Equal(t, "SUB", f.SelfTransfer.ConnectionAirport)
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+		})
+	}
+}
+
+func TestSelfTransferMaxPrice(t *testing.T) {
+	t.Run("unlimited without regular flights", func(t *testing.T) {
+		assert.True(t, math.IsInf(selfTransferMaxPrice(nil, 0.2), 1))
+	})
+
+	t.Run("cheapest regular fare less the minimum saving", func(t *testing.T) {
+		regular := []domain.Flight{
+			{ID: "direct", Price: domain.PriceInfo{Amount: 1000000}},
+			{ID: "connecting", Price: domain.PriceInfo{Amount: 1500000}},
+		}
+
+		assert.Equal(t, 800000.0, selfTransferMaxPrice(regular, 0.2))
+	})
+}
+
+func TestSearch_SelfTransfer(t *testing.T) {
+	provider := &routeProvider{
+		name: "test_provider",
+		flights: []domain.Flight{
+			legFlight("direct", "CGK", "LOP", 0, 0, 2, 0, 2000000),
+			legFlight("QZ7510", "CGK", "SUB", 0, 10, 1, 40, 540000),
+			legFlight("JT828", "SUB", "LOP", 4, 0, 5, 5, 620000),
+		},
+	}
+
+	criteria := domain.SearchCriteria{
+		Origin:        "CGK",
+		Destination:   "LOP",
+		DepartureDate: "2025-12-15",
+		Passengers:    1,
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		uc := NewFlightSearchUseCase([]domain.FlightProvider{provider}, nil)
+
+		result, err := uc.Search(context.Background(), criteria, DefaultSearchOptions())
+
+		require.NoError(t, err)
+		require.Len(t, result.Flights, 1)
+		assert.Equal(t, "direct", result.Flights[0].ID)
+	})
+
+	t.Run("ranked alongside regular flights", func(t *testing.T) {
+		uc := NewFlightSearchUseCase([]domain.FlightProvider{provider}, &Config{
+			SelfTransfer: SelfTransferConfig{Enabled: true, Hubs: []string{"SUB", "CGK"}, MinSaving: 0.2},
+		})
+
+		opts := DefaultSearchOptions()
+		opts.SortBy = domain.SortByPrice
+		result, err := uc.Search(context.Background(), criteria, opts)
+
+		require.NoError(t, err)
+		require.Len(t, result.Flights, 2)
+		assert.Equal(t, "QZ7510+JT828", result.Flights[0].ID)
+		assert.Equal(t, 1160000.0, result.Flights[0].Price.Amount)
+		require.NotNil(t, result.Flights[0].SelfTransfer)
+		assert.Equal(t, domain.SelfTransferUnprotected, result.Flights[0].SelfTransfer.Status)
+		assert.Equal(t, 140, result.Flights[0].SelfTransfer.ConnectionMinutes)
+		assert.Equal(t, "direct", result.Flights[1].ID)
+		assert.Nil(t, result.Flights[1].SelfTransfer)
+	})
+
+	t.Run("excludes self-transfers not saving enough", func(t *testing.T) {
+		provider := &routeProvider{
+			name: "test_provider",
+			flights: []domain.Flight{
+				legFlight("direct", "CGK", "LOP", 0, 0, 2, 0, 1000000),
+				legFlight("QZ7510", "CGK", "SUB", 0, 10, 1, 40, 900000),
+				legFlight("JT828", "SUB", "LOP", 4, 0, 5, 5, 100000),
+			},
+		}
+		uc := NewFlightSearchUseCase([]domain.FlightProvider{provider}, &Config{
+			SelfTransfer: SelfTransferConfig{Enabled: true, Hubs: []string{"SUB"}, MinSaving: 0.2},
+		})
+
+		result, err := uc.Search(context.Background(), criteria, DefaultSearchOptions())
+
+		require.NoError(t, err)
+		require.Len(t, result.Flights, 1)
+		assert.Equal(t, "direct", result.Flights[0].ID)
+		assert.ElementsMatch(t, []string{"CGK-LOP", "CGK-SUB", "SUB-LOP"}, provider.searched,
+			"legs are not queried for the next day without a late arrival at the hub")
+	})
+
+	t.Run("connects to legs departing after midnight", func(t *testing.T) {
+		provider := &routeProvider{
+			name: "test_provider",
+			flights: []domain.Flight{
+				legFlight("QZ7520", "CGK", "SUB", 20, 30, 22, 0, 540000),
+				legFlight("JT830", "SUB", "LOP", 25, 0, 26, 5, 620000),
+			},
+		}
+		uc := NewFlightSearchUseCase([]domain.FlightProvider{provider}, &Config{
+			SelfTransfer: SelfTransferConfig{Enabled: true, Hubs: []string{"SUB"}, MinSaving: 0.2},
+		})
+
+		result, err := uc.Search(context.Background(), criteria, DefaultSearchOptions())
+
+		require.NoError(t, err)
+		require.Len(t, result.Flights, 1)
+		assert.Equal(t, "QZ7520+JT830", result.Flights[0].ID)
+		assert.Equal(t, 180, result.Flights[0].SelfTransfer.ConnectionMinutes)
+	})
+}
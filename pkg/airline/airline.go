@@ -0,0 +1,69 @@
+// Package airline provides reference data for airlines, embedded in the binary.
+// Each airline carries its IATA code, canonical name, logo URL, low-cost flag,
+// parent group, alliance and indicative on-time rate. It is the single source of
+// airline names used when normalizing provider data, since providers spell airline
+// names inconsistently.
+package airline
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//go:embed airlines.json
+var airlinesJSON []byte
+
+// Airline is a single entry of the airline reference dataset.
+type Airline struct {
+	Code     string `json:"code"`     // IATA airline code
+	Name     string `json:"name"`     // Canonical airline name
+	Logo     string `json:"logo"`     // Logo image URL
+	LowCost  bool   `json:"low_cost"` // Low-cost carrier (false means full-service)
+	Group    string `json:"group"`    // Parent airline group
+	Alliance string `json:"alliance"` // Airline alliance, empty if unaligned
+
+	OnTimeRate float64 `json:"on_time_rate"` // Indicative share of flights arriving on time, in [0,1]; 0 if unknown
+}
+
+var (
+	loadOnce sync.Once
+	airlines []Airline
+	byCode   map[string]Airline
+)
+
+// load parses the embedded dataset once.
+// It panics if the embedded data is malformed, since that is a build defect.
+func load() {
+	loadOnce.Do(func() {
+		if err := json.Unmarshal(airlinesJSON, &airlines); err != nil {
+			panic(fmt.Sprintf("airline: invalid embedded dataset: %v", err))
+		}
+		sort.Slice(airlines, func(i, j int) bool {
+			return airlines[i].Code < airlines[j].Code
+		})
+		byCode = make(map[string]Airline, len(airlines))
+		for _, a := range airlines {
+			byCode[a.Code] = a
+		}
+	})
+}
+
+// Lookup returns the airline with the given IATA code (case-insensitive).
+func Lookup(code string) (Airline, bool) {
+	load()
+	a, ok := byCode[strings.ToUpper(strings.TrimSpace(code))]
+	return a, ok
+}
+
+// All returns every airline in the dataset, sorted by code.
+// The returned slice is a copy and may be modified by the caller.
+func All() []Airline {
+	load()
+	result := make([]Airline, len(airlines))
+	copy(result, airlines)
+	return result
+}
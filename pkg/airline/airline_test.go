@@ -0,0 +1,70 @@
+package airline
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name          string
+		code          string
+		expectFound   bool
+		expectName    string
+		expectLowCost bool
+		expectGroup   string
+	}{
+		{"Garuda Indonesia", "GA", true, "Garuda Indonesia", false, "Garuda Indonesia Group"},
+		{"Lion Air", "JT", true, "Lion Air", true, "Lion Group"},
+		{"Batik Air", "ID", true, "Batik Air", false, "Lion Group"},
+		{"Indonesia AirAsia", "QZ", true, "Indonesia AirAsia", true, "Capital A"},
+		{"Citilink", "QG", true, "Citilink", true, "Garuda Indonesia Group"},
+		{"case-insensitive", " ga ", true, "Garuda Indonesia", false, "Garuda Indonesia Group"},
+		{"unknown code", "ZZ", false, "", false, ""},
+		{"empty code", "", false, "", false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, ok := Lookup(tt.code)
+			assert.Equal(t, tt.expectFound, ok)
+			assert.Equal(t, tt.expectName, a.Name)
+			assert.Equal(t, tt.expectLowCost, a.LowCost)
+			assert.Equal(t, tt.expectGroup, a.Group)
+		})
+	}
+}
+
+func TestAll_DatasetIsValid(t *testing.T) {
+	airlines := All()
+	require.NotEmpty(t, airlines)
+
+	seen := make(map[string]struct{}, len(airlines))
+	for i, a := range airlines {
+		assert.Len(t, a.Code, 2, "code %q", a.Code)
+		assert.Equal(t, strings.ToUpper(a.Code), a.Code)
+		assert.NotEmpty(t, a.Name, a.Code)
+		assert.NotEmpty(t, a.Group, a.Code)
+		assert.True(t, strings.HasPrefix(a.Logo, "https://"), a.Code)
+		assert.True(t, a.OnTimeRate > 0 && a.OnTimeRate <= 1, "on-time rate of %q", a.Code)
+
+		_, dup := seen[a.Code]
+		assert.False(t, dup, "duplicate code %q", a.Code)
+		seen[a.Code] = struct{}{}
+
+		if i > 0 {
+			assert.Less(t, airlines[i-1].Code, a.Code, "not sorted by code")
+		}
+	}
+}
+
+func TestAll_ReturnsCopy(t *testing.T) {
+	first := All()
+	first[0].Name = "modified"
+
+	second := All()
+	assert.NotEqual(t, "modified", second[0].Name)
+}
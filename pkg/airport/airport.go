@@ -0,0 +1,96 @@
+// Package airport provides reference data for airports, embedded in the binary.
+// Each airport carries its IATA code, name, city, country, IANA timezone and coordinates,
+// and hubs carry their minimum self-transfer connection time.
+// It is the single source of airport timezones used when normalizing provider data.
+package airport
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/herdiagusthio/flight-search-system/pkg/util"
+)
+
+// ErrUnknownAirport is returned when an airport code is not in the dataset.
+var ErrUnknownAirport = errors.New("unknown airport")
+
+//go:embed airports.json
+var airportsJSON []byte
+
+// Airport is a single entry of the airport reference dataset.
+type Airport struct {
+	Code      string  `json:"code"`      // IATA airport code
+	Name      string  `json:"name"`      // Airport name
+	City      string  `json:"city"`      // City served
+	Country   string  `json:"country"`   // ISO 3166-1 alpha-2 country code
+	Timezone  string  `json:"timezone"`  // IANA timezone name
+	Latitude  float64 `json:"latitude"`  // Latitude in decimal degrees
+	Longitude float64 `json:"longitude"` // Longitude in decimal degrees
+	Major     bool    `json:"major"`     // High-traffic airport, ranked first in search results
+
+	MinConnectionMinutes int `json:"min_connection_minutes,omitempty"` // Minimum self-transfer connection time, 0 if unknown
+}
+
+var (
+	loadOnce sync.Once
+	airports []Airport
+	byCode   map[string]Airport
+)
+
+// load parses the embedded dataset once.
+// It panics if the embedded data is malformed, since that is a build defect.
+func load() {
+	loadOnce.Do(func() {
+		if err := json.Unmarshal(airportsJSON, &airports); err != nil {
+			panic(fmt.Sprintf("airport: invalid embedded dataset: %v", err))
+		}
+		sort.Slice(airports, func(i, j int) bool {
+			return airports[i].Code < airports[j].Code
+		})
+		byCode = make(map[string]Airport, len(airports))
+		for _, a := range airports {
+			byCode[a.Code] = a
+		}
+	})
+}
+
+// Lookup returns the airport with the given IATA code (case-insensitive).
+func Lookup(code string) (Airport, bool) {
+	load()
+	a, ok := byCode[strings.ToUpper(strings.TrimSpace(code))]
+	return a, ok
+}
+
+// All returns every airport in the dataset, sorted by code.
+// The returned slice is a copy and may be modified by the caller.
+func All() []Airport {
+	load()
+	result := make([]Airport, len(airports))
+	copy(result, airports)
+	return result
+}
+
+// Location returns the timezone location of an airport.
+// Returns ErrUnknownAirport if the airport is not in the dataset.
+func Location(code string) (*time.Location, error) {
+	a, ok := Lookup(code)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownAirport, code)
+	}
+	return util.GetLocation(a.Timezone)
+}
+
+// LocationOr returns the timezone location of an airport, or fallback if it is unknown.
+func LocationOr(code string, fallback *time.Location) *time.Location {
+	loc, err := Location(code)
+	if err != nil {
+		return fallback
+	}
+	return loc
+}
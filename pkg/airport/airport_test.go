@@ -0,0 +1,101 @@
+package airport
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name           string
+		code           string
+		expectFound    bool
+		expectCity     string
+		expectCountry  string
+		expectTimezone string
+	}{
+		{"Jakarta CGK", "CGK", true, "Jakarta", "ID", "Asia/Jakarta"},
+		{"Surabaya SUB", "SUB", true, "Surabaya", "ID", "Asia/Jakarta"},
+		{"Bali DPS", "DPS", true, "Denpasar", "ID", "Asia/Makassar"},
+		{"Makassar UPG", "UPG", true, "Makassar", "ID", "Asia/Makassar"},
+		{"Jayapura DJJ", "DJJ", true, "Jayapura", "ID", "Asia/Jayapura"},
+		{"Pontianak PNK", "PNK", true, "Pontianak", "ID", "Asia/Pontianak"},
+		{"Singapore SIN", "SIN", true, "Singapore", "SG", "Asia/Singapore"},
+		{"Kuala Lumpur KUL", "KUL", true, "Kuala Lumpur", "MY", "Asia/Kuala_Lumpur"},
+		{"case-insensitive", " sub ", true, "Surabaya", "ID", "Asia/Jakarta"},
+		{"unknown code", "XYZ", false, "", "", ""},
+		{"empty code", "", false, "", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, ok := Lookup(tt.code)
+			assert.Equal(t, tt.expectFound, ok)
+			assert.Equal(t, tt.expectCity, a.City)
+			assert.Equal(t, tt.expectCountry, a.Country)
+			assert.Equal(t, tt.expectTimezone, a.Timezone)
+		})
+	}
+}
+
+func TestLookup_MinConnectionMinutes(t *testing.T) {
+	sub, ok := Lookup("SUB")
+	require.True(t, ok)
+	assert.Equal(t, 90, sub.MinConnectionMinutes)
+
+	lop, ok := Lookup("LOP")
+	require.True(t, ok)
+	assert.Zero(t, lop.MinConnectionMinutes, "non-hub airports have no minimum connection time")
+}
+
+func TestAll_DatasetIsValid(t *testing.T) {
+	airports := All()
+	require.NotEmpty(t, airports)
+
+	seen := make(map[string]struct{}, len(airports))
+	for i, a := range airports {
+		assert.Len(t, a.Code, 3, "code %q", a.Code)
+		assert.NotEmpty(t, a.Name, a.Code)
+		assert.NotEmpty(t, a.City, a.Code)
+		assert.Len(t, a.Country, 2, a.Code)
+		assert.True(t, a.Latitude >= -90 && a.Latitude <= 90, a.Code)
+		assert.True(t, a.Longitude >= -180 && a.Longitude <= 180, a.Code)
+		assert.GreaterOrEqual(t, a.MinConnectionMinutes, 0, a.Code)
+
+		_, err := time.LoadLocation(a.Timezone)
+		assert.NoError(t, err, a.Code)
+
+		_, dup := seen[a.Code]
+		assert.False(t, dup, "duplicate code %q", a.Code)
+		seen[a.Code] = struct{}{}
+
+		if i > 0 {
+			assert.Less(t, airports[i-1].Code, a.Code)
+		}
+	}
+}
+
+func TestAll_ReturnsCopy(t *testing.T) {
+	airports := All()
+	airports[0].Name = "modified"
+
+	assert.NotEqual(t, "modified", All()[0].Name)
+}
+
+func TestLocation(t *testing.T) {
+	loc, err := Location("DPS")
+	require.NoError(t, err)
+	assert.Equal(t, "Asia/Makassar", loc.String())
+
+	_, err = Location("XYZ")
+	assert.True(t, errors.Is(err, ErrUnknownAirport))
+}
+
+func TestLocationOr(t *testing.T) {
+	assert.Equal(t, "Asia/Singapore", LocationOr("SIN", time.UTC).String())
+	assert.Equal(t, time.UTC, LocationOr("XYZ", time.UTC))
+}
@@ -0,0 +1,152 @@
+package airport
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Match scores, from strongest to weakest.
+const (
+	scoreExactCode   = 100
+	scoreCodePrefix  = 80
+	scoreCityPrefix  = 70
+	scoreNamePrefix  = 60
+	scoreContains    = 40
+	scoreFuzzy       = 20
+	minFuzzyQueryLen = 3
+)
+
+// Search finds airports matching query by IATA code, city name or airport name.
+// Matching is case-insensitive and tries, in order of strength: exact code, code prefix,
+// city word prefix, airport name word prefix, substring, and finally fuzzy (typo-tolerant) word matching.
+// Results are ranked by match strength, then major airports first, then code.
+// At most limit results are returned; limit <= 0 means no limit.
+func Search(query string, limit int) []Airport {
+	q := strings.ToLower(strings.TrimSpace(query))
+	if q == "" {
+		return []Airport{}
+	}
+
+	load()
+	type scored struct {
+		airport Airport
+		score   int
+	}
+	matches := make([]scored, 0)
+	for _, a := range airports {
+		if score := matchScore(a, q); score > 0 {
+			matches = append(matches, scored{airport: a, score: score})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		if matches[i].airport.Major != matches[j].airport.Major {
+			return matches[i].airport.Major
+		}
+		return matches[i].airport.Code < matches[j].airport.Code
+	})
+
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	result := make([]Airport, len(matches))
+	for i, m := range matches {
+		result[i] = m.airport
+	}
+	return result
+}
+
+// matchScore returns how strongly an airport matches a lowercase query, or 0 for no match.
+func matchScore(a Airport, q string) int {
+	code := strings.ToLower(a.Code)
+	city := strings.ToLower(a.City)
+	name := strings.ToLower(a.Name)
+
+	switch {
+	case code == q:
+		return scoreExactCode
+	case strings.HasPrefix(code, q):
+		return scoreCodePrefix
+	case hasWordPrefix(city, q):
+		return scoreCityPrefix
+	case hasWordPrefix(name, q):
+		return scoreNamePrefix
+	case strings.Contains(city, q) || strings.Contains(name, q):
+		return scoreContains
+	case fuzzyMatch(city, q) || fuzzyMatch(name, q):
+		return scoreFuzzy
+	default:
+		return 0
+	}
+}
+
+// hasWordPrefix reports whether text starts with q or any of its words does.
+func hasWordPrefix(text, q string) bool {
+	if strings.HasPrefix(text, q) {
+		return true
+	}
+	for _, word := range splitWords(text) {
+		if strings.HasPrefix(word, q) {
+			return true
+		}
+	}
+	return false
+}
+
+// fuzzyMatch reports whether any word of text is within a small edit distance of q,
+// either as a whole word or as a prefix of the same length as q.
+// One edit is allowed for queries of 3-6 characters and two for longer queries.
+func fuzzyMatch(text, q string) bool {
+	if len(q) < minFuzzyQueryLen {
+		return false
+	}
+	maxEdits := 1
+	if len(q) > 6 {
+		maxEdits = 2
+	}
+
+	for _, word := range splitWords(text) {
+		if levenshtein(word, q) <= maxEdits {
+			return true
+		}
+		if len(word) > len(q) && levenshtein(word[:len(q)], q) <= maxEdits {
+			return true
+		}
+	}
+	return false
+}
+
+// splitWords splits text on any non-letter, non-digit character.
+func splitWords(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
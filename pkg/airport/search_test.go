@@ -0,0 +1,82 @@
+package airport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func codes(airports []Airport) []string {
+	result := make([]string, len(airports))
+	for i, a := range airports {
+		result[i] = a.Code
+	}
+	return result
+}
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		name        string
+		query       string
+		expectFirst []string // Expected leading codes, in order
+	}{
+		{"city prefix ranks major airport first", "jak", []string{"CGK", "HLP"}},
+		{"exact code", "dps", []string{"DPS"}},
+		{"code prefix", "SU", []string{"SUB"}},
+		{"airport name word prefix", "juanda", []string{"SUB"}},
+		{"airport name substring", "ngurah", []string{"DPS"}},
+		{"multi-word city", "kuala lumpur", []string{"KUL"}},
+		{"fuzzy city with typo", "jakrta", []string{"CGK", "HLP"}},
+		{"fuzzy city prefix with typo", "surab", []string{"SUB"}},
+		{"fuzzy misspelled prefix", "singapre", []string{"SIN"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Search(tt.query, 0)
+			require.GreaterOrEqual(t, len(result), len(tt.expectFirst))
+			assert.Equal(t, tt.expectFirst, codes(result)[:len(tt.expectFirst)])
+		})
+	}
+}
+
+func TestSearch_ExactCodeBeatsCityMatch(t *testing.T) {
+	// "MED" is Medina's code and a prefix of Medan's city name
+	result := Search("med", 0)
+
+	require.NotEmpty(t, result)
+	assert.Equal(t, "MED", result[0].Code)
+	assert.Contains(t, codes(result), "KNO")
+}
+
+func TestSearch_Limit(t *testing.T) {
+	assert.Len(t, Search("a", 3), 3)
+	assert.Greater(t, len(Search("a", 0)), 3)
+}
+
+func TestSearch_NoMatch(t *testing.T) {
+	assert.Empty(t, Search("zzzzzz", 10))
+	assert.Empty(t, Search("   ", 10))
+	assert.Empty(t, Search("qq", 10)) // Too short for fuzzy matching
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"jakarta", "jakarta", 0},
+		{"jakarta", "jakrta", 1},
+		{"surabaya", "surbaya", 1},
+		{"kitten", "sitting", 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			assert.Equal(t, tt.expected, levenshtein(tt.a, tt.b))
+		})
+	}
+}
@@ -1,11 +1,34 @@
 package util
 
 import (
-	"fmt"
 	"math"
+	"strconv"
 	"strings"
 )
 
+// currencyFormat describes how amounts in a currency are written in its home locale.
+type currencyFormat struct {
+	symbol    string // Currency symbol, including any separating space
+	suffix    bool   // Symbol is written after the amount
+	thousands string // Thousands separator
+	decimal   string // Decimal separator
+	decimals  int    // Number of minor unit digits
+}
+
+// currencyFormats maps ISO 4217 codes to their locale formatting.
+var currencyFormats = map[string]currencyFormat{
+	"IDR": {symbol: "Rp ", thousands: ".", decimal: ",", decimals: 0},
+	"USD": {symbol: "$", thousands: ",", decimal: ".", decimals: 2},
+	"EUR": {symbol: " €", suffix: true, thousands: ".", decimal: ",", decimals: 2},
+	"GBP": {symbol: "£", thousands: ",", decimal: ".", decimals: 2},
+	"SGD": {symbol: "S$", thousands: ",", decimal: ".", decimals: 2},
+	"MYR": {symbol: "RM", thousands: ",", decimal: ".", decimals: 2},
+	"THB": {symbol: "฿", thousands: ",", decimal: ".", decimals: 2},
+	"JPY": {symbol: "¥", thousands: ",", decimal: ".", decimals: 0},
+	"AUD": {symbol: "A$", thousands: ",", decimal: ".", decimals: 2},
+	"CNY": {symbol: "CN¥", thousands: ",", decimal: ".", decimals: 2},
+}
+
 // FormatIDR formats a float64 amount to Indonesian Rupiah (IDR) format.
 //
 // The function follows Indonesian currency conventions:
@@ -24,29 +47,55 @@ import (
 //   - Decimal values are rounded using standard rounding rules
 //   - Very large numbers are supported up to int64 limits
 func FormatIDR(amount float64) string {
-	// Round and convert to integer for formatting
-	intAmount := int64(math.Round(amount))
+	return FormatCurrency(amount, "IDR")
+}
+
+// FormatCurrency formats an amount using the conventions of the currency's home locale:
+// symbol and its position, thousands and decimal separators, and number of decimal places.
+//
+// Examples:
+//   - 1500000, "IDR" → "Rp 1.500.000"
+//   - 1234.5, "USD" → "$1,234.50"
+//   - 1234.5, "EUR" → "1.234,50 €"
+//   - 1234.5, "JPY" → "¥1,235"
+//
+// Currencies without known conventions are written as "1,234.50 XYZ".
+func FormatCurrency(amount float64, currency string) string {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	format, ok := currencyFormats[currency]
+	if !ok {
+		format = currencyFormat{symbol: " " + currency, suffix: true, thousands: ",", decimal: ".", decimals: 2}
+	}
 
-	// Handle zero case
-	if intAmount == 0 {
-		return "Rp 0"
+	scale := math.Pow10(format.decimals)
+	minor := int64(math.Round(math.Abs(amount) * scale))
+	whole := minor / int64(scale)
+
+	number := groupThousands(strconv.FormatInt(whole, 10), format.thousands)
+	if format.decimals > 0 {
+		fraction := strconv.FormatInt(minor%int64(scale), 10)
+		number += format.decimal + strings.Repeat("0", format.decimals-len(fraction)) + fraction
 	}
 
-	// Convert to string
-	str := fmt.Sprintf("%d", intAmount)
+	sign := ""
+	if amount < 0 && minor != 0 {
+		sign = "-"
+	}
+	if format.suffix {
+		return sign + number + format.symbol
+	}
+	return sign + format.symbol + number
+}
 
-	// Add thousand separators (dots)
+// groupThousands inserts sep between every group of three digits from the right.
+func groupThousands(digits, sep string) string {
 	var result strings.Builder
-	length := len(str)
-
-	for i, char := range str {
-		// Add dot before every 3rd digit from the right
+	length := len(digits)
+	for i, char := range digits {
 		if i > 0 && (length-i)%3 == 0 {
-			result.WriteString(".")
+			result.WriteString(sep)
 		}
 		result.WriteRune(char)
 	}
-
-	// Build final string with prefix
-	return "Rp " + result.String()
+	return result.String()
 }
@@ -211,3 +211,32 @@ func TestFormatIDR_RoundingBehavior(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatCurrency(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   float64
+		currency string
+		expected string
+	}{
+		{"IDR", 1500000, "IDR", "Rp 1.500.000"},
+		{"IDR rounds to whole rupiah", 1499.6, "IDR", "Rp 1.500"},
+		{"USD", 1234.5, "USD", "$1,234.50"},
+		{"USD small amount", 0.05, "USD", "$0.05"},
+		{"USD rounds to cents", 99.999, "USD", "$100.00"},
+		{"EUR suffix symbol", 1234.5, "EUR", "1.234,50 €"},
+		{"SGD", 1000000, "SGD", "S$1,000,000.00"},
+		{"MYR", 250.75, "MYR", "RM250.75"},
+		{"JPY has no decimals", 1234.5, "JPY", "¥1,235"},
+		{"lowercase code", 10, "usd", "$10.00"},
+		{"negative amount", -1234.5, "USD", "-$1,234.50"},
+		{"negative rounding to zero", -0.001, "USD", "$0.00"},
+		{"unknown currency", 1234.5, "XYZ", "1,234.50 XYZ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, FormatCurrency(tt.amount, tt.currency))
+		})
+	}
+}
@@ -51,41 +51,3 @@ func ParseInTimezone(layout, value, timezone string) (time.Time, error) {
 	}
 	return time.ParseInLocation(layout, value, loc)
 }
-
-
-
-// GetTimezoneByAirport returns the IANA timezone for an Indonesian airport code.
-// Indonesian airports are divided into three time zones:
-//   - WIB (Western): Jakarta, Surabaya, Bandung, Medan, Semarang, Yogyakarta, etc.
-//   - WITA (Central): Bali, Makassar, Balikpapan, Manado, etc.
-//   - WIT (Eastern): Jayapura, Ambon, Timika, etc.
-//
-// For non-Indonesian or unknown airports, defaults to WIB.
-func GetTimezoneByAirport(airportCode string) string {
-	switch airportCode {
-	// Central Indonesia Time (WITA) - UTC+8
-	case "DPS", // Denpasar, Bali
-		"UPG", // Makassar
-		"BPN", // Balikpapan
-		"MDC", // Manado
-		"PLW", // Palu
-		"KDI", // Kendari
-		"LOP", // Lombok
-		"BDJ": // Banjarmasin
-		return WITA
-
-	// Eastern Indonesia Time (WIT) - UTC+9
-	case "DJJ", // Jayapura
-		"AMQ", // Ambon
-		"TIM", // Timika
-		"MKQ", // Merauke
-		"SOQ", // Sorong
-		"BIK": // Biak
-		return WIT
-
-	// Western Indonesia Time (WIB) - UTC+7 (default)
-	default:
-		// Includes: CGK, SUB, BDO, KNO, SRG, JOG, PLM, PKU, BTH, PNK, etc.
-		return WIB
-	}
-}
\ No newline at end of file
@@ -236,55 +236,6 @@ func TestConcurrentAccess(t *testing.T) {
 	}
 }
 
-// TestGetTimezoneByAirport verifies airport code to timezone mapping
-func TestGetTimezoneByAirport(t *testing.T) {
-	tests := []struct {
-		name         string
-		airportCode  string
-		expectedZone string
-	}{
-		// Western Indonesia (WIB) - UTC+7
-		{"Jakarta CGK", "CGK", WIB},
-		{"Surabaya SUB", "SUB", WIB},
-		{"Bandung BDO", "BDO", WIB},
-		{"Medan KNO", "KNO", WIB},
-		{"Semarang SRG", "SRG", WIB},
-		{"Yogyakarta JOG", "JOG", WIB},
-		{"Palembang PLM", "PLM", WIB},
-		{"Batam BTH", "BTH", WIB},
-
-		// Central Indonesia (WITA) - UTC+8
-		{"Bali DPS", "DPS", WITA},
-		{"Makassar UPG", "UPG", WITA},
-		{"Balikpapan BPN", "BPN", WITA},
-		{"Manado MDC", "MDC", WITA},
-		{"Palu PLW", "PLW", WITA},
-		{"Kendari KDI", "KDI", WITA},
-		{"Lombok LOP", "LOP", WITA},
-		{"Banjarmasin BDJ", "BDJ", WITA},
-
-		// Eastern Indonesia (WIT) - UTC+9
-		{"Jayapura DJJ", "DJJ", WIT},
-		{"Ambon AMQ", "AMQ", WIT},
-		{"Timika TIM", "TIM", WIT},
-		{"Merauke MKQ", "MKQ", WIT},
-		{"Sorong SOQ", "SOQ", WIT},
-		{"Biak BIK", "BIK", WIT},
-
-		// Unknown/International defaults to WIB
-		{"Unknown XYZ", "XYZ", WIB},
-		{"International", "SIN", WIB},
-		{"Empty code", "", WIB},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := GetTimezoneByAirport(tt.airportCode)
-			assert.Equal(t, tt.expectedZone, result)
-		})
-	}
-}
-
 // clearLocationCache clears the location cache for testing purposes.
 func clearLocationCache() {
 	locationCache.Range(func(key, _ interface{}) bool {
@@ -265,3 +265,58 @@ func TestHealthCheckEndpoint(t *testing.T) {
 		})
 	}
 }
+
+func TestAirportSearchEndpoint(t *testing.T) {
+	e := setupTestServer()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/airports?q=jak", nil)
+	rec := httptest.NewRecorder()
+
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response struct {
+		Airports []struct {
+			Code     string `json:"code"`
+			Timezone string `json:"timezone"`
+		} `json:"airports"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.NotEmpty(t, response.Airports)
+	assert.Equal(t, "CGK", response.Airports[0].Code)
+	assert.Equal(t, "Asia/Jakarta", response.Airports[0].Timezone)
+}
+
+func TestAirlineDirectoryEndpoint(t *testing.T) {
+	e := setupTestServer()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/airlines", nil)
+	rec := httptest.NewRecorder()
+
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response struct {
+		Airlines []struct {
+			Code    string `json:"code"`
+			Name    string `json:"name"`
+			Logo    string `json:"logo"`
+			LowCost bool   `json:"low_cost"`
+		} `json:"airlines"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.NotEmpty(t, response.Airlines)
+
+	found := false
+	for _, a := range response.Airlines {
+		if a.Code == "QZ" {
+			found = true
+			assert.Equal(t, "Indonesia AirAsia", a.Name)
+			assert.NotEmpty(t, a.Logo)
+			assert.True(t, a.LowCost)
+		}
+	}
+	assert.True(t, found, "QZ should be in the airline directory")
+}